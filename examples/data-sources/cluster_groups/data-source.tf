@@ -0,0 +1,30 @@
+# Read Tanzu Mission Control cluster groups : fetch the list of production cluster groups
+data "tanzu-mission-control_cluster_groups" "production" {
+  name  = "*" # Optional, default value is '*'
+  query = ""  # Optional, TQL query to filter the cluster groups
+
+  labels = { # Optional, only list the cluster groups carrying all of these labels
+    "env" : "prod"
+  }
+}
+
+# Fan out a git repository to all the production cluster groups
+resource "tanzu-mission-control_git_repository" "production_git_repository" {
+  for_each = toset(data.tanzu-mission-control_cluster_groups.production.names)
+
+  name           = "tf-platform-config"
+  namespace_name = "tanzu-continuousdelivery-resources"
+
+  scope {
+    cluster_group {
+      name = each.value
+    }
+  }
+
+  spec {
+    url = "https://github.com/example/platform-config"
+    ref {
+      branch = "main"
+    }
+  }
+}
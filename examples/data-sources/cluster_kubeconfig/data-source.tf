@@ -0,0 +1,18 @@
+# Read Tanzu Mission Control cluster kubeconfig : fetch the admin kubeconfig of a provisioned cluster
+data "tanzu-mission-control_cluster_kubeconfig" "read_cluster_kubeconfig" {
+  management_cluster_name = "tkgm-mc"   # Default: attached
+  provisioner_name        = "default"   # Default: attached
+  name                    = "tkgm-wc-1" # Required
+
+  admin               = true        # Default: false
+  cli_type            = "TANZU_CLI" # Default: TANZU_CLI, ignored for admin kubeconfig
+  wait_for_kubeconfig = true        # Default: false
+  wait_timeout        = "15m"       # Default: 10m
+}
+
+provider "kubernetes" {
+  host                   = data.tanzu-mission-control_cluster_kubeconfig.read_cluster_kubeconfig.host
+  cluster_ca_certificate = data.tanzu-mission-control_cluster_kubeconfig.read_cluster_kubeconfig.cluster_ca_certificate
+  client_certificate     = data.tanzu-mission-control_cluster_kubeconfig.read_cluster_kubeconfig.client_certificate
+  client_key             = data.tanzu-mission-control_cluster_kubeconfig.read_cluster_kubeconfig.client_key
+}
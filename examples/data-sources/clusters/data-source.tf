@@ -0,0 +1,43 @@
+# Read Tanzu Mission Control clusters : fetch the list of healthy production clusters
+data "tanzu-mission-control_clusters" "production" {
+  name                    = "*"          # Optional, default value is '*'
+  management_cluster_name = "attached"   # Optional
+  provisioner_name        = "attached"   # Optional
+  cluster_group           = "production" # Optional
+  query                   = ""           # Optional, TQL query to filter the clusters
+
+  labels = { # Optional, only list the clusters carrying all of these labels
+    "env" : "prod"
+  }
+
+  kubernetes_version = "v1.26"     # Optional, matches the patches of the version
+  health             = ["HEALTHY"] # Optional
+  phase              = ["READY"]   # Optional
+}
+
+# Create a registry pull secret on every selected cluster
+resource "tanzu-mission-control_kubernetes_secret" "registry_credentials" {
+  for_each = {
+    for cluster in data.tanzu-mission-control_clusters.production.clusters :
+    "${cluster.management_cluster_name}/${cluster.provisioner_name}/${cluster.name}" => cluster
+  }
+
+  name           = "registry-credentials"
+  namespace_name = "default"
+
+  scope {
+    cluster {
+      name                    = each.value.name
+      provisioner_name        = each.value.provisioner_name
+      management_cluster_name = each.value.management_cluster_name
+    }
+  }
+
+  spec {
+    docker_config_json {
+      username           = "testusername"
+      password           = "testpassword"
+      image_registry_url = "testimageregistryurl"
+    }
+  }
+}
@@ -0,0 +1,44 @@
+# Read the policies applying to a namespace, inherited from the organization, workspace and cluster group
+data "tanzu-mission-control_effective_policies" "namespace_effective_policies" {
+  cluster {
+    management_cluster_name = "attached"
+    provisioner_name        = "attached"
+    name                    = "tf-attach-cluster"
+  }
+
+  namespace_name = "tf-namespace"
+}
+
+# Read the quota effectively applied to the namespace
+data "tanzu-mission-control_effective_policies" "namespace_effective_quota" {
+  cluster {
+    name = "tf-attach-cluster"
+  }
+
+  namespace_name = "tf-namespace"
+  policy_type    = "namespace-quota-policy"
+}
+
+output "namespace_effective_quota" {
+  value = jsondecode(data.tanzu-mission-control_effective_policies.namespace_effective_quota.effective_policies[0].merged_input)
+}
+
+# Preview the quota applied to the namespace once a new organization quota policy is created
+data "tanzu-mission-control_effective_policies" "namespace_quota_preview" {
+  cluster {
+    name = "tf-attach-cluster"
+  }
+
+  namespace_name = "tf-namespace"
+  policy_type    = "namespace-quota-policy"
+
+  preview_policy {
+    name        = "tf-org-quota"
+    policy_type = "namespace-quota-policy"
+    recipe      = "custom"
+    input       = jsonencode({
+      limitsCpu    = "2"
+      limitsMemory = "4Gi"
+    })
+  }
+}
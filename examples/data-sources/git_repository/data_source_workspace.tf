@@ -0,0 +1,12 @@
+# Read Tanzu Mission Control git repository : fetch workspace git repository details
+data "tanzu-mission-control_git_repository" "read_workspace_git_repository" {
+  name = "tf-git-repository-name" # Required
+
+  namespace_name = "tf-namespace" #Required
+
+  scope {
+    workspace {
+      name = "tf-workspace" # Required
+    }
+  }
+}
@@ -0,0 +1,21 @@
+# Read Tanzu Mission Control IAM policy : fetch effective role bindings of a cluster, including inherited ones
+data "tanzu-mission-control_iam_policy" "read_cluster_iam_policy" {
+  scope {
+    cluster {
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+      name                    = "prod-cluster"
+    }
+  }
+}
+
+# Assert that no user is bound to the cluster admin role on the production cluster
+check "no_user_cluster_admins" {
+  assert {
+    condition = length([
+      for binding in data.tanzu-mission-control_iam_policy.read_cluster_iam_policy.bindings : binding
+      if binding.role == "cluster.admin" && binding.subject[0].kind == "USER"
+    ]) == 0
+    error_message = "USER subjects must not be bound to the cluster.admin role on production clusters."
+  }
+}
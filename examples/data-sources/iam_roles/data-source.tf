@@ -0,0 +1,11 @@
+# Read Tanzu Mission Control IAM roles : fetch the roles which can be bound on clusters
+data "tanzu-mission-control_iam_roles" "read_cluster_roles" {
+  name          = "cluster.*"
+  resource_type = "CLUSTER"
+}
+
+output "cluster_role_permissions" {
+  value = {
+    for role in data.tanzu-mission-control_iam_roles.read_cluster_roles.roles : role.name => role.tanzu_permissions
+  }
+}
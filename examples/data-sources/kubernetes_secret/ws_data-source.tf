@@ -0,0 +1,11 @@
+# Read Tanzu Mission Control kubernetes secret : fetch workspace secret details
+data "tanzu-mission-control_kubernetes_secret" "read_ws_secret" {
+  name           = "tf-secret"                # Required
+  namespace_name = "tf-secret-namespace-name" # Required 
+
+  scope {
+    workspace {
+      name = "tf-workspace" # Required
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,10 @@
+# Read Tanzu Mission Control management clusters : fetch the list of registered management clusters
+data "tanzu-mission-control_management_clusters" "read_management_clusters" {
+  name   = "tkgm-*"               # Optional, default value is '*'
+  org_id = "<ID of Organization>" # Optional
+  query  = ""                     # Optional, TQL query to filter the management clusters
+}
+
+output "ready_management_clusters" {
+  value = [for mc in data.tanzu-mission-control_management_clusters.read_management_clusters.management_clusters : mc.name if mc.phase == "READY"]
+}
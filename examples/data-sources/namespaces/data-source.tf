@@ -0,0 +1,31 @@
+# Read Tanzu Mission Control namespaces : fetch the unmanaged namespaces of a legacy cluster
+data "tanzu-mission-control_namespaces" "legacy" {
+  name                    = "team-*"        # Optional, default value is '*'
+  cluster_name            = "legacycluster" # Optional, default value is '*'
+  management_cluster_name = "attached"      # Optional
+  provisioner_name        = "attached"      # Optional
+  query                   = ""              # Optional, TQL query to filter the namespaces
+  include_unmanaged       = true            # Optional, default value is false
+
+  labels = { # Optional, only list the namespaces carrying all of these labels
+    "team" : "payments"
+  }
+}
+
+# Attach every unmanaged namespace to the payments workspace
+resource "tanzu-mission-control_namespace" "migrated" {
+  for_each = {
+    for namespace in data.tanzu-mission-control_namespaces.legacy.namespaces :
+    namespace.name => namespace if !namespace.managed
+  }
+
+  name                    = each.value.name
+  cluster_name            = each.value.cluster_name
+  provisioner_name        = each.value.provisioner_name
+  management_cluster_name = each.value.management_cluster_name
+
+  spec {
+    workspace_name = "payments"
+    attach         = true
+  }
+}
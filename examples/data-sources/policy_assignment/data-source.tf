@@ -0,0 +1,15 @@
+# Read the status of a security policy on the production cluster groups
+data "tanzu-mission-control_policy_assignment" "production_baseline_security" {
+  name        = "tf-baseline-security"
+  policy_type = "security"
+
+  targets {
+    cluster_group_labels = {
+      "env" : "production"
+    }
+  }
+}
+
+output "cluster_groups_missing_policy" {
+  value = [for status in data.tanzu-mission-control_policy_assignment.production_baseline_security.status : status.name if status.state != "APPLIED"]
+}
@@ -0,0 +1,30 @@
+# Read the violations of custom policies reported by a cluster
+data "tanzu-mission-control_policy_insights" "cluster_custom_policy_violations" {
+  scope {
+    cluster {
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+      name                    = "tf-attach-cluster"
+    }
+  }
+
+  policy_type = "custom-policy"
+  severity    = "HIGH"
+}
+
+# Fail the promotion when the cluster reports violations
+output "cluster_violation_count" {
+  value = data.tanzu-mission-control_policy_insights.cluster_custom_policy_violations.total_count
+
+  precondition {
+    condition     = data.tanzu-mission-control_policy_insights.cluster_custom_policy_violations.total_count == 0
+    error_message = "cluster has policy violations"
+  }
+}
+
+# Read all policy violations in a cluster group
+data "tanzu-mission-control_policy_insights" "cluster_group_violations" {
+  scope {
+    cluster_group = "tf-cluster-group"
+  }
+}
@@ -0,0 +1,10 @@
+# Read Tanzu Mission Control source secret : fetch workspace source secret details
+data "tanzu-mission-control_repository_credential" "read_workspace_source_secret" {
+  name = "tf-source_secret" # Required
+
+  scope {
+    workspace {
+      name = "tf-workspace" # Required
+    }
+  }
+}
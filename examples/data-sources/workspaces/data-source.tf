@@ -0,0 +1,30 @@
+# Read Tanzu Mission Control workspaces : fetch the list of production workspaces
+data "tanzu-mission-control_workspaces" "production" {
+  name  = "*" # Optional, default value is '*'
+  query = ""  # Optional, TQL query to filter the workspaces
+
+  labels = { # Optional, only list the workspaces carrying all of these labels
+    "env" : "prod"
+  }
+}
+
+# Fan out an image policy to all the production workspaces
+resource "tanzu-mission-control_image_policy" "production_block_latest_tag" {
+  for_each = toset(data.tanzu-mission-control_workspaces.production.names)
+
+  name = "tf-block-latest-tag"
+
+  scope {
+    workspace {
+      workspace = each.value
+    }
+  }
+
+  spec {
+    input {
+      block_latest_tag {
+        audit = false
+      }
+    }
+  }
+}
@@ -0,0 +1,10 @@
+# Move an AKS cluster to a cluster group without managing the cluster lifecycle
+resource "tanzu-mission-control_cluster_group_membership" "aks_cluster" {
+  name          = "tf-aks-cluster"   # Required
+  cluster_group = "tf-cluster-group" # Required
+
+  cluster_type    = "aks"
+  credential_name = "tf-azure-credential"                  # Required for aks
+  subscription_id = "00000000-0000-0000-0000-000000000000" # Required for aks
+  resource_group  = "tf-resource-group"                    # Required for aks
+}
@@ -0,0 +1,9 @@
+# Move an attached cluster to a cluster group without managing the cluster lifecycle
+resource "tanzu-mission-control_cluster_group_membership" "attached_cluster" {
+  name          = "tf-attached-cluster" # Required
+  cluster_group = "tf-cluster-group"    # Required
+
+  cluster_type            = "classic"  # Default: classic
+  management_cluster_name = "attached" # Default: attached
+  provisioner_name        = "attached" # Default: attached
+}
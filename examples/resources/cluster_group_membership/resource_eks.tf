@@ -0,0 +1,9 @@
+# Move an EKS cluster to a cluster group without managing the cluster lifecycle
+resource "tanzu-mission-control_cluster_group_membership" "eks_cluster" {
+  name          = "tf-eks-cluster"   # Required
+  cluster_group = "tf-cluster-group" # Required
+
+  cluster_type    = "eks"
+  credential_name = "tf-aws-credential" # Required for eks
+  region          = "us-west-2"         # Required for eks
+}
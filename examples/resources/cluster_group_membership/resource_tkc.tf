@@ -0,0 +1,9 @@
+# Move a Tanzu Kubernetes cluster to a cluster group without managing the cluster lifecycle
+resource "tanzu-mission-control_cluster_group_membership" "tkc_cluster" {
+  name          = "tf-tkc-cluster"   # Required
+  cluster_group = "tf-cluster-group" # Required
+
+  cluster_type            = "tkc"
+  management_cluster_name = "tf-management-cluster" # Required for tkc
+  provisioner_name        = "tf-provisioner"        # Required for tkc
+}
@@ -0,0 +1,31 @@
+# Create a Tanzu Mission Control node pool for a TKG AWS workload cluster
+resource "tanzu-mission-control_cluster_node_pool" "create_tkg_aws_node_pool" {
+  management_cluster_name = "tkgm-aws-terraform" # Required
+  provisioner_name        = "default"            # Required
+  cluster_name            = "tkgm-aws-workload"  # Required
+  name                    = "md-1"               # Required
+
+  spec {
+    worker_node_count = "2" # Required
+
+    tkg_aws {
+      nodepool_instance_type = "m5.large"
+
+      node_placement {
+        aws_availability_zone = "us-west-2a"
+      }
+
+      node_placement {
+        aws_availability_zone = "us-west-2b"
+      }
+
+      private_subnet_id = "subnet-0123456789abcdef0" # Optional
+
+      auto_scaling_config {
+        enable    = true
+        min_count = 1
+        max_count = 5
+      }
+    }
+  }
+}
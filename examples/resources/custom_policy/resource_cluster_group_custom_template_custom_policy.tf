@@ -0,0 +1,34 @@
+/*
+Cluster group scoped Tanzu Mission Control custom policy with an input recipe backed by a custom policy template.
+This policy is applied to a cluster group and enforces the tanzu-mission-control_custom_policy_template named in template_name.
+The parameters are validated against the parameters schema of the template.
+*/
+resource "tanzu-mission-control_custom_policy" "cluster_group_scoped_custom_template_custom_policy" {
+  name = "tf-custom-template-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      custom_template {
+        template_name = tanzu-mission-control_custom_policy_template.required_annotations.name
+        audit         = false
+        parameters = jsonencode({
+          annotations = ["owner", "cost-center"]
+        })
+        target_kubernetes_resources {
+          api_groups = [
+            "apps",
+          ]
+          kinds = [
+            "Deployment",
+          ]
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,42 @@
+# Create Tanzu Mission Control custom policy template with a gatekeeper ConstraintTemplate
+resource "tanzu-mission-control_custom_policy_template" "required_annotations" {
+  name = "k8srequiredannotations"
+
+  meta {
+    description = "Requires resources to carry a set of annotations"
+    labels = {
+      "owner" : "security"
+    }
+  }
+
+  spec {
+    constraint_kind = "K8sRequiredAnnotations"
+
+    data_inventory {
+      group   = ""
+      version = "v1"
+      kind    = "Namespace"
+    }
+
+    parameters_schema = jsonencode({
+      properties = {
+        annotations = {
+          type = "array"
+          items = {
+            type = "string"
+          }
+        }
+      }
+    })
+
+    rego = <<-EOT
+      package k8srequiredannotations
+
+      violation[{"msg": msg}] {
+        required := input.parameters.annotations[_]
+        not input.review.object.metadata.annotations[required]
+        msg := sprintf("annotation %v is required", [required])
+      }
+    EOT
+  }
+}
@@ -0,0 +1,27 @@
+# Create Tanzu Mission Control custom role granting read-only access plus exec into pods
+resource "tanzu-mission-control_custom_role" "read_only_exec" {
+  name = "tf-read-only-exec"
+
+  meta {
+    description = "Read-only access to namespaced resources, with exec into pods"
+    labels = {
+      "owner" : "platform"
+    }
+  }
+
+  spec {
+    resources = ["CLUSTER", "NAMESPACE"]
+
+    rules {
+      api_groups = ["", "apps", "batch"]
+      resources  = ["pods", "pods/log", "services", "configmaps", "deployments", "replicasets", "statefulsets", "jobs", "cronjobs"]
+      verbs      = ["get", "list", "watch"]
+    }
+
+    rules {
+      api_groups = [""]
+      resources  = ["pods/exec"]
+      verbs      = ["create"]
+    }
+  }
+}
@@ -0,0 +1,28 @@
+# Create Tanzu Mission Control custom role aggregating the rules of the cluster roles matching the selector
+resource "tanzu-mission-control_custom_role" "aggregated_view" {
+  name = "tf-aggregated-view"
+
+  meta {
+    description = "Aggregates the rules of the cluster roles labeled for the platform view"
+  }
+
+  spec {
+    resources = ["CLUSTER"]
+
+    aggregation_rule {
+      cluster_role_selectors {
+        match_labels = {
+          "rbac.example.com/aggregate-to-platform-view" : "true"
+        }
+      }
+
+      cluster_role_selectors {
+        match_expressions {
+          key      = "rbac.example.com/tier"
+          operator = "In"
+          values   = ["view"]
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,33 @@
+# Create Tanzu Mission Control workspace scope helm release with attached set as default value.
+resource "tanzu-mission-control_helm_release" "create_ws_helm_release" {
+  name = "test-helm-release-name" # Required
+
+  namespace_name = "test-namespace-name" # Required
+
+  scope {
+    workspace {
+      name = "tf-workspace" # Required
+    }
+  }
+
+  meta {
+    description = "Create namespace through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    chart_ref {
+      git_repository {
+        repository_name      = "testgitrepo"
+        repository_namespace = "test-gitrepo-namespace"
+        chart_path           = "chartpath"
+      }
+    }
+
+    inline_config = "<inline-config-file-path>"
+
+    target_namespace = "testtargetnamespacename"
+
+    interval = "10m" # Default: 5m
+  }
+}
\ No newline at end of file
@@ -0,0 +1,33 @@
+/*
+ Cluster group scoped Tanzu Mission Control IAM members owned by different teams.
+ Each resource manages exactly one role binding, so the teams can grant roles on the same cluster group from separate configurations.
+ */
+resource "tanzu-mission-control_iam_member" "team_a_cluster_group_admin" {
+  scope {
+    cluster_group {
+      name = "default"
+    }
+  }
+
+  role = "clustergroup.admin"
+
+  subject {
+    name = "team-a"
+    kind = "GROUP"
+  }
+}
+
+resource "tanzu-mission-control_iam_member" "team_b_cluster_group_view" {
+  scope {
+    cluster_group {
+      name = "default"
+    }
+  }
+
+  role = "clustergroup.view"
+
+  subject {
+    name = "user@example.com"
+    kind = "USER"
+  }
+}
@@ -0,0 +1,18 @@
+/*
+ Workspace scoped Tanzu Mission Control IAM member.
+ This resource binds a single role to a single subject on the workspace, leaving other role bindings untouched.
+ */
+resource "tanzu-mission-control_iam_member" "workspace_scoped_iam_member" {
+  scope {
+    workspace {
+      name = "tf-workspace"
+    }
+  }
+
+  role = "workspace.edit"
+
+  subject {
+    name = "team-a"
+    kind = "GROUP"
+  }
+}
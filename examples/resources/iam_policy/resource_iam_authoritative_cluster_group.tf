@@ -0,0 +1,22 @@
+/*
+ Cluster group scoped authoritative Tanzu Mission Control IAM policy.
+ This resource owns the complete list of role bindings on the associated cluster group.
+ Role bindings added outside of terraform show up in the plan and are removed on apply.
+ */
+resource "tanzu-mission-control_iam_policy" "cluster_group_scoped_authoritative_iam_policy" {
+  scope {
+    cluster_group {
+      name = "default"
+    }
+  }
+
+  authoritative = true
+
+  role_bindings {
+    role = "clustergroup.admin"
+    subjects {
+      name = "test"
+      kind = "GROUP"
+    }
+  }
+}
@@ -0,0 +1,19 @@
+/*
+ Cluster group scoped Tanzu Mission Control IAM policy binding a custom role.
+ The role binding references the custom role by name, so the role is created before the binding.
+ */
+resource "tanzu-mission-control_iam_policy" "cluster_group_scoped_custom_role_iam_policy" {
+  scope {
+    cluster_group {
+      name = "default"
+    }
+  }
+
+  role_bindings {
+    role = tanzu-mission-control_custom_role.read_only_exec.name
+    subjects {
+      name = "test-1"
+      kind = "GROUP"
+    }
+  }
+}
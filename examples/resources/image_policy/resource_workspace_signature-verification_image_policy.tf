@@ -0,0 +1,48 @@
+/*
+Workspace scoped Tanzu Mission Control image policy with signature-verification input recipe.
+This policy is applied to a workspace and requires the images to be signed with cosign,
+either with one of the public keys or keyless by one of the identities of the rule matching the image.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_image_policy" "workspace_scoped_signature-verification_image_policy" {
+  name = "tf-image-test"
+
+  scope {
+    workspace {
+      workspace = "tf-workspace"
+    }
+  }
+
+  spec {
+    input {
+      signature_verification {
+        audit = true
+
+        rules {
+          image_pattern = "harbor.example.com/apps/*"
+          public_keys   = [file("${path.module}/cosign.pub")]
+        }
+
+        rules {
+          image_pattern = "ghcr.io/example/*"
+
+          keyless {
+            issuer         = "https://token.actions.githubusercontent.com"
+            subject_regexp = "^https://github.com/example/.*$"
+          }
+        }
+      }
+    }
+
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "In"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
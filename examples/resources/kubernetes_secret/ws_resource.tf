@@ -0,0 +1,26 @@
+# Create Tanzu Mission Control kubernetes secret with attached set as default value.
+resource "tanzu-mission-control_kubernetes_secret" "create_ws_secret" {
+  name           = "tf-secret"                # Required
+  namespace_name = "tf-secret-namespace-name" # Required 
+
+  scope {
+    workspace {
+      name = "tf-workspace" # Required
+    }
+  }
+
+  export = false # Default: false
+
+  meta {
+    description = "Create namespace through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    docker_config_json {
+      username           = "testusername"         # Required
+      password           = "testpassword"         # Required
+      image_registry_url = "testimageregistryurl" # Required
+    }
+  }
+}
\ No newline at end of file
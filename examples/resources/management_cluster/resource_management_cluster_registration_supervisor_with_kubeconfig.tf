@@ -0,0 +1,15 @@
+resource "tanzu-mission-control_management_cluster" "management_cluster_registration_supervisor" {
+  name = "tf-registration-test" // Required
+
+  spec {
+    cluster_group            = "default" // Required
+    kubernetes_provider_type = "VMWARE_TANZU_KUBERNETES_GRID_SERVICE" // Required
+  }
+
+  register_management_cluster {
+    supervisor_kubeconfig_file = "<supervisor-kube-config-path>" // Required
+    supervisor_tmc_namespace   = "svc-tmc-c8" // Optional, discovered by the svc-tmc- prefix when not provided
+  }
+
+  ready_wait_timeout = "15m" // Optional , default value is 15m
+}
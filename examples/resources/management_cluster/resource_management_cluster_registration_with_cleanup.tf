@@ -0,0 +1,15 @@
+resource "tanzu-mission-control_management_cluster" "management_cluster_registration_with_cleanup" {
+  name = "tf-registration-test" // Required
+
+  spec {
+    cluster_group            = "default" // Required
+    kubernetes_provider_type = "VMWARE_TANZU_KUBERNETES_GRID" // Required
+  }
+
+  register_management_cluster {
+    tkgm_kubeconfig_file = "<kube-config-path>" // Required
+  }
+
+  force   = false // Optional, default value is false - force the deregistration even if the management cluster is not reachable
+  cleanup = true  // Optional, default value is false - remove the Tanzu Mission Control agent from the management cluster on delete
+}
@@ -0,0 +1,34 @@
+resource "tanzu-mission-control_mutation_policy" "cluster_group_default_resources_mutation_policy" {
+  name = "tf-mutation-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      default_resources {
+        requests {
+          cpu    = "100m"
+          memory = "128Mi"
+        }
+        limits {
+          cpu    = "500m"
+          memory = "512Mi"
+        }
+      }
+    }
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "NotIn"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
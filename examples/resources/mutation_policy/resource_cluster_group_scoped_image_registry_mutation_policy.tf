@@ -0,0 +1,31 @@
+resource "tanzu-mission-control_mutation_policy" "cluster_group_image_registry_mutation_policy" {
+  name = "tf-mutation-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      image_registry {
+        target_registry = "harbor.example.com/mirror"
+        source_registries = [
+          "docker.io",
+          "quay.io",
+        ]
+      }
+    }
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "NotIn"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
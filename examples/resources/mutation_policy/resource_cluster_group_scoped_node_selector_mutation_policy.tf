@@ -0,0 +1,30 @@
+resource "tanzu-mission-control_mutation_policy" "cluster_group_node_selector_mutation_policy" {
+  name = "tf-mutation-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      node_selector {
+        labels = {
+          "kubernetes.io/os" = "linux"
+          "node-pool"        = "workloads"
+        }
+      }
+    }
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "NotIn"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
@@ -0,0 +1,38 @@
+resource "tanzu-mission-control_mutation_policy" "cluster_group_tolerations_mutation_policy" {
+  name = "tf-mutation-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      tolerations {
+        toleration {
+          key      = "dedicated"
+          operator = "Equal"
+          value    = "gpu"
+          effect   = "NoSchedule"
+        }
+        toleration {
+          key                = "node.kubernetes.io/unreachable"
+          operator           = "Exists"
+          effect             = "NoExecute"
+          toleration_seconds = 300
+        }
+      }
+    }
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "NotIn"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
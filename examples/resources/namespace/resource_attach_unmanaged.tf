@@ -0,0 +1,16 @@
+# Attach an existing unmanaged namespace to a workspace, without recreating it
+resource "tanzu-mission-control_namespace" "attach_unmanaged_namespace" {
+  name                    = "legacy-namespace" # Required, name of the existing namespace
+  cluster_name            = "testcluster"      # Required
+  provisioner_name        = "attached"         # Default: attached
+  management_cluster_name = "attached"         # Default: attached
+
+  meta {
+    labels = { "migrated" : "true" }
+  }
+
+  spec {
+    workspace_name = "legacy-workspace"
+    attach         = true # Required to adopt the existing namespace
+  }
+}
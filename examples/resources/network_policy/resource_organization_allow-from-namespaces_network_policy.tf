@@ -0,0 +1,50 @@
+/*
+Organization scoped Tanzu Mission Control network policy with allow-from-namespaces input recipe.
+This policy is applied to a organization with the allow-from-namespaces configuration option, only allowing ingress traffic from the given namespaces on a named port and a port range.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_network_policy" "organization_scoped_allow-from-namespaces_network_policy" {
+  name = "tf-network-test"
+
+  scope {
+    organization {
+      organization = "dummy-id"
+    }
+  }
+
+  spec {
+    input {
+      allow_from_namespaces {
+        from_namespaces = [
+          "monitoring",
+        ]
+        from_namespace_labels = {
+          "team" = "frontend"
+        }
+        ports {
+          port     = "metrics"
+          protocol = "TCP"
+        }
+        ports {
+          port     = "8080"
+          end_port = 8090
+          protocol = "TCP"
+        }
+        to_pod_labels = {
+          "key-1" = "value-1"
+        }
+      }
+    }
+
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "In"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
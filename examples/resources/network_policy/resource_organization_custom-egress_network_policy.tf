@@ -80,7 +80,7 @@ resource "tanzu-mission-control_network_policy" "organization_scoped_custom-egre
               ip_block {
                 cidr = "192.168.1.1/24"
                 except = [
-                  "2001:db9::/64",
+                  "192.168.1.1/25",
                 ]
               }
             }
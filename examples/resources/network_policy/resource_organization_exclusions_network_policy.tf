@@ -0,0 +1,24 @@
+/*
+Organization scoped Tanzu Mission Control network policy with deny-all input recipe and exclusions.
+This policy is applied to a organization with the deny-all configuration option,
+except for the excluded namespaces.
+*/
+resource "tanzu-mission-control_network_policy" "organization_scoped_exclusions_network_policy" {
+  name = "tf-network-test"
+
+  scope {
+    organization {
+      organization = "dummy-id"
+    }
+  }
+
+  spec {
+    input {
+      deny_all {}
+    }
+
+    exclusions {
+      namespaces = ["kube-system"]
+    }
+  }
+}
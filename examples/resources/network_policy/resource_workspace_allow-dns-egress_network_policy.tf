@@ -0,0 +1,41 @@
+/*
+Workspace scoped Tanzu Mission Control network policy with allow-dns-egress input recipe.
+This policy is applied to a workspace with the allow-dns-egress configuration option, only allowing the selected pods to reach the cluster DNS.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_network_policy" "workspace_scoped_allow-dns-egress_network_policy" {
+  name = "tf-network-test"
+
+  scope {
+    workspace {
+      workspace = "tf-workspace"
+    }
+  }
+
+  spec {
+    input {
+      allow_dns_egress {
+        dns_namespace_labels = {
+          "kubernetes.io/metadata.name" = "kube-system"
+        }
+        dns_pod_labels = {
+          "k8s-app" = "kube-dns"
+        }
+        to_pod_labels = {
+          "key-1" = "value-1"
+        }
+      }
+    }
+
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "In"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
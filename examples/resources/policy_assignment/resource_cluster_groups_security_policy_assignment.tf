@@ -0,0 +1,39 @@
+/*
+Tanzu Mission Control security policy with baseline input recipe assigned to a fleet of cluster groups.
+A cluster group scoped policy is created on every cluster group listed by name or having the selected labels.
+*/
+resource "tanzu-mission-control_policy_assignment" "cluster_groups_baseline_security_policy" {
+  name = "tf-baseline-security"
+
+  meta {
+    description = "Baseline security policy of the production fleet"
+    labels = {
+      "managed-by" : "terraform"
+    }
+  }
+
+  policy {
+    security {
+      input {
+        baseline {
+          audit              = false
+          disable_native_psp = true
+        }
+      }
+    }
+  }
+
+  targets {
+    cluster_groups = [
+      "tf-edge-cluster-group",
+    ]
+
+    cluster_group_labels = {
+      "env" : "production"
+    }
+  }
+}
+
+output "security_policy_status" {
+  value = tanzu-mission-control_policy_assignment.cluster_groups_baseline_security_policy.status
+}
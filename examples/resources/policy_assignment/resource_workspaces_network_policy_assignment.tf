@@ -0,0 +1,26 @@
+/*
+Tanzu Mission Control network policy with deny-all-to-pods input recipe assigned to a list of workspaces.
+A workspace scoped policy is created on every workspace, and deleted from the workspaces removed from the list.
+*/
+resource "tanzu-mission-control_policy_assignment" "workspaces_deny-all-to-pods_network_policy" {
+  name = "tf-deny-all-to-pods"
+
+  policy {
+    network {
+      input {
+        deny_all_to_pods {
+          to_pod_labels = {
+            "app" : "payments"
+          }
+        }
+      }
+    }
+  }
+
+  targets {
+    workspaces = [
+      "tf-payments-dev",
+      "tf-payments-prod",
+    ]
+  }
+}
@@ -0,0 +1,80 @@
+/*
+Cluster group scoped Tanzu Mission Control namespace quota policy with custom input recipe, LimitRange defaults and per-namespace overrides.
+This policy is applied to a cluster group with the custom configuration option, granting GPUs only to the namespaces matching the override.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+
+resource "tanzu-mission-control_namespace_quota_policy" "cluster_group_scoped_custom_overrides_quota_policy" {
+  name = "tf-qt-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      custom {
+        limits_cpu      = "4"
+        limits_memory   = "8Gi"
+        requests_cpu    = "2"
+        requests_memory = "4Gi"
+        resource_counts = {
+          pods : 20
+          "requests.nvidia.com/gpu" : 0
+        }
+
+        limit_range {
+          default {
+            cpu    = "500m"
+            memory = "512Mi"
+          }
+          default_request {
+            cpu    = "250m"
+            memory = "256Mi"
+          }
+          min {
+            cpu    = "50m"
+            memory = "64Mi"
+          }
+          max {
+            cpu    = "2"
+            memory = "4Gi"
+          }
+        }
+
+        namespace_override {
+          namespace_selector {
+            match_expressions {
+              key      = "team"
+              operator = "In"
+              values = [
+                "machine-learning"
+              ]
+            }
+          }
+
+          limits_cpu      = "16"
+          limits_memory   = "64Gi"
+          requests_cpu    = "8"
+          requests_memory = "32Gi"
+          resource_counts = {
+            pods : 50
+            "requests.nvidia.com/gpu" : 4
+          }
+        }
+      }
+    }
+
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "NotIn"
+        values = [
+          "api-server"
+        ]
+      }
+    }
+  }
+}
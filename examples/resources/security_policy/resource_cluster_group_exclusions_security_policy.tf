@@ -0,0 +1,38 @@
+/*
+Cluster group scoped Tanzu Mission Control security policy with baseline input recipe and exclusions.
+This policy is applied to a cluster group with the baseline configuration option and is inherited by the clusters,
+except for the namespaces excluded by name or by label.
+*/
+resource "tanzu-mission-control_security_policy" "cluster_group_scoped_exclusions_security_policy" {
+  name = "tf-sp-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      baseline {
+        audit              = false
+        disable_native_psp = true
+      }
+    }
+
+    exclusions {
+      namespaces = [
+        "kube-system",
+        "tanzu-system",
+      ]
+
+      namespace_selector {
+        match_expressions {
+          key      = "security-exempt"
+          operator = "Exists"
+          values   = []
+        }
+      }
+    }
+  }
+}
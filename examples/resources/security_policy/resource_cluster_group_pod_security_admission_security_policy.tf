@@ -0,0 +1,48 @@
+/*
+Cluster group scoped Tanzu Mission Control security policy with a pod security admission input recipe.
+This policy is applied to a cluster group and labels the selected namespaces of its clusters for the Kubernetes Pod Security Admission controller.
+The clusters of the cluster group must run Kubernetes v1.23 or later.
+The defined scope and input blocks can be updated to change the policy's scope and recipe.
+*/
+resource "tanzu-mission-control_security_policy" "cluster_group_scoped_pod_security_admission_security_policy" {
+  name = "tf-sp-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      pod_security_admission {
+        enforce {
+          level   = "baseline"
+          version = "v1.25"
+        }
+
+        audit {
+          level = "restricted"
+        }
+
+        warn {
+          level = "restricted"
+        }
+
+        exemptions {
+          usernames       = ["system:serviceaccount:kube-system:replicaset-controller"]
+          runtime_classes = ["kata"]
+          namespaces      = ["kube-system"]
+        }
+      }
+    }
+
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "NotIn"
+        values   = ["api-server", "agent-gateway"]
+      }
+    }
+  }
+}
@@ -18,6 +18,16 @@ const (
 	queryParamKeyForce                 = "force"
 	queryParamKeyManagementClusterName = "fullName.managementClusterName"
 	queryParamKeyProvisionerName       = "fullName.provisionerName"
+
+	queryParamKeySearchScopeName                  = "searchScope.name"
+	queryParamKeySearchScopeClusterGroupName      = "searchScope.clusterGroupName"
+	queryParamKeySearchScopeManagementClusterName = "searchScope.managementClusterName"
+	queryParamKeySearchScopeProvisionerName       = "searchScope.provisionerName"
+	queryParamKeyQuery                            = "query"
+	queryParamKeySortBy                           = "sortBy"
+	queryParamKeyPaginationOffset                 = "pagination.offset"
+	queryParamKeyPaginationSize                   = "pagination.size"
+	queryParamKeyIncludeTotalCount                = "includeTotalCount"
 )
 
 // New creates a new cluster resource service API client.
@@ -40,6 +50,8 @@ type ClientService interface {
 
 	ManageV1alpha1ClusterResourceServiceGet(fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) (*clustermodel.VmwareTanzuManageV1alpha1ClusterGetClusterResponse, error)
 
+	ManageV1alpha1ClusterResourceServiceList(request *clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequest) (*clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse, error)
+
 	ManageV1alpha1ClusterResourceServiceUpdate(request *clustermodel.VmwareTanzuManageV1alpha1ClusterRequest) (*clustermodel.VmwareTanzuManageV1alpha1ClusterResponse, error)
 }
 
@@ -113,3 +125,58 @@ func (c *Client) ManageV1alpha1ClusterResourceServiceGet(
 
 	return clusterResponse, err
 }
+
+/*
+ManageV1alpha1ClusterResourceServiceList lists clusters.
+*/
+func (c *Client) ManageV1alpha1ClusterResourceServiceList(
+	request *clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequest,
+) (*clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse, error) {
+	queryParams := url.Values{}
+
+	if scope := request.SearchScope; scope != nil {
+		if scope.Name != "" {
+			queryParams.Add(queryParamKeySearchScopeName, scope.Name)
+		}
+
+		if scope.ClusterGroupName != "" {
+			queryParams.Add(queryParamKeySearchScopeClusterGroupName, scope.ClusterGroupName)
+		}
+
+		if scope.ManagementClusterName != "" {
+			queryParams.Add(queryParamKeySearchScopeManagementClusterName, scope.ManagementClusterName)
+		}
+
+		if scope.ProvisionerName != "" {
+			queryParams.Add(queryParamKeySearchScopeProvisionerName, scope.ProvisionerName)
+		}
+	}
+
+	if request.Query != "" {
+		queryParams.Add(queryParamKeyQuery, request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add(queryParamKeySortBy, request.SortBy)
+	}
+
+	if request.Pagination != nil {
+		if request.Pagination.Offset != "" {
+			queryParams.Add(queryParamKeyPaginationOffset, request.Pagination.Offset)
+		}
+
+		if request.Pagination.Size != "" {
+			queryParams.Add(queryParamKeyPaginationSize, request.Pagination.Size)
+		}
+	}
+
+	if request.IncludeTotalCount {
+		queryParams.Add(queryParamKeyIncludeTotalCount, "true")
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	clustersResponse := &clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse{}
+	err := c.Get(requestURL, clustersResponse)
+
+	return clustersResponse, err
+}
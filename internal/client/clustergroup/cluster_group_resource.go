@@ -7,11 +7,22 @@ package clustergroupclient
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
 )
 
+const (
+	queryParamKeySearchScopeName   = "searchScope.name"
+	queryParamKeyQuery             = "query"
+	queryParamKeySortBy            = "sortBy"
+	queryParamKeyPaginationOffset  = "pagination.offset"
+	queryParamKeyPaginationSize    = "pagination.size"
+	queryParamKeyIncludeTotalCount = "includeTotalCount"
+)
+
 // New creates a new cluster group resource service API client.
 func New(transport *transport.Client) ClientService {
 	return &Client{Client: transport}
@@ -30,6 +41,8 @@ type ClientService interface {
 
 	ManageV1alpha1ClusterGroupResourceServiceGet(fn *clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupGetClusterGroupResponse, error)
 
+	ManageV1alpha1ClusterGroupResourceServiceList(request *clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse, error)
+
 	ManageV1alpha1ClusterGroupResourceServiceUpdate(request *clustergroupmodel.VmwareTanzuManageV1alpha1ClusterGroupRequest) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClusterGroupResponse, error)
 }
 
@@ -74,3 +87,42 @@ func (c *Client) ManageV1alpha1ClusterGroupResourceServiceUpdate(
 
 	return clusterGroupResponse, err
 }
+
+// ManageV1alpha1ClusterGroupResourceServiceList lists cluster groups.
+func (c *Client) ManageV1alpha1ClusterGroupResourceServiceList(
+	request *clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest,
+) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add(queryParamKeySearchScopeName, request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add(queryParamKeyQuery, request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add(queryParamKeySortBy, request.SortBy)
+	}
+
+	if request.Pagination != nil {
+		if request.Pagination.Offset != "" {
+			queryParams.Add(queryParamKeyPaginationOffset, request.Pagination.Offset)
+		}
+
+		if request.Pagination.Size != "" {
+			queryParams.Add(queryParamKeyPaginationSize, request.Pagination.Size)
+		}
+	}
+
+	if request.IncludeTotalCount {
+		queryParams.Add(queryParamKeyIncludeTotalCount, "true")
+	}
+
+	requestURL := helper.ConstructRequestURL("v1alpha1/clustergroups").AppendQueryParams(queryParams).String()
+	listResponse := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse{}
+	err := c.Get(requestURL, listResponse)
+
+	return listResponse, err
+}
@@ -40,6 +40,7 @@ import (
 	credentialclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/credential"
 	eksclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/ekscluster"
 	eksnodepoolclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/ekscluster/nodepool"
+	iamroleclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/iamrole"
 	integrationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/integration"
 	kubeconfigclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/kubeconfig"
 	secretclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/kubernetessecret"
@@ -51,8 +52,12 @@ import (
 	helmchartsorgclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/organization/helmcharts"
 	iamorganizationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/organization/iam_policy"
 	policyorganizationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/organization/policy"
+	policyeffectiveclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/policyeffective"
+	policyinsightclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/policyinsight"
+	policytemplateclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/policytemplate"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
 	tanzukubernetesclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzukubernetescluster"
+	tanzukubernetesreleaseclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzukubernetesrelease"
 	tanzupackageclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzupackage"
 	pkginstallclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzupackageinstall"
 	pkgrepositoryclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzupackagerepository"
@@ -60,8 +65,14 @@ import (
 	targetlocationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/targetlocation"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
 	workspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace"
+	gitrepositoryworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/gitrepository"
+	helmreleaseworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/helmrelease"
 	iamworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/iam_policy"
+	secretworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/kubernetessecret"
+	secretexportworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/kubernetessecret/secretexport"
+	kustomizationworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/kustomization"
 	policyworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/policy"
+	sourcesecretworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/sourcesecret"
 )
 
 // NewHTTPClient creates a new tanzu mission control HTTP client.
@@ -142,6 +153,17 @@ func newHTTPClient(httpClient *transport.Client) *TanzuMissionControl {
 		ManagementClusterRegistrationResourceService:  managementclusterregistrationclient.New(httpClient),
 		ClusterClassResourceService:                   clusterclassclient.New(httpClient),
 		TanzuKubernetesClusterResourceService:         tanzukubernetesclusterclient.New(httpClient),
+		TanzuKubernetesReleaseResourceService:         tanzukubernetesreleaseclient.New(httpClient),
+		PolicyTemplateResourceService:                 policytemplateclient.New(httpClient),
+		PolicyInsightResourceService:                  policyinsightclient.New(httpClient),
+		EffectivePolicyResourceService:                policyeffectiveclient.New(httpClient),
+		IAMRoleResourceService:                        iamroleclient.New(httpClient),
+		WorkspaceGitRepositoryResourceService:         gitrepositoryworkspaceclient.New(httpClient),
+		WorkspaceKustomizationResourceService:         kustomizationworkspaceclient.New(httpClient),
+		WorkspaceSourcesecretResourceService:          sourcesecretworkspaceclient.New(httpClient),
+		WorkspaceHelmReleaseResourceService:           helmreleaseworkspaceclient.New(httpClient),
+		WorkspaceSecretResourceService:                secretworkspaceclient.New(httpClient),
+		WorkspaceSecretExportResourceService:          secretexportworkspaceclient.New(httpClient),
 	}
 }
 
@@ -199,4 +221,15 @@ type TanzuMissionControl struct {
 	ManagementClusterRegistrationResourceService  managementclusterregistrationclient.ClientService
 	ClusterClassResourceService                   clusterclassclient.ClientService
 	TanzuKubernetesClusterResourceService         tanzukubernetesclusterclient.ClientService
+	TanzuKubernetesReleaseResourceService         tanzukubernetesreleaseclient.ClientService
+	PolicyTemplateResourceService                 policytemplateclient.ClientService
+	PolicyInsightResourceService                  policyinsightclient.ClientService
+	EffectivePolicyResourceService                policyeffectiveclient.ClientService
+	IAMRoleResourceService                        iamroleclient.ClientService
+	WorkspaceGitRepositoryResourceService         gitrepositoryworkspaceclient.ClientService
+	WorkspaceKustomizationResourceService         kustomizationworkspaceclient.ClientService
+	WorkspaceSourcesecretResourceService          sourcesecretworkspaceclient.ClientService
+	WorkspaceHelmReleaseResourceService           helmreleaseworkspaceclient.ClientService
+	WorkspaceSecretResourceService                secretworkspaceclient.ClientService
+	WorkspaceSecretExportResourceService          secretexportworkspaceclient.ClientService
 }
@@ -0,0 +1,107 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamroleclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	iamrolemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_role"
+)
+
+const (
+	apiVersionAndGroup           = "v1alpha1/iam/roles"
+	queryParamKeySearchScopeName = "searchScope.name"
+	queryParamKeyQuery           = "query"
+)
+
+// New creates a new IAM role resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for IAM role resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1IAMRoleResourceServiceCreate(request *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleRequest) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse, error)
+
+	ManageV1alpha1IAMRoleResourceServiceDelete(fn *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName) error
+
+	ManageV1alpha1IAMRoleResourceServiceGet(fn *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse, error)
+
+	ManageV1alpha1IAMRoleResourceServiceList(request *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleListRolesRequest) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleListRolesResponse, error)
+
+	ManageV1alpha1IAMRoleResourceServiceUpdate(request *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleRequest) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse, error)
+}
+
+/*
+ManageV1alpha1IAMRoleResourceServiceCreate creates a custom role.
+*/
+func (c *Client) ManageV1alpha1IAMRoleResourceServiceCreate(request *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleRequest) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse, error) {
+	response := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse{}
+	err := c.Create(apiVersionAndGroup, request, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1IAMRoleResourceServiceUpdate updates a custom role.
+*/
+func (c *Client) ManageV1alpha1IAMRoleResourceServiceUpdate(request *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleRequest) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse, error) {
+	response := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse{}
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Role.FullName.Name).String()
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1IAMRoleResourceServiceDelete deletes a custom role.
+*/
+func (c *Client) ManageV1alpha1IAMRoleResourceServiceDelete(fn *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1IAMRoleResourceServiceGet gets a role.
+*/
+func (c *Client) ManageV1alpha1IAMRoleResourceServiceGet(fn *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).String()
+	response := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1IAMRoleResourceServiceList lists roles.
+*/
+func (c *Client) ManageV1alpha1IAMRoleResourceServiceList(request *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleListRolesRequest) (*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleListRolesResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add(queryParamKeySearchScopeName, request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add(queryParamKeyQuery, request.Query)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	response := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleListRolesResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
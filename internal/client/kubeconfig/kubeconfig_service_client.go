@@ -16,6 +16,8 @@ import (
 const (
 	apiVersionAndGroup                         = "/v1alpha1/clusters"
 	apiKubeconfigPath                          = "kubeconfig"
+	apiAdminKubeconfigPath                     = "adminkubeconfig"
+	queryParamKeyCli                           = "cli"
 	queryParamKeyFullNameManagementClusterName = "full_name.managementClusterName"
 	queryParamKeyFullNameProvisionerName       = "full_name.provisionerName"
 )
@@ -35,12 +37,47 @@ type Client struct {
 // ClientService is the interface for Client methods.
 type ClientService interface {
 	KubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1ClusterFullName) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error)
+
+	KubeconfigServiceGetForCli(fn *models.VmwareTanzuManageV1alpha1ClusterFullName, cli models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error)
+
+	AdminKubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1ClusterFullName) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error)
 }
 
 /*
-KubeconfigServiceGet gets cluster kubeconfig.
+KubeconfigServiceGet gets cluster kubeconfig for the tanzu CLI.
 */
 func (c *Client) KubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1ClusterFullName) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
+	return c.KubeconfigServiceGetForCli(fn, models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTANZUCLI)
+}
+
+/*
+KubeconfigServiceGetForCli gets cluster kubeconfig for the given CLI type.
+*/
+func (c *Client) KubeconfigServiceGetForCli(fn *models.VmwareTanzuManageV1alpha1ClusterFullName, cli models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
+	queryParams := fullNameQueryParams(fn)
+	queryParams.Add(queryParamKeyCli, string(cli))
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name, apiKubeconfigPath).AppendQueryParams(queryParams).String()
+	clusterResponse := &models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse{}
+
+	err := c.Get(requestURL, clusterResponse)
+
+	return clusterResponse, err
+}
+
+/*
+AdminKubeconfigServiceGet gets the admin kubeconfig of a provisioned cluster.
+*/
+func (c *Client) AdminKubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1ClusterFullName) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name, apiAdminKubeconfigPath).AppendQueryParams(fullNameQueryParams(fn)).String()
+	clusterResponse := &models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse{}
+
+	err := c.Get(requestURL, clusterResponse)
+
+	return clusterResponse, err
+}
+
+func fullNameQueryParams(fn *models.VmwareTanzuManageV1alpha1ClusterFullName) url.Values {
 	queryParams := url.Values{}
 	if fn.ManagementClusterName != "" {
 		queryParams.Add(queryParamKeyFullNameManagementClusterName, fn.ManagementClusterName)
@@ -50,12 +87,5 @@ func (c *Client) KubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1Cluste
 		queryParams.Add(queryParamKeyFullNameProvisionerName, fn.ProvisionerName)
 	}
 
-	queryParams.Add("cli", string(models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTANZUCLI))
-
-	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name, apiKubeconfigPath).AppendQueryParams(queryParams).String()
-	clusterResponse := &models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse{}
-
-	err := c.Get(requestURL, clusterResponse)
-
-	return clusterResponse, err
+	return queryParams
 }
@@ -27,6 +27,20 @@ const (
 	reregisterApiVersionAndGroup = "v1alpha1/managementclusters:reregister"
 
 	queryParamKeyOrgID = "fullName.orgId"
+
+	queryParamKeySearchScopeName = "searchScope.name"
+
+	queryParamKeyQuery = "query"
+
+	queryParamKeySortBy = "sortBy"
+
+	queryParamKeyListOrgID = "orgId"
+
+	queryParamKeyPaginationOffset = "pagination.offset"
+
+	queryParamKeyPaginationSize = "pagination.size"
+
+	queryParamKeyIncludeTotalCount = "includeTotalCount"
 )
 
 /*
@@ -53,6 +67,8 @@ type ClientService interface {
 	ManagementClusterResourceServiceUpdate(reguest *registration.VmwareTanzuManageV1alpha1ManagementclusterCreateManagementClusterRequest) (*registration.VmwareTanzuManageV1alpha1ManagementclusterCreateManagementClusterResponse, error)
 
 	ManagementClusterManifestHelperGetManifest(request *registration.VmwareTanzuManageV1alpha1ManagementclusterFullName) (*registration.VmwareTanzuManageV1alpha1ManagementclusterManagementClusterGetManifestResponse, error)
+
+	ManagementClusterResourceServiceList(request *registration.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequest) (*registration.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse, error)
 }
 
 /*
@@ -136,3 +152,47 @@ func (a *Client) ManagementClusterManifestHelperGetManifest(params *registration
 
 	return response, err
 }
+
+/*
+ManagementClusterResourceServiceList lists management clusters
+*/
+func (a *Client) ManagementClusterResourceServiceList(request *registration.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequest) (*registration.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add(queryParamKeySearchScopeName, request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add(queryParamKeyQuery, request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add(queryParamKeySortBy, request.SortBy)
+	}
+
+	if request.OrgID != "" {
+		queryParams.Add(queryParamKeyListOrgID, request.OrgID)
+	}
+
+	if request.Pagination != nil {
+		if request.Pagination.Offset != "" {
+			queryParams.Add(queryParamKeyPaginationOffset, request.Pagination.Offset)
+		}
+
+		if request.Pagination.Size != "" {
+			queryParams.Add(queryParamKeyPaginationSize, request.Pagination.Size)
+		}
+	}
+
+	if request.IncludeTotalCount {
+		queryParams.Add(queryParamKeyIncludeTotalCount, "true")
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	response := &registration.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse{}
+
+	err := a.Get(requestURL, response)
+
+	return response, err
+}
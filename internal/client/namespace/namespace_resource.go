@@ -33,6 +33,8 @@ type ClientService interface {
 
 	ManageV1alpha1NamespaceResourceServiceGet(fn *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceGetNamespaceResponse, error)
 
+	ManageV1alpha1NamespaceResourceServiceList(request *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse, error)
+
 	ManageV1alpha1NamespaceResourceServiceUpdate(request *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceRequest) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceResponse, error)
 }
 
@@ -105,3 +107,63 @@ func (c *Client) ManageV1alpha1NamespaceResourceServiceGet(
 
 	return namespaceResponse, err
 }
+
+/*
+ManageV1alpha1NamespaceResourceServiceList lists namespaces.
+*/
+func (c *Client) ManageV1alpha1NamespaceResourceServiceList(
+	request *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest,
+) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse, error) {
+	queryParams := url.Values{}
+	clusterName := "*"
+
+	if scope := request.SearchScope; scope != nil {
+		if scope.ClusterName != "" {
+			clusterName = scope.ClusterName
+		}
+
+		if scope.Name != "" {
+			queryParams["searchScope.name"] = []string{scope.Name}
+		}
+
+		if scope.ManagementClusterName != "" {
+			queryParams["searchScope.managementClusterName"] = []string{scope.ManagementClusterName}
+		}
+
+		if scope.ProvisionerName != "" {
+			queryParams["searchScope.provisionerName"] = []string{scope.ProvisionerName}
+		}
+
+		if scope.WorkspaceName != "" {
+			queryParams["searchScope.workspaceName"] = []string{scope.WorkspaceName}
+		}
+	}
+
+	if request.Query != "" {
+		queryParams["query"] = []string{request.Query}
+	}
+
+	if request.SortBy != "" {
+		queryParams["sortBy"] = []string{request.SortBy}
+	}
+
+	if request.Pagination != nil {
+		if request.Pagination.Offset != "" {
+			queryParams["pagination.offset"] = []string{request.Pagination.Offset}
+		}
+
+		if request.Pagination.Size != "" {
+			queryParams["pagination.size"] = []string{request.Pagination.Size}
+		}
+	}
+
+	if request.IncludeTotalCount {
+		queryParams["includeTotalCount"] = []string{"true"}
+	}
+
+	requestURL := fmt.Sprintf("%s/%s/%s?%s", "v1alpha1/clusters", clusterName, "namespaces", queryParams.Encode())
+	namespacesResponse := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse{}
+	err := c.Get(requestURL, namespacesResponse)
+
+	return namespacesResponse, err
+}
@@ -31,6 +31,8 @@ type ClientService interface {
 
 	ManageV1alpha1ClusterNodePoolResourceServiceGet(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error)
 
+	ManageV1alpha1ClusterNodePoolResourceServiceList(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolListNodepoolsResponse, error)
+
 	ManageV1alpha1ClusterNodePoolResourceServiceDelete(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) error
 
 	ManageV1alpha1ClusterNodePoolResourceServiceUpdate(request *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolRequest) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error)
@@ -69,6 +71,27 @@ func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceGet(fn *nodepoolsmo
 	return clusterNodePoolResponse, err
 }
 
+/*
+ManageV1alpha1ClusterNodePoolResourceServiceList lists the node pools of a cluster.
+*/
+func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceList(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolListNodepoolsResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams["fullName.managementClusterName"] = []string{fn.ManagementClusterName}
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams["fullName.provisionerName"] = []string{fn.ProvisionerName}
+	}
+
+	requestURL := fmt.Sprintf("%s/%s/%s?%s", "v1alpha1/clusters", fn.ClusterName, "nodepools", queryParams.Encode())
+	clusterNodePoolListResponse := &nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolListNodepoolsResponse{}
+	err := c.Get(requestURL, clusterNodePoolListResponse)
+
+	return clusterNodePoolListResponse, err
+}
+
 func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceDelete(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) error {
 	queryParams := url.Values{}
 
@@ -0,0 +1,72 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyeffectiveclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/clusters"
+	namespacesPath     = "namespaces"
+	apiKind            = "policies:effective"
+
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyPolicyType            = "policyType"
+)
+
+// New creates a new effective policy resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for effective policy resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	EffectivePolicyResourceServiceList(request *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest) (*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse, error)
+}
+
+/*
+EffectivePolicyResourceServiceList lists the policies applying to a cluster, or to a namespace when the namespace name is set.
+*/
+func (c *Client) EffectivePolicyResourceServiceList(request *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest) (*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse, error) {
+	fn := request.FullName
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if request.PolicyType != "" {
+		queryParams.Add(queryParamKeyPolicyType, request.PolicyType)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind)
+
+	if fn.NamespaceName != "" {
+		requestURL = helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, namespacesPath, fn.NamespaceName, apiKind)
+	}
+
+	response := &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse{}
+	err := c.Get(requestURL.AppendQueryParams(queryParams).String(), response)
+
+	return response, err
+}
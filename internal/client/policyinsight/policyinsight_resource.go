@@ -0,0 +1,89 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyinsightclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyinsightmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insight"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/policy/insights"
+
+	queryParamKeySearchScopeClusterGroupName      = "searchScope.clusterGroupName"
+	queryParamKeySearchScopeWorkspaceName         = "searchScope.workspaceName"
+	queryParamKeySearchScopeManagementClusterName = "searchScope.managementClusterName"
+	queryParamKeySearchScopeProvisionerName       = "searchScope.provisionerName"
+	queryParamKeySearchScopeClusterName           = "searchScope.clusterName"
+	queryParamKeyPolicyType                       = "policyType"
+	queryParamKeyPolicyName                       = "policyName"
+	queryParamKeySeverity                         = "severity"
+	queryParamKeyQuery                            = "query"
+	queryParamKeyPaginationOffset                 = "pagination.offset"
+	queryParamKeyPaginationSize                   = "pagination.size"
+	queryParamKeyIncludeTotalCount                = "includeTotalCount"
+)
+
+// New creates a new policy insight resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for policy insight resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	PolicyInsightResourceServiceList(request *policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest) (*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse, error)
+}
+
+/*
+PolicyInsightResourceServiceList lists the policy insights matching the request.
+*/
+func (c *Client) PolicyInsightResourceServiceList(request *policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest) (*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse, error) {
+	queryParams := url.Values{}
+
+	if scope := request.SearchScope; scope != nil {
+		addQueryParam(queryParams, queryParamKeySearchScopeClusterGroupName, scope.ClusterGroupName)
+		addQueryParam(queryParams, queryParamKeySearchScopeWorkspaceName, scope.WorkspaceName)
+		addQueryParam(queryParams, queryParamKeySearchScopeManagementClusterName, scope.ManagementClusterName)
+		addQueryParam(queryParams, queryParamKeySearchScopeProvisionerName, scope.ProvisionerName)
+		addQueryParam(queryParams, queryParamKeySearchScopeClusterName, scope.ClusterName)
+	}
+
+	addQueryParam(queryParams, queryParamKeyPolicyType, request.PolicyType)
+	addQueryParam(queryParams, queryParamKeyPolicyName, request.PolicyName)
+	addQueryParam(queryParams, queryParamKeySeverity, request.Severity)
+	addQueryParam(queryParams, queryParamKeyQuery, request.Query)
+
+	if request.Pagination != nil {
+		addQueryParam(queryParams, queryParamKeyPaginationOffset, request.Pagination.Offset)
+		addQueryParam(queryParams, queryParamKeyPaginationSize, request.Pagination.Size)
+	}
+
+	if request.IncludeTotalCount {
+		queryParams.Add(queryParamKeyIncludeTotalCount, "true")
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	response := &policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+func addQueryParam(queryParams url.Values, key, value string) {
+	if value != "" {
+		queryParams.Add(key, value)
+	}
+}
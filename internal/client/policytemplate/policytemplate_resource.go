@@ -0,0 +1,80 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policytemplateclient
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/template"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/policy/templates"
+)
+
+// New creates a new policy template resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for policy template resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	PolicyTemplateResourceServiceCreate(request *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error)
+
+	PolicyTemplateResourceServiceDelete(fn *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) error
+
+	PolicyTemplateResourceServiceGet(fn *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) (*policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error)
+
+	PolicyTemplateResourceServiceUpdate(request *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error)
+}
+
+/*
+PolicyTemplateResourceServiceCreate creates a policy template.
+*/
+func (c *Client) PolicyTemplateResourceServiceCreate(request *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	response := &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{}
+	err := c.Create(apiVersionAndGroup, request, response)
+
+	return response, err
+}
+
+/*
+PolicyTemplateResourceServiceUpdate updates a policy template.
+*/
+func (c *Client) PolicyTemplateResourceServiceUpdate(request *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	response := &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{}
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Template.FullName.Name).String()
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+PolicyTemplateResourceServiceDelete deletes a policy template.
+*/
+func (c *Client) PolicyTemplateResourceServiceDelete(fn *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+PolicyTemplateResourceServiceGet gets a policy template.
+*/
+func (c *Client) PolicyTemplateResourceServiceGet(fn *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) (*policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).String()
+	response := &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
@@ -0,0 +1,48 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetesreleaseclient
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tkrmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetesrelease"
+)
+
+const (
+	apiVersionAndGroup          = "v1alpha1/managementclusters"
+	provisionersPath            = "provisioners"
+	tanzuKubernetesReleasesPath = "tanzukubernetesreleases"
+)
+
+// New creates a new tanzu kubernetes release resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for tanzu kubernetes release resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	TanzuKubernetesReleaseResourceServiceList(fn *tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName) (*tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse, error)
+}
+
+/*
+TanzuKubernetesReleaseResourceServiceList lists the tanzu kubernetes releases available on a provisioner.
+*/
+func (c *Client) TanzuKubernetesReleaseResourceServiceList(
+	fn *tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName,
+) (*tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, provisionersPath, fn.ProvisionerName, tanzuKubernetesReleasesPath).String()
+	resp := &tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse{}
+	err := c.Get(requestURL, resp)
+
+	return resp, err
+}
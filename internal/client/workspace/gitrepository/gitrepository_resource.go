@@ -0,0 +1,110 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package gitrepositoryworkspaceclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
+)
+
+// Workspace scoped resources are served at
+// v1alpha1/workspaces/{fullName.workspaceName}/namespace/fluxcd/gitrepositories, with the singular
+// "namespace" sub group also used by the cluster group clients.
+const (
+	apiVersionAndGroup         = "v1alpha1/workspaces"
+	apiSubGroup                = "namespace"
+	apiKind                    = "fluxcd/gitrepositories"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new workspace Flux CD git repository resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for workspace Flux CD git repository resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceCreate(request *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest) (*gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceDelete(fn *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName) error
+
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceGet(fn *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName) (*gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceUpdate(request *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest) (*gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceCreate creates a Flux CD git repository scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceCreate(request *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest) (*gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.GitRepository.FullName.WorkspaceName, apiSubGroup, apiKind).String()
+	fluxCDGitRepositoryWorkspaceResponse := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse{}
+	err := p.Create(requestURL, request, fluxCDGitRepositoryWorkspaceResponse)
+
+	return fluxCDGitRepositoryWorkspaceResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceDelete deletes a Flux CD git repository scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceDelete(fn *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceGet gets a Flux CD git repository scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceGet(fn *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName) (*gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	fluxCDGitRepositoryWorkspaceResponse := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse{}
+	err := p.Get(requestURL, fluxCDGitRepositoryWorkspaceResponse)
+
+	return fluxCDGitRepositoryWorkspaceResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceUpdate updates overwrite a Flux CD git repository scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceUpdate(request *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest) (*gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.GitRepository.FullName.WorkspaceName, apiSubGroup, apiKind, request.GitRepository.FullName.Name).String()
+	fluxCDGitRepositoryWorkspaceResponse := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse{}
+	err := p.Update(requestURL, request, fluxCDGitRepositoryWorkspaceResponse)
+
+	return fluxCDGitRepositoryWorkspaceResponse, err
+}
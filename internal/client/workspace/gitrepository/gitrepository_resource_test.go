@@ -0,0 +1,98 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package gitrepositoryworkspaceclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
+)
+
+func TestRequestURL(t *testing.T) {
+	t.Parallel()
+
+	fullName := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName{
+		WorkspaceName: "tf-workspace",
+		NamespaceName: "tf-namespace",
+		Name:          "tf-git-repository",
+		OrgID:         "tf-org",
+	}
+	request := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest{
+		GitRepository: &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepository{
+			FullName: fullName,
+		},
+	}
+
+	cases := []struct {
+		description string
+		invoke      func(client ClientService) error
+		method      string
+		expected    string
+	}{
+		{
+			description: "create",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceCreate(request)
+				return err
+			},
+			method:   http.MethodPost,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/gitrepositories",
+		},
+		{
+			description: "get",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceGet(fullName)
+				return err
+			},
+			method:   http.MethodGet,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/gitrepositories/tf-git-repository?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+		{
+			description: "update",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceUpdate(request)
+				return err
+			},
+			method:   http.MethodPut,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/gitrepositories/tf-git-repository",
+		},
+		{
+			description: "delete",
+			invoke: func(client ClientService) error {
+				return client.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceDelete(fullName)
+			},
+			method:   http.MethodDelete,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/gitrepositories/tf-git-repository?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			var method, requestURL string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, requestURL = r.Method, r.URL.RequestURI()
+				_, _ = w.Write([]byte("{}"))
+			}))
+			t.Cleanup(server.Close)
+
+			client := transport.NewClientWithDefaultTransport()
+			client.Host = server.URL
+
+			require.NoError(t, test.invoke(New(client)))
+			require.Equal(t, test.method, method)
+			require.Equal(t, test.expected, requestURL)
+		})
+	}
+}
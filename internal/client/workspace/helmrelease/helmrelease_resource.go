@@ -0,0 +1,110 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseworkspaceclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmreleaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
+)
+
+// Workspace scoped resources are served at
+// v1alpha1/workspaces/{fullName.workspaceName}/namespace/fluxcd/helm/releases, with the singular
+// "namespace" sub group also used by the cluster group clients.
+const (
+	apiVersionAndGroup         = "v1alpha1/workspaces"
+	apiSubGroup                = "namespace"
+	apiKind                    = "fluxcd/helm/releases"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new workspace Flux CD helm release resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for workspace Flux CD helm release resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1WorkspaceFluxcdHelmReleaseResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceCreate(request *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest) (*helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse, error)
+
+	VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceDelete(fn *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName) error
+
+	VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceGet(fn *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName) (*helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseGetResponse, error)
+
+	VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceUpdate(request *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest) (*helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceCreate creates a Flux CD helm release scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceCreate(request *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest) (*helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Release.FullName.WorkspaceName, apiSubGroup, apiKind).String()
+	releaseWorkspaceResponse := &helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse{}
+	err := p.Create(requestURL, request, releaseWorkspaceResponse)
+
+	return releaseWorkspaceResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceDelete deletes a Flux CD helm release scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceDelete(fn *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceGet gets a Flux CD helm release scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceGet(fn *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName) (*helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseGetResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	releaseWorkspaceResponse := &helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseGetResponse{}
+	err := p.Get(requestURL, releaseWorkspaceResponse)
+
+	return releaseWorkspaceResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceUpdate updates overwrite a Flux CD helm release scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceUpdate(request *helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest) (*helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Release.FullName.WorkspaceName, apiSubGroup, apiKind, request.Release.FullName.Name).String()
+	releaseWorkspaceResponse := &helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse{}
+	err := p.Update(requestURL, request, releaseWorkspaceResponse)
+
+	return releaseWorkspaceResponse, err
+}
@@ -0,0 +1,98 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseworkspaceclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	helmreleaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
+)
+
+func TestRequestURL(t *testing.T) {
+	t.Parallel()
+
+	fullName := &helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName{
+		WorkspaceName: "tf-workspace",
+		NamespaceName: "tf-namespace",
+		Name:          "tf-release",
+		OrgID:         "tf-org",
+	}
+	request := &helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest{
+		Release: &helmreleaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease{
+			FullName: fullName,
+		},
+	}
+
+	cases := []struct {
+		description string
+		invoke      func(client ClientService) error
+		method      string
+		expected    string
+	}{
+		{
+			description: "create",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceCreate(request)
+				return err
+			},
+			method:   http.MethodPost,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/helm/releases",
+		},
+		{
+			description: "get",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceGet(fullName)
+				return err
+			},
+			method:   http.MethodGet,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/helm/releases/tf-release?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+		{
+			description: "update",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceUpdate(request)
+				return err
+			},
+			method:   http.MethodPut,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/helm/releases/tf-release",
+		},
+		{
+			description: "delete",
+			invoke: func(client ClientService) error {
+				return client.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceDelete(fullName)
+			},
+			method:   http.MethodDelete,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/helm/releases/tf-release?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			var method, requestURL string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, requestURL = r.Method, r.URL.RequestURI()
+				_, _ = w.Write([]byte("{}"))
+			}))
+			t.Cleanup(server.Close)
+
+			client := transport.NewClientWithDefaultTransport()
+			client.Host = server.URL
+
+			require.NoError(t, test.invoke(New(client)))
+			require.Equal(t, test.method, method)
+			require.Equal(t, test.expected, requestURL)
+		})
+	}
+}
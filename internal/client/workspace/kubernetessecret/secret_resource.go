@@ -0,0 +1,110 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kubernetessecretworkspaceclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	secret "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
+)
+
+// Workspace scoped resources are served at
+// v1alpha1/workspaces/{fullName.workspaceName}/namespace/secrets, with the singular
+// "namespace" sub group also used by the cluster group clients.
+const (
+	apiVersionAndGroup         = "v1alpha1/workspaces"
+	apiKind                    = "secrets"
+	apiSubGroup                = "namespace"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new workspace secret resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for workspace secret resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	SecretResourceServiceCreate(request *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse, error)
+
+	SecretResourceServiceDelete(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName) error
+
+	SecretResourceServiceGet(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse, error)
+
+	SecretResourceServiceUpdate(request *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse, error)
+}
+
+/*
+SecretResourceServiceCreate creates a secret.
+*/
+func (c *Client) SecretResourceServiceCreate(request *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Secret.FullName.WorkspaceName, apiSubGroup, apiKind).String()
+	secretResponse := &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse{}
+	err := c.Create(requestURL, request, secretResponse)
+
+	return secretResponse, err
+}
+
+/*
+SecretResourceServiceDelete deletes a secret.
+*/
+func (c *Client) SecretResourceServiceDelete(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+SecretResourceServiceGet gets a secret.
+*/
+func (c *Client) SecretResourceServiceGet(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	secretResponse := &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse{}
+	err := c.Get(requestURL, secretResponse)
+
+	return secretResponse, err
+}
+
+/*
+SecretResourceServiceUpdate updates overwrite a secret.
+*/
+func (c *Client) SecretResourceServiceUpdate(request *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Secret.FullName.WorkspaceName, apiSubGroup, apiKind, request.Secret.FullName.Name).String()
+	secretResponse := &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse{}
+	err := c.Update(requestURL, request, secretResponse)
+
+	return secretResponse, err
+}
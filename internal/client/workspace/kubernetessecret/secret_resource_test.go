@@ -0,0 +1,98 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kubernetessecretworkspaceclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	secret "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
+)
+
+func TestRequestURL(t *testing.T) {
+	t.Parallel()
+
+	fullName := &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName{
+		WorkspaceName: "tf-workspace",
+		NamespaceName: "tf-namespace",
+		Name:          "tf-secret",
+		OrgID:         "tf-org",
+	}
+	request := &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest{
+		Secret: &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret{
+			FullName: fullName,
+		},
+	}
+
+	cases := []struct {
+		description string
+		invoke      func(client ClientService) error
+		method      string
+		expected    string
+	}{
+		{
+			description: "create",
+			invoke: func(client ClientService) error {
+				_, err := client.SecretResourceServiceCreate(request)
+				return err
+			},
+			method:   http.MethodPost,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/secrets",
+		},
+		{
+			description: "get",
+			invoke: func(client ClientService) error {
+				_, err := client.SecretResourceServiceGet(fullName)
+				return err
+			},
+			method:   http.MethodGet,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/secrets/tf-secret?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+		{
+			description: "update",
+			invoke: func(client ClientService) error {
+				_, err := client.SecretResourceServiceUpdate(request)
+				return err
+			},
+			method:   http.MethodPut,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/secrets/tf-secret",
+		},
+		{
+			description: "delete",
+			invoke: func(client ClientService) error {
+				return client.SecretResourceServiceDelete(fullName)
+			},
+			method:   http.MethodDelete,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/secrets/tf-secret?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			var method, requestURL string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, requestURL = r.Method, r.URL.RequestURI()
+				_, _ = w.Write([]byte("{}"))
+			}))
+			t.Cleanup(server.Close)
+
+			client := transport.NewClientWithDefaultTransport()
+			client.Host = server.URL
+
+			require.NoError(t, test.invoke(New(client)))
+			require.Equal(t, test.method, method)
+			require.Equal(t, test.expected, requestURL)
+		})
+	}
+}
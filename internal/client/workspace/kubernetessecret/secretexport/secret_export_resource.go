@@ -0,0 +1,97 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package secretexportworkspaceclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	secret "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace/secretexport"
+)
+
+// Workspace scoped resources are served at
+// v1alpha1/workspaces/{fullName.workspaceName}/namespace/secretexports, with the singular
+// "namespace" sub group also used by the cluster group clients.
+const (
+	apiVersionAndGroup         = "v1alpha1/workspaces"
+	apiKind                    = "secretexports"
+	apiSubGroup                = "namespace"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new workspace secret export resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for workspace secret export resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	SecretExportResourceServiceCreate(request *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse, error)
+
+	SecretExportResourceServiceDelete(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName) error
+
+	SecretExportResourceServiceGet(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse, error)
+}
+
+/*
+SecretExportResourceServiceCreate creates a secret export.
+*/
+func (c *Client) SecretExportResourceServiceCreate(request *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.SecretExport.FullName.WorkspaceName, apiSubGroup, apiKind).String()
+	secretexportResponse := &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse{}
+	err := c.Create(requestURL, request, secretexportResponse)
+
+	return secretexportResponse, err
+}
+
+/*
+SecretExportResourceServiceDelete deletes a secret export.
+*/
+func (c *Client) SecretExportResourceServiceDelete(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+SecretExportResourceServiceGet gets a secret export.
+*/
+func (c *Client) SecretExportResourceServiceGet(fn *secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName) (*secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	secretexportResponse := &secret.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse{}
+	err := c.Get(requestURL, secretexportResponse)
+
+	return secretexportResponse, err
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kustomizationworkspaceclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	kustomizationworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/workspace"
+)
+
+// Workspace scoped resources are served at
+// v1alpha1/workspaces/{fullName.workspaceName}/namespace/fluxcd/kustomizations, with the singular
+// "namespace" sub group also used by the cluster group clients.
+const (
+	apiVersionAndGroup         = "v1alpha1/workspaces"
+	apiSubGroup                = "namespace"
+	apiKind                    = "fluxcd/kustomizations"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new workspace Flux CD kustomization resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for workspace Flux CD kustomization resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceCreate(request *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest) (*kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse, error)
+
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceDelete(fn *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationFullName) error
+
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceGet(fn *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationFullName) (*kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationGetKustomizationResponse, error)
+
+	VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceUpdate(request *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest) (*kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceCreate creates a Flux CD kustomization scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceCreate(request *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest) (*kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Kustomization.FullName.WorkspaceName, apiSubGroup, apiKind).String()
+	fluxCDKustomizationWorkspaceResponse := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse{}
+	err := p.Create(requestURL, request, fluxCDKustomizationWorkspaceResponse)
+
+	return fluxCDKustomizationWorkspaceResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceDelete deletes a Flux CD kustomization scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceDelete(fn *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceGet gets a Flux CD kustomization scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceGet(fn *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationFullName) (*kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationGetKustomizationResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	fluxCDKustomizationWorkspaceResponse := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationGetKustomizationResponse{}
+	err := p.Get(requestURL, fluxCDKustomizationWorkspaceResponse)
+
+	return fluxCDKustomizationWorkspaceResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceUpdate updates overwrite a Flux CD kustomization scoped to a workspace resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceUpdate(request *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest) (*kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Kustomization.FullName.WorkspaceName, apiSubGroup, apiKind, request.Kustomization.FullName.Name).String()
+	fluxCDKustomizationWorkspaceResponse := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse{}
+	err := p.Update(requestURL, request, fluxCDKustomizationWorkspaceResponse)
+
+	return fluxCDKustomizationWorkspaceResponse, err
+}
@@ -0,0 +1,98 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kustomizationworkspaceclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	kustomizationworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/workspace"
+)
+
+func TestRequestURL(t *testing.T) {
+	t.Parallel()
+
+	fullName := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationFullName{
+		WorkspaceName: "tf-workspace",
+		NamespaceName: "tf-namespace",
+		Name:          "tf-kustomization",
+		OrgID:         "tf-org",
+	}
+	request := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest{
+		Kustomization: &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomization{
+			FullName: fullName,
+		},
+	}
+
+	cases := []struct {
+		description string
+		invoke      func(client ClientService) error
+		method      string
+		expected    string
+	}{
+		{
+			description: "create",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceCreate(request)
+				return err
+			},
+			method:   http.MethodPost,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/kustomizations",
+		},
+		{
+			description: "get",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceGet(fullName)
+				return err
+			},
+			method:   http.MethodGet,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/kustomizations/tf-kustomization?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+		{
+			description: "update",
+			invoke: func(client ClientService) error {
+				_, err := client.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceUpdate(request)
+				return err
+			},
+			method:   http.MethodPut,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/kustomizations/tf-kustomization",
+		},
+		{
+			description: "delete",
+			invoke: func(client ClientService) error {
+				return client.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceDelete(fullName)
+			},
+			method:   http.MethodDelete,
+			expected: "/v1alpha1/workspaces/tf-workspace/namespace/fluxcd/kustomizations/tf-kustomization?fullName.namespaceName=tf-namespace&fullName.orgID=tf-org",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			var method, requestURL string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, requestURL = r.Method, r.URL.RequestURI()
+				_, _ = w.Write([]byte("{}"))
+			}))
+			t.Cleanup(server.Close)
+
+			client := transport.NewClientWithDefaultTransport()
+			client.Host = server.URL
+
+			require.NoError(t, test.invoke(New(client)))
+			require.Equal(t, test.method, method)
+			require.Equal(t, test.expected, requestURL)
+		})
+	}
+}
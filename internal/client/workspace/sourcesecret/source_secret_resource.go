@@ -0,0 +1,98 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package sourcesecretworkspaceclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	sourcesecretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/workspace"
+)
+
+const (
+	apiVersionAndGroup         = "v1alpha1/workspaces"
+	apiKind                    = "fluxcd/sourcesecrets"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new workspace Flux CD source secret resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for workspace Flux CD source secret resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for ManageV1alpha1WorkspaceFluxcdSourcesecretResourceService Client methods.
+type ClientService interface {
+	ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceCreate(request *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest) (*sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse, error)
+
+	ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceDelete(fn *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretFullName) error
+
+	ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceGet(fn *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretFullName) (*sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse, error)
+
+	ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceUpdate(request *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest) (*sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse, error)
+}
+
+/*
+ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceCreate creates a Flux CD source secret scoped to a workspace resource.
+*/
+func (p *Client) ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceCreate(request *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest) (*sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.SourceSecret.FullName.WorkspaceName, apiKind).String()
+	fluxCDSourcesecretWorkspaceResponse := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse{}
+	err := p.Create(requestURL, request, fluxCDSourcesecretWorkspaceResponse)
+
+	return fluxCDSourcesecretWorkspaceResponse, err
+}
+
+/*
+ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceDelete deletes a Flux CD source secret scoped to a workspace resource.
+*/
+func (p *Client) ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceDelete(fn *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretFullName) error {
+	queryParams := url.Values{}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceGet gets a Flux CD source secret scoped to a workspace resource.
+*/
+func (p *Client) ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceGet(fn *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretFullName) (*sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	fluxCDSourcesecretWorkspaceResponse := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse{}
+	err := p.Get(requestURL, fluxCDSourcesecretWorkspaceResponse)
+
+	return fluxCDSourcesecretWorkspaceResponse, err
+}
+
+/*
+ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceUpdate updates overwrite a Flux CD source secret scoped to a workspace resource.
+*/
+func (p *Client) ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceUpdate(request *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest) (*sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.SourceSecret.FullName.WorkspaceName, apiKind, request.SourceSecret.FullName.Name).String()
+	fluxCDSourcesecretWorkspaceResponse := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse{}
+	err := p.Update(requestURL, request, fluxCDSourcesecretWorkspaceResponse)
+
+	return fluxCDSourcesecretWorkspaceResponse, err
+}
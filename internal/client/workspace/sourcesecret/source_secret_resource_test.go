@@ -0,0 +1,97 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package sourcesecretworkspaceclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	sourcesecretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/workspace"
+)
+
+func TestRequestURL(t *testing.T) {
+	t.Parallel()
+
+	fullName := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretFullName{
+		WorkspaceName: "tf-workspace",
+		Name:          "tf-source-secret",
+		OrgID:         "tf-org",
+	}
+	request := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest{
+		SourceSecret: &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSourceSecret{
+			FullName: fullName,
+		},
+	}
+
+	cases := []struct {
+		description string
+		invoke      func(client ClientService) error
+		method      string
+		expected    string
+	}{
+		{
+			description: "create",
+			invoke: func(client ClientService) error {
+				_, err := client.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceCreate(request)
+				return err
+			},
+			method:   http.MethodPost,
+			expected: "/v1alpha1/workspaces/tf-workspace/fluxcd/sourcesecrets",
+		},
+		{
+			description: "get",
+			invoke: func(client ClientService) error {
+				_, err := client.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceGet(fullName)
+				return err
+			},
+			method:   http.MethodGet,
+			expected: "/v1alpha1/workspaces/tf-workspace/fluxcd/sourcesecrets/tf-source-secret?fullName.orgID=tf-org",
+		},
+		{
+			description: "update",
+			invoke: func(client ClientService) error {
+				_, err := client.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceUpdate(request)
+				return err
+			},
+			method:   http.MethodPut,
+			expected: "/v1alpha1/workspaces/tf-workspace/fluxcd/sourcesecrets/tf-source-secret",
+		},
+		{
+			description: "delete",
+			invoke: func(client ClientService) error {
+				return client.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceDelete(fullName)
+			},
+			method:   http.MethodDelete,
+			expected: "/v1alpha1/workspaces/tf-workspace/fluxcd/sourcesecrets/tf-source-secret?fullName.orgID=tf-org",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			var method, requestURL string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, requestURL = r.Method, r.URL.RequestURI()
+				_, _ = w.Write([]byte("{}"))
+			}))
+			t.Cleanup(server.Close)
+
+			client := transport.NewClientWithDefaultTransport()
+			client.Host = server.URL
+
+			require.NoError(t, test.invoke(New(client)))
+			require.Equal(t, test.method, method)
+			require.Equal(t, test.expected, requestURL)
+		})
+	}
+}
@@ -7,11 +7,22 @@ package workspaceclient
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
 )
 
+const (
+	queryParamKeySearchScopeName   = "searchScope.name"
+	queryParamKeyQuery             = "query"
+	queryParamKeySortBy            = "sortBy"
+	queryParamKeyPaginationOffset  = "pagination.offset"
+	queryParamKeyPaginationSize    = "pagination.size"
+	queryParamKeyIncludeTotalCount = "includeTotalCount"
+)
+
 // New creates a new workspace resource service API client.
 func New(transport *transport.Client) ClientService {
 	return &Client{Client: transport}
@@ -32,6 +43,8 @@ type ClientService interface {
 
 	ManageV1alpha1WorkspaceResourceServiceGet(fn *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName) (*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceGetWorkspaceResponse, error)
 
+	ManageV1alpha1WorkspaceResourceServiceList(request *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest) (*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse, error)
+
 	ManageV1alpha1WorkspaceResourceServiceUpdate(fn *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceRequest) (*workspacemodel.VmwareTanzuManageV1alphaWorkspaceResponse, error)
 }
 
@@ -76,3 +89,42 @@ func (c *Client) ManageV1alpha1WorkspaceResourceServiceDelete(
 
 	return c.Delete(requestURL)
 }
+
+// ManageV1alpha1WorkspaceResourceServiceList lists workspaces.
+func (c *Client) ManageV1alpha1WorkspaceResourceServiceList(
+	request *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest,
+) (*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add(queryParamKeySearchScopeName, request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add(queryParamKeyQuery, request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add(queryParamKeySortBy, request.SortBy)
+	}
+
+	if request.Pagination != nil {
+		if request.Pagination.Offset != "" {
+			queryParams.Add(queryParamKeyPaginationOffset, request.Pagination.Offset)
+		}
+
+		if request.Pagination.Size != "" {
+			queryParams.Add(queryParamKeyPaginationSize, request.Pagination.Size)
+		}
+	}
+
+	if request.IncludeTotalCount {
+		queryParams.Add(queryParamKeyIncludeTotalCount, "true")
+	}
+
+	requestURL := helper.ConstructRequestURL("v1alpha1/workspaces").AppendQueryParams(queryParams).String()
+	listResponse := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse{}
+	err := c.Get(requestURL, listResponse)
+
+	return listResponse, err
+}
@@ -0,0 +1,17 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+// MatchLabels reports whether the labels contain every key and value of the selector.
+func MatchLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchLabels(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		labels      map[string]string
+		selector    map[string]string
+		expected    bool
+	}{
+		{
+			description: "labels with all the selector labels",
+			labels:      map[string]string{"env": "prod", "team": "payments"},
+			selector:    map[string]string{"env": "prod"},
+			expected:    true,
+		},
+		{
+			description: "labels with a different value of a selector label",
+			labels:      map[string]string{"env": "dev", "team": "payments"},
+			selector:    map[string]string{"env": "prod"},
+			expected:    false,
+		},
+		{
+			description: "labels without one of the selector labels",
+			labels:      map[string]string{"env": "prod"},
+			selector:    map[string]string{"env": "prod", "team": "payments"},
+			expected:    false,
+		},
+		{
+			description: "nil labels",
+			labels:      nil,
+			selector:    map[string]string{"env": "prod"},
+			expected:    false,
+		},
+		{
+			description: "empty selector",
+			labels:      nil,
+			selector:    nil,
+			expected:    true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, MatchLabels(test.labels, test.selector))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultPageSize is the number of records requested per page when listing all the records of a resource.
+const DefaultPageSize = 100
+
+// Pager lists the page of records starting at offset, returns the number of records in the page and the total count of records, 0 when unknown.
+type Pager func(offset, size int) (count int, totalCount int, err error)
+
+// ListAllPages requests consecutive pages until the total count of records is reached, or when the total count is unknown
+// until a page is short. The offset advances by the records received, the server may return fewer records than requested.
+func ListAllPages(pageSize int, pager Pager) error {
+	for offset := 0; ; {
+		count, totalCount, err := pager(offset, pageSize)
+		if err != nil {
+			return err
+		}
+
+		if totalCount > 0 {
+			if count == 0 || offset+count >= totalCount {
+				return nil
+			}
+		} else if count < pageSize {
+			return nil
+		}
+
+		offset += count
+	}
+}
+
+// LabelSelectorString returns the labels of the selector as comma separated key=value pairs, sorted so that the result does not depend on map ordering.
+func LabelSelectorString(selector map[string]string) string {
+	pairs := make([]string, 0, len(selector))
+
+	for key, value := range selector {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
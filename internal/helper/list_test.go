@@ -0,0 +1,144 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAllPages(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description     string
+		records         int
+		reportTotal     bool
+		pageSize        int
+		serverPageSize  int
+		totalCount      int
+		expectedOffsets []int
+	}{
+		{
+			description:     "no records",
+			records:         0,
+			pageSize:        2,
+			expectedOffsets: []int{0},
+		},
+		{
+			description:     "last page is short",
+			records:         5,
+			pageSize:        2,
+			expectedOffsets: []int{0, 2, 4},
+		},
+		{
+			description:     "last page is full without total count",
+			records:         4,
+			pageSize:        2,
+			expectedOffsets: []int{0, 2, 4},
+		},
+		{
+			description:     "last page is full with total count",
+			records:         4,
+			reportTotal:     true,
+			pageSize:        2,
+			expectedOffsets: []int{0, 2},
+		},
+		{
+			description:     "server caps the page size",
+			records:         5,
+			reportTotal:     true,
+			pageSize:        4,
+			serverPageSize:  2,
+			expectedOffsets: []int{0, 2, 4},
+		},
+		{
+			description:     "empty page before the total count is reached",
+			records:         3,
+			reportTotal:     true,
+			totalCount:      5,
+			pageSize:        2,
+			expectedOffsets: []int{0, 2, 3},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			offsets := make([]int, 0)
+
+			err := ListAllPages(test.pageSize, func(offset, size int) (int, int, error) {
+				offsets = append(offsets, offset)
+
+				if test.serverPageSize > 0 && size > test.serverPageSize {
+					size = test.serverPageSize
+				}
+
+				count := test.records - offset
+				if count > size {
+					count = size
+				}
+
+				if count < 0 {
+					count = 0
+				}
+
+				if test.totalCount > 0 {
+					return count, test.totalCount, nil
+				}
+
+				if test.reportTotal {
+					return count, test.records, nil
+				}
+
+				return count, 0, nil
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedOffsets, offsets)
+		})
+	}
+}
+
+func TestListAllPagesError(t *testing.T) {
+	t.Parallel()
+
+	err := ListAllPages(DefaultPageSize, func(offset, size int) (int, int, error) {
+		return 0, 0, errors.New("internal server error")
+	})
+
+	require.Error(t, err)
+}
+
+func TestLabelSelectorString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		selector    map[string]string
+		expected    string
+	}{
+		{
+			description: "nil selector",
+			selector:    nil,
+			expected:    "",
+		},
+		{
+			description: "labels are sorted by key",
+			selector:    map[string]string{"team": "payments", "env": "prod"},
+			expected:    "env=prod,team=payments",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, LabelSelectorString(test.selector))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustermodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1ClusterSearchScope Scope to search clusters by.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.SearchScope
+type VmwareTanzuManageV1alpha1ClusterSearchScope struct {
+
+	// Scope search to the specified cluster group; supports globbing.
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Scope search to the specified management cluster name; supports globbing.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Scope search to the specified cluster name; supports globbing.
+	Name string `json:"name,omitempty"`
+
+	// Scope search to the specified provisioner name; supports globbing.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterListClustersRequest Request to list clusters.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.ListClustersRequest
+type VmwareTanzuManageV1alpha1ClusterListClustersRequest struct {
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1ClusterSearchScope `json:"searchScope,omitempty"`
+
+	// Sort order.
+	SortBy string `json:"sortBy,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterListClustersRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterListClustersRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterListClustersRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterListClustersResponse Response from listing clusters.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.ListClustersResponse
+type VmwareTanzuManageV1alpha1ClusterListClustersResponse struct {
+
+	// List of clusters.
+	Clusters []*VmwareTanzuManageV1alpha1ClusterCluster `json:"clusters"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterListClustersResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterListClustersResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterListClustersResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
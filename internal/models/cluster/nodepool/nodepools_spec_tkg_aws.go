@@ -19,6 +19,9 @@ type VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool struct {
 	// workload cluster please use TKGAWSNodePlacement
 	AvailabilityZone string `json:"availabilityZone,omitempty"`
 
+	// Auto scaling config for the nodepool.
+	AutoScaling *VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig `json:"autoScaling,omitempty"`
+
 	// Nodepool instance type.
 	// The potential values could be found using cluster:options api.
 	InstanceType string `json:"instanceType,omitempty"`
@@ -17,6 +17,9 @@ type VmwareTanzuManageV1alpha1ClusterNodepoolTKGVsphereNodepool struct {
 
 	// VM specific configuration.
 	VMConfig *VmwareTanzuManageV1alpha1CommonClusterTKGVsphereVMConfig `json:"vmConfig,omitempty"`
+
+	// Kubernetes version of the node pool.
+	Version string `json:"version,omitempty"`
 }
 
 // MarshalBinary interface implementation.
@@ -28,6 +28,9 @@ type VmwareTanzuManageV1alpha1ClusterNodepoolTKGServiceVsphereNodepool struct {
 
 	// Configure volumes for node pool nodes.
 	Volumes []*VmwareTanzuManageV1alpha1CommonClusterTKGServiceVsphereVolume `json:"volumes"`
+
+	// Kubernetes version of the node pool.
+	Version string `json:"version,omitempty"`
 }
 
 // MarshalBinary interface implementation.
@@ -0,0 +1,46 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package nodepool
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig Auto scaling config for the TKG AWS nodepool.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.nodepool.TKGAWSAutoScalingConfig
+type VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig struct {
+
+	// Whether to enable the cluster autoscaler for the nodepool.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// The maximum number of nodes for auto-scaling.
+	MaxCount int32 `json:"maxCount,omitempty"`
+
+	// The minimum number of nodes for auto-scaling.
+	MinCount int32 `json:"minCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustergroupmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupSearchScope Scope to search cluster groups by.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.SearchScope
+type VmwareTanzuManageV1alpha1ClustergroupSearchScope struct {
+
+	// Scope search to the specified name; supports globbing.
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest Request to list cluster groups.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.ListClusterGroupsRequest
+type VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest struct {
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1ClustergroupSearchScope `json:"searchScope,omitempty"`
+
+	// Sort order.
+	SortBy string `json:"sortBy,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse Response from listing cluster groups.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.ListClusterGroupsResponse
+type VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse struct {
+
+	// List of cluster groups.
+	ClusterGroups []*VmwareTanzuManageV1alpha1ClustergroupClusterGroup `json:"clusterGroups"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
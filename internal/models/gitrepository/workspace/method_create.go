@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package gitrepositoryworkspacemodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest Request to create a GitRepository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.gitrepository.CreateGitRepositoryRequest
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest struct {
+
+	// GitRepository to create.
+	GitRepository *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepository `json:"gitRepository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse Response from creating a GitRepository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.gitrepository.CreateGitRepositoryResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse struct {
+
+	// GitRepository created.
+	GitRepository *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepository `json:"gitRepository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package gitrepositoryworkspacemodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse Response from getting a GitRepository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.gitrepository.GetGitRepositoryResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse struct {
+
+	// GitRepository returned.
+	GitRepository *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepository `json:"gitRepository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGetGitRepositoryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,42 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package gitrepositoryworkspacemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	gitrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/cluster"
+)
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec Spec for the Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.gitrepository.Spec
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec struct {
+
+	// Spec of git repository as defined at atomic level.
+	AtomicSpec *gitrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdGitrepositorySpec `json:"atomicSpec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseworkspacemodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName Full name of the Helm Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.helm.release.FullName
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName struct {
+
+	// Name of Workspace.
+	WorkspaceName string `json:"workspaceName,omitempty"`
+
+	// Name of the Helm Release.
+	Name string `json:"name,omitempty"`
+
+	// Name of Namespace.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseworkspacemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease Release is an instance of Helm Chart created at workspace level.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.helm.release.Release
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease struct {
+
+	// Full name for the Release.
+	FullName *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Release object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the Release.
+	Spec *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec `json:"spec,omitempty"`
+
+	// Status for the Release.
+	Status *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
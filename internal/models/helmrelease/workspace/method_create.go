@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseworkspacemodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest Request to create a Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.helm.release.CreateReleaseRequest
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest struct {
+
+	// Release to create.
+	Release *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease `json:"release,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse Response from updating a Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.helm.release.UpdateReleaseResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse struct {
+
+	// Release updated.
+	Release *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease `json:"release,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
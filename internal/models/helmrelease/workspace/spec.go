@@ -0,0 +1,42 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseworkspacemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+)
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec Spec of the Helm Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.helm.release.Spec
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec struct {
+
+	// Spec of helm release as defined at atomic level.
+	AtomicSpec *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec `json:"atomicSpec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
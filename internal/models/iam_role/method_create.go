@@ -0,0 +1,40 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrolemodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1IamRoleRoleRequest Request to create or update a role.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.CreateRoleRequest
+type VmwareTanzuManageV1alpha1IamRoleRoleRequest struct {
+
+	// Role to create or update.
+	Role *VmwareTanzuManageV1alpha1IamRoleRole `json:"role,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleRoleRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleRoleRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleRoleRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
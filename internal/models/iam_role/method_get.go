@@ -0,0 +1,40 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrolemodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1IamRoleRoleResponse Response of a role request.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.GetRoleResponse
+type VmwareTanzuManageV1alpha1IamRoleRoleResponse struct {
+
+	// Role returned.
+	Role *VmwareTanzuManageV1alpha1IamRoleRole `json:"role,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleRoleResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleRoleResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleRoleResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
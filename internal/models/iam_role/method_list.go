@@ -0,0 +1,106 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrolemodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1IamRoleListRolesRequest Request to list roles.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.ListRolesRequest
+type VmwareTanzuManageV1alpha1IamRoleListRolesRequest struct {
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1IamRoleSearchScope `json:"searchScope,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleListRolesRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleListRolesRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleListRolesRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1IamRoleSearchScope Scope to search roles by.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.SearchScope
+type VmwareTanzuManageV1alpha1IamRoleSearchScope struct {
+
+	// Scope search to the specified name; supports globbing.
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1IamRoleListRolesResponse Response from listing roles.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.ListRolesResponse
+type VmwareTanzuManageV1alpha1IamRoleListRolesResponse struct {
+
+	// List of roles.
+	Roles []*VmwareTanzuManageV1alpha1IamRoleRole `json:"roles"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleListRolesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleListRolesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleListRolesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrolemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// VmwareTanzuManageV1alpha1IamRoleRole Role is a collection of permissions that can be bound to subjects on resources.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.Role
+type VmwareTanzuManageV1alpha1IamRoleRole struct {
+
+	// Full name for the role.
+	FullName *VmwareTanzuManageV1alpha1IamRoleFullName `json:"fullName,omitempty"`
+
+	// Metadata for the role object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the role.
+	Spec *VmwareTanzuManageV1alpha1IamRoleSpec `json:"spec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleRole) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleRole) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleRole
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1IamRoleSpec Spec of the role.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.Spec
+type VmwareTanzuManageV1alpha1IamRoleSpec struct {
+
+	// Aggregation rule to combine the rules of other roles.
+	AggregationRule *VmwareTanzuManageV1alpha1IamRoleAggregationRule `json:"aggregationRule,omitempty"`
+
+	// Flag representing whether the role is deprecated.
+	IsDeprecated bool `json:"isDeprecated"`
+
+	// Flag representing whether the role is a built-in role.
+	IsInbuilt bool `json:"isInbuilt"`
+
+	// Resource types on which the role can be bound.
+	Resources []string `json:"resources"`
+
+	// Kubernetes rules granted by the role.
+	Rules []*VmwareTanzuManageV1alpha1IamRoleKubernetesRule `json:"rules"`
+
+	// Tanzu Mission Control permissions granted by the role.
+	TanzuPermissions []string `json:"tanzuPermissions"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1IamRoleKubernetesRule Kubernetes RBAC rule granted by the role.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.KubernetesRule
+type VmwareTanzuManageV1alpha1IamRoleKubernetesRule struct {
+
+	// API groups of the rule resources.
+	APIGroups []string `json:"apiGroups"`
+
+	// Non-resource URLs of the rule.
+	NonResourceUrls []string `json:"nonResourceUrls"`
+
+	// Names of the resources the rule applies to.
+	ResourceNames []string `json:"resourceNames"`
+
+	// Resources the rule applies to.
+	Resources []string `json:"resources"`
+
+	// Verbs allowed by the rule.
+	Verbs []string `json:"verbs"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleKubernetesRule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleKubernetesRule) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleKubernetesRule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1IamRoleAggregationRule Aggregation rule of the role.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.AggregationRule
+type VmwareTanzuManageV1alpha1IamRoleAggregationRule struct {
+
+	// Label selectors of the roles to be aggregated.
+	ClusterRoleSelectors []*VmwareTanzuManageV1alpha1IamRoleLabelSelector `json:"clusterRoleSelectors"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleAggregationRule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleAggregationRule) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleAggregationRule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1IamRoleLabelSelector Label selector of the roles to be aggregated, the results of match labels and match expressions are ANDed.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.LabelSelector
+type VmwareTanzuManageV1alpha1IamRoleLabelSelector struct {
+
+	// List of label selector requirements.
+	MatchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement `json:"matchExpressions"`
+
+	// Map of {key,value} pairs to match.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleLabelSelector) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleLabelSelector) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleLabelSelector
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacesecret
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest Request to create a Secret.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.secret.CreateSecretRequest
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest struct {
+
+	// Secret to create.
+	Secret *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret `json:"secret,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse Response from creating a Secret.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.secret.CreateSecretResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse struct {
+
+	// Secret created.
+	Secret *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret `json:"secret,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacesecret
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse Response from getting a Secret.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.secret.GetSecretResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse struct {
+
+	// Secret returned.
+	Secret *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret `json:"secret,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretGetSecretResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacesecret
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret Represents Tanzu Secret.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.secret.Secret
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret struct {
+
+	// Full name for the Secret.
+	FullName *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Secret  object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the Secret.
+	Spec *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec `json:"spec,omitempty"`
+
+	// Status for the Secret.
+	Status *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacesecretexport
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest Request to create a SecretExport.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.secretexport.CreateSecretExportRequest
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest struct {
+
+	// SecretExport to create.
+	SecretExport *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportSecretExport `json:"secretExport,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse Response from creating a SecretExport.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.secretexport.CreateSecretExportResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse struct {
+
+	// SecretExport created.
+	SecretExport *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportSecretExport `json:"secretExport,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
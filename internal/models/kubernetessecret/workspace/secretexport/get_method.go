@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacesecretexport
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse Response from getting a SecretExport.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.secretexport.GetSecretExportResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse struct {
+
+	// SecretExport returned.
+	SecretExport *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportSecretExport `json:"secretExport,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportGetSecretExportResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
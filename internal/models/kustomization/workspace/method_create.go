@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kustomizationworkspacemodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest Request to create a Kustomization.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.kustomization.CreateKustomizationRequest
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest struct {
+
+	// Kustomization to create.
+	Kustomization *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomization `json:"kustomization,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse Response from creating a Kustomization.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.namespace.fluxcd.kustomization.CreateKustomizationResponse
+type VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse struct {
+
+	// Kustomization created.
+	Kustomization *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomization `json:"kustomization,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package namespacemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope Scope to search namespaces by.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.SearchScope
+type VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope struct {
+
+	// Scope search to the specified cluster name; supports globbing.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Scope search to the specified management cluster name; supports globbing.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Scope search to the specified namespace name; supports globbing.
+	Name string `json:"name,omitempty"`
+
+	// Scope search to the specified provisioner name; supports globbing.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+
+	// Scope search to the specified workspace name; supports globbing.
+	WorkspaceName string `json:"workspaceName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest Request to list namespaces.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.ListNamespacesRequest
+type VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest struct {
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope `json:"searchScope,omitempty"`
+
+	// Sort order.
+	SortBy string `json:"sortBy,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse Response from listing namespaces.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.ListNamespacesResponse
+type VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse struct {
+
+	// List of namespaces.
+	Namespaces []*VmwareTanzuManageV1alpha1ClusterNamespaceNamespace `json:"namespaces"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
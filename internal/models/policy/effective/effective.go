@@ -0,0 +1,192 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyeffectivemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// VmwareTanzuManageV1alpha1PolicyEffectiveFullName Full name of the cluster or namespace the effective policies are listed for.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.FullName
+type VmwareTanzuManageV1alpha1PolicyEffectiveFullName struct {
+
+	// Name of the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of the management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the namespace, empty for the effective policies of the cluster.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+
+	// Name of the cluster provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectiveFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectiveFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectiveFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec A policy applying to the cluster or namespace, along with where it is defined.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.PolicySpec
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec struct {
+
+	// Whether the policy is inherited from a parent of the cluster or namespace.
+	Inherited bool `json:"inherited,omitempty"`
+
+	// Name of the policy.
+	PolicyName string `json:"policyName,omitempty"`
+
+	// Resource ID of the organization, cluster group, workspace, cluster or namespace the policy is defined on.
+	SourceRid string `json:"sourceRid,omitempty"`
+
+	// Spec of the policy.
+	Spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec `json:"spec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicy The policies of one type applying to a cluster or namespace.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.EffectivePolicy
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicy struct {
+
+	// Full name of the cluster or namespace.
+	FullName *VmwareTanzuManageV1alpha1PolicyEffectiveFullName `json:"fullName,omitempty"`
+
+	// Policies of the type, inherited and direct.
+	PolicySpecs []*VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec `json:"policySpecs"`
+
+	// Type of the policies.
+	Type string `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicy) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest Request to list the effective policies of a cluster or namespace.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.ListEffectivePoliciesRequest
+type VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest struct {
+
+	// Full name of the cluster or namespace.
+	FullName *VmwareTanzuManageV1alpha1PolicyEffectiveFullName `json:"fullName,omitempty"`
+
+	// Type of the policies to list, all types when empty.
+	PolicyType string `json:"policyType,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse Response with the effective policies of a cluster or namespace.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.ListEffectivePoliciesResponse
+type VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse struct {
+
+	// Effective policies, one entry per policy type.
+	EffectivePolicies []*VmwareTanzuManageV1alpha1PolicyEffectivePolicy `json:"effectivePolicies"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
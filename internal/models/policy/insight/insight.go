@@ -0,0 +1,236 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyinsightmodel
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1PolicyInsightSearchScope Scope to search policy insights in.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insight.SearchScope
+type VmwareTanzuManageV1alpha1PolicyInsightSearchScope struct {
+
+	// Name of the cluster group.
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Name of the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of the management cluster of the cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the provisioner of the cluster.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+
+	// Name of the workspace.
+	WorkspaceName string `json:"workspaceName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest Request to list policy insights.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insight.ListPolicyInsightsRequest
+type VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest struct {
+
+	// Name of the policy.
+	PolicyName string `json:"policyName,omitempty"`
+
+	// Type of the policy, e.g. custom-policy or security-policy.
+	PolicyType string `json:"policyType,omitempty"`
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Scope to search by.
+	SearchScope *VmwareTanzuManageV1alpha1PolicyInsightSearchScope `json:"searchScope,omitempty"`
+
+	// Severity of the insights.
+	Severity string `json:"severity,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyInsightResource Kubernetes resource violating a policy.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insight.Resource
+type VmwareTanzuManageV1alpha1PolicyInsightResource struct {
+
+	// API version of the resource.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the resource.
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the resource, empty for cluster scoped resources.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightResource) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightResource) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightResource
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight Violation of a policy reported by a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insight.PolicyInsight
+type VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight struct {
+
+	// Name of the cluster reporting the violation.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Enforcement action of the policy: deny, dryrun or warn.
+	EnforcementAction string `json:"enforcementAction,omitempty"`
+
+	// Time the violation was last observed.
+	// Format: date-time
+	LastObservedTime strfmt.DateTime `json:"lastObservedTime,omitempty"`
+
+	// Name of the management cluster of the cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Violation message.
+	Message string `json:"message,omitempty"`
+
+	// Name of the violated policy.
+	PolicyName string `json:"policyName,omitempty"`
+
+	// Type of the violated policy.
+	PolicyType string `json:"policyType,omitempty"`
+
+	// Name of the provisioner of the cluster.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+
+	// Recipe of the violated policy.
+	Recipe string `json:"recipe,omitempty"`
+
+	// Resource violating the policy.
+	Resource *VmwareTanzuManageV1alpha1PolicyInsightResource `json:"resource,omitempty"`
+
+	// Severity of the violation.
+	Severity string `json:"severity,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse Response from listing policy insights.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insight.ListPolicyInsightsResponse
+type VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse struct {
+
+	// List of policy insights.
+	Insights []*VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight `json:"insights"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
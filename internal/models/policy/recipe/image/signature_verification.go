@@ -0,0 +1,122 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipeimagemodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification is model for signature-verification recipe version v1
+//
+// The input schema for image policy signature-verification recipe, verifying the cosign signatures of the images.
+//
+// swagger:model VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification
+type VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification struct {
+
+	// Audit (dry-run)
+	// Creates this policy for dry-run. Violations will be logged but not denied. Defaults to false (deny).
+	Audit *bool `json:"audit,omitempty"`
+
+	// This specifies a list of rules that defines the signatures required per image pattern.
+	// Required: true
+	// Min Items: 1
+	Rules []*VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules `json:"rules"`
+}
+
+// MarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules Rules.
+//
+// swagger:model VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules
+type VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules struct {
+
+	// Image pattern the rule applies to, wildcards are supported (for example: harbor.example.com/apps/*).
+	// Required: true
+	ImagePattern string `json:"imagePattern"`
+
+	// Keyless identities, one of which must have signed the images.
+	Keyless []*VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless `json:"keyless"`
+
+	// PEM encoded public keys, one of which must have signed the images.
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// MarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless Keyless identity.
+//
+// swagger:model VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless
+type VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless struct {
+
+	// OIDC issuer of the signing certificate (for example: https://token.actions.githubusercontent.com).
+	// Required: true
+	Issuer string `json:"issuer"`
+
+	// Subject of the signing certificate.
+	Subject string `json:"subject,omitempty"`
+
+	// Regular expression matching the subject of the signing certificate.
+	SubjectRegExp string `json:"subjectRegExp,omitempty"`
+}
+
+// MarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipemutationmodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources The input schema for default resources mutation policy recipe version v1.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources struct {
+	// Limits Resource limits set on the containers not defining them
+	Limits *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues `json:"limits,omitempty"`
+
+	// Requests Resource requests set on the containers not defining them
+	Requests *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues `json:"requests,omitempty"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues CPU and memory quantities of a container.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues struct {
+	// CPU quantity, e.g. 500m
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory quantity, e.g. 512Mi
+	Memory string `json:"memory,omitempty"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
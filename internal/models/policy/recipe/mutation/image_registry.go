@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipemutationmodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry The input schema for image registry mutation policy recipe version v1.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry struct {
+	// SourceRegistries Registries of the container images rewritten, images of all registries are rewritten when empty
+	SourceRegistries []string `json:"sourceRegistries"`
+
+	// TargetRegistry Registry, optionally with a project path, the container images are rewritten to
+	TargetRegistry string `json:"targetRegistry"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
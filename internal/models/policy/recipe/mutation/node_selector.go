@@ -0,0 +1,35 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipemutationmodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector The input schema for node selector mutation policy recipe version v1.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector struct {
+	// NodeSelector Node labels added to the node selector of the pods of the selected namespaces
+	NodeSelector map[string]string `json:"nodeSelector"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipemutationmodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations The input schema for tolerations mutation policy recipe version v1.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations struct {
+	// Tolerations added to the pods of the selected namespaces
+	Tolerations []*VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration `json:"tolerations"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration A Kubernetes pod toleration.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration struct {
+	// Effect Taint effect to match: NoSchedule, PreferNoSchedule or NoExecute, all effects when empty
+	Effect string `json:"effect,omitempty"`
+
+	// Key Taint key the toleration applies to, all taints when empty with operator Exists
+	Key string `json:"key,omitempty"`
+
+	// Operator Relationship of the key to the value: Exists or Equal
+	Operator string `json:"operator,omitempty"`
+
+	// TolerationSeconds Period of time the pod tolerates a NoExecute taint
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
+
+	// Value Taint value the toleration matches with operator Equal
+	Value string `json:"value,omitempty"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipenetworkmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
+)
+
+// V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress allow-dns-egress schema
+//
+// Only allow egress traffic of the selected pods to the cluster DNS on port 53 (TCP and UDP).
+type V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress struct {
+
+	// Labels of the namespace running the cluster DNS.
+	// Defaults to the kube-system namespace.
+	DNSNamespaceLabels []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels `json:"dnsNamespaceLabels,omitempty"`
+
+	// Labels of the cluster DNS pods.
+	// Defaults to k8s-app: kube-dns.
+	DNSPodLabels []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels `json:"dnsPodLabels,omitempty"`
+
+	// Pod Labels on which traffic should be restricted
+	// Use a label selector to identify the pods to which the policy applies
+	ToPodLabels []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels `json:"toPodLabels,omitempty"`
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress) UnmarshalBinary(b []byte) error {
+	var res V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
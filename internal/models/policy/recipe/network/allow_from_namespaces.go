@@ -0,0 +1,52 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipenetworkmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
+)
+
+// V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces allow-from-namespaces schema
+//
+// Only allow ingress traffic to the selected pods from the given namespaces, optionally restricted to some ports.
+type V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces struct {
+
+	// Labels of the namespaces allowed as ingress sources.
+	FromNamespaceLabels []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels `json:"fromNamespaceLabels,omitempty"`
+
+	// Names of the namespaces allowed as ingress sources.
+	FromNamespaces []string `json:"fromNamespaces,omitempty"`
+
+	// List of ports which should be made accessible on the selected pods. Default is all ports.
+	Ports []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts `json:"ports,omitempty"`
+
+	// Pod Labels on which traffic should be allowed
+	// Use a label selector to identify the pods to which the policy applies
+	ToPodLabels []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels `json:"toPodLabels,omitempty"`
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces) UnmarshalBinary(b []byte) error {
+	var res V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
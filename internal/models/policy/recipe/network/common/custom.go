@@ -35,6 +35,9 @@ type V1alpha1CommonPolicySpecNetworkV1CustomRules struct {
 
 // V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts defines model for V1alpha1CommonPolicySpecNetworkV1Custom.rules.Item.Port
 type V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts struct {
+	// If set, indicates that the range of ports from port to endPort, inclusive, should be allowed by the policy. Requires a numerical port.
+	EndPort *int32 `json:"endPort,omitempty"`
+
 	// The port on the given protocol. This can either be a numerical or named port on a pod.
 	Port *string `json:"port,omitempty"`
 
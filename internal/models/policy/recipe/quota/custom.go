@@ -22,6 +22,12 @@ type VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom struct {
 	// Pattern: ^[0-9]+(E|P|T|G|M|K|Ei|Pi|Ti|Gi|Mi|Ki)?$
 	LimitsMemory string `json:"limitsMemory,omitempty"`
 
+	// LimitRange setting the default, minimum and maximum resources of the containers in a namespace.
+	LimitRange *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange `json:"limitRange,omitempty"`
+
+	// Quota values replacing the ones of the recipe for the namespaces matching their selector.
+	NamespaceOverrides []*VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride `json:"namespaceOverrides,omitempty"`
+
 	// The total number of PersistentVolumeClaims that can exist in a namespace.
 	Persistentvolumeclaims int64 `json:"persistentvolumeclaims,omitempty"`
 
@@ -42,7 +48,7 @@ type VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom struct {
 	// Across all persistent volume claims associated with each storage class, the sum of storage requests cannot exceed this value.
 	RequestsStoragePerClass map[string]string `json:"requestsStoragePerClass,omitempty"`
 
-	// The total number of Services of the given type that can exist in a namespace.
+	// The total number of objects or extended resources of the given name that can exist in a namespace.
 	ResourceCounts map[string]int `json:"resourceCounts,omitempty"`
 }
 
@@ -0,0 +1,76 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipequotamodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange LimitRange created alongside the ResourceQuota of the namespace quota policy custom recipe.
+type VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange struct {
+	// Default limits set on the containers not defining them.
+	Default *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues `json:"default,omitempty"`
+
+	// Default requests set on the containers not defining them.
+	DefaultRequest *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues `json:"defaultRequest,omitempty"`
+
+	// Maximum limits a container can set.
+	Max *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues `json:"max,omitempty"`
+
+	// Minimum requests a container can set.
+	Min *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues `json:"min,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues CPU and memory quantities of a container.
+type VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues struct {
+	// CPU quantity, e.g. 500m.
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory quantity, e.g. 512Mi.
+	Memory string `json:"memory,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
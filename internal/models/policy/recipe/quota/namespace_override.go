@@ -0,0 +1,58 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipequotamodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride Quota values replacing the ones of the custom recipe for the namespaces matching the selector.
+type VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride struct {
+
+	// The sum of CPU limits across all pods in a non-terminal state cannot exceed this value.
+	LimitsCPU string `json:"limitsCpu,omitempty"`
+
+	// The sum of memory limits across all pods in a non-terminal state cannot exceed this value.
+	LimitsMemory string `json:"limitsMemory,omitempty"`
+
+	// Label based selector of the namespaces the override applies to.
+	NamespaceSelector *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector `json:"namespaceSelector,omitempty"`
+
+	// The sum of CPU requests across all pods in a non-terminal state cannot exceed this value.
+	RequestsCPU string `json:"requestsCpu,omitempty"`
+
+	// The sum of memory requests across all pods in a non-terminal state cannot exceed this value.
+	RequestsMemory string `json:"requestsMemory,omitempty"`
+
+	// The sum of storage requests across all persistent volume claims cannot exceed this value.
+	RequestsStorage string `json:"requestsStorage,omitempty"`
+
+	// The total number of objects or extended resources of the given name that can exist in a namespace.
+	ResourceCounts map[string]int `json:"resourceCounts,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
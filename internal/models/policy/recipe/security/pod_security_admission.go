@@ -0,0 +1,118 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+The contents of this file are not auto-generated using swagger CLI as the schema defined for the recipes are not a part of the TMC API models.
+The models defined here are used to map the API request and response bodies to and from the terraform provider schema.
+*/
+
+package policyrecipesecuritymodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission Input schema for security policy pod security admission recipe version v1.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.security.v1.PodSecurityAdmission
+type VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission struct {
+
+	// Pod security standard audited on the selected namespaces, violations are recorded in the audit log.
+	Audit *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode `json:"audit,omitempty"`
+
+	// Pod security standard enforced on the selected namespaces, violating pods are rejected.
+	Enforce *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode `json:"enforce,omitempty"`
+
+	// Requests exempted from pod security admission.
+	Exemptions *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions `json:"exemptions,omitempty"`
+
+	// Pod security standard warned about on the selected namespaces, violations are returned as warnings to the user.
+	Warn *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode `json:"warn,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode Pod security standard level and version of a pod security admission mode.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.security.v1.PodSecurityAdmission.Mode
+type VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode struct {
+
+	// Pod security standard level: privileged, baseline or restricted.
+	Level string `json:"level,omitempty"`
+
+	// Kubernetes minor version of the pod security standard, e.g. v1.25, or latest.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions Requests exempted from pod security admission.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.security.v1.PodSecurityAdmission.Exemptions
+type VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions struct {
+
+	// Namespaces exempted.
+	Namespaces []string `json:"namespaces"`
+
+	// Runtime class names exempted.
+	RuntimeClasses []string `json:"runtimeClasses"`
+
+	// Authenticated usernames exempted.
+	Usernames []string `json:"usernames"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
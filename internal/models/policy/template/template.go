@@ -0,0 +1,189 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policytemplatemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1PolicyTemplateFullName Full name of the policy template.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.template.FullName
+type VmwareTanzuManageV1alpha1PolicyTemplateFullName struct {
+
+	// Name of the policy template.
+	Name string `json:"name,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplateFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyTemplateDataInventory Kubernetes resource which the template needs to be synced into the OPA cache for referential constraints.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.template.DataInventory
+type VmwareTanzuManageV1alpha1PolicyTemplateDataInventory struct {
+
+	// API group of the resource.
+	Group string `json:"group"`
+
+	// Kind of the resource.
+	Kind string `json:"kind"`
+
+	// API version of the resource.
+	Version string `json:"version"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateDataInventory) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateDataInventory) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplateDataInventory
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyTemplateSpec Spec of the policy template.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.template.Spec
+type VmwareTanzuManageV1alpha1PolicyTemplateSpec struct {
+
+	// Resources to be synced into the OPA cache for referential constraints.
+	DataInventory []*VmwareTanzuManageV1alpha1PolicyTemplateDataInventory `json:"dataInventory"`
+
+	// Flag representing whether the template is deprecated.
+	IsDeprecated bool `json:"isDeprecated"`
+
+	// Kubernetes object of the template, e.g. a gatekeeper ConstraintTemplate.
+	Object map[string]interface{} `json:"object,omitempty"`
+
+	// Type of the kubernetes object, e.g. ConstraintTemplate.
+	ObjectType string `json:"objectType,omitempty"`
+
+	// Type of the template, e.g. OPAGatekeeper.
+	TemplateType string `json:"templateType,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplateSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyTemplate A policy template used by custom policies.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.template.Template
+type VmwareTanzuManageV1alpha1PolicyTemplate struct {
+
+	// Full name for the policy template.
+	FullName *VmwareTanzuManageV1alpha1PolicyTemplateFullName `json:"fullName,omitempty"`
+
+	// Metadata for the policy template object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the policy template.
+	Spec *VmwareTanzuManageV1alpha1PolicyTemplateSpec `json:"spec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplate) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyTemplateData Request and response of the policy template resource service.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.template.Data
+type VmwareTanzuManageV1alpha1PolicyTemplateData struct {
+
+	// Policy template.
+	Template *VmwareTanzuManageV1alpha1PolicyTemplate `json:"template,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateData) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateData) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplateData
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
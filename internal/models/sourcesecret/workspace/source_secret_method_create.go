@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacesourcesecret
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest Request to create a SourceSecret.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.fluxcd.sourcesecret.CreateSourceSecretRequest
+type VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest struct {
+
+	// SourceSecret to create.
+	SourceSecret *VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSourceSecret `json:"sourceSecret,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse Response from creating a SourceSecret.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.fluxcd.sourcesecret.CreateSourceSecretResponse
+type VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse struct {
+
+	// SourceSecret created.
+	SourceSecret *VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSourceSecret `json:"sourceSecret,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
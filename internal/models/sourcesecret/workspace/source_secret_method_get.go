@@ -0,0 +1,41 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacesourcesecret
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse Response from getting a SourceSecret.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.fluxcd.sourcesecret.GetSourceSecretResponse
+type VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse struct {
+
+	// SourceSecret returned.
+	SourceSecret *VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSourceSecret `json:"sourceSecret,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceFluxcdGetSourceSecretResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetesreleasemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName Full name of the tanzu kubernetes release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.FullName
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName struct {
+
+	// Name of management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the tanzu kubernetes release.
+	Name string `json:"name,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+
+	// Name of Provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec Spec of the tanzu kubernetes release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.Spec
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec struct {
+
+	// Kubernetes version of the release.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Full version of the release, including the distribution suffix.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus Status of the tanzu kubernetes release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.Status
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus struct {
+
+	// Whether the release is compatible with the management cluster.
+	Compatible bool `json:"compatible,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease A kubernetes release available on a provisioner.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.TanzuKubernetesRelease
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease struct {
+
+	// Full name for the tanzu kubernetes release.
+	FullName *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName `json:"fullName,omitempty"`
+
+	// Metadata for the tanzu kubernetes release object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the tanzu kubernetes release.
+	Spec *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec `json:"spec,omitempty"`
+
+	// Status for the tanzu kubernetes release.
+	Status *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus `json:"status,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse Response from listing tanzu kubernetes releases.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.ListTanzuKubernetesReleasesResponse
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse struct {
+
+	// List of tanzu kubernetes releases.
+	TanzuKubernetesReleases []*VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease `json:"tanzuKubernetesReleases"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseListTanzuKubernetesReleasesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspacemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1WorkspaceSearchScope Scope to search workspaces by.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.SearchScope
+type VmwareTanzuManageV1alpha1WorkspaceSearchScope struct {
+
+	// Scope search to the specified name; supports globbing.
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest Request to list workspaces.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.ListWorkspacesRequest
+type VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest struct {
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1WorkspaceSearchScope `json:"searchScope,omitempty"`
+
+	// Sort order.
+	SortBy string `json:"sortBy,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse Response from listing workspaces.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.workspace.ListWorkspacesResponse
+type VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse struct {
+
+	// List of workspaces.
+	Workspaces []*VmwareTanzuManageV1alpha1WorkspaceWorkspace `json:"workspaces"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
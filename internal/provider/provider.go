@@ -14,9 +14,11 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/backupschedule"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/dataprotection"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/integration"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/kubeconfig"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/nodepools"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clusterclass"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clustergroup"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clustergroupmembership"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/credential"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/ekscluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository"
@@ -25,14 +27,19 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/iampolicy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/iamrole"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kubernetessecret"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kustomization"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/managementcluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/namespace"
 	tanzupackage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/package"
 	tanzupackages "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packages"
+	policyassignment "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/assignment"
+	effectivepolicies "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/effective"
+	policyinsights "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/insights"
 	custompolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
 	custompolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/resource"
+	policytemplate "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/template"
 	imagepolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
 	imagepolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image/resource"
 	mutationpolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
@@ -56,60 +63,76 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: authctx.ProviderAuthSchema(),
 		ResourcesMap: map[string]*schema.Resource{
-			cluster.ResourceName:             cluster.ResourceTMCCluster(),
-			ekscluster.ResourceName:          ekscluster.ResourceTMCEKSCluster(),
-			akscluster.ResourceName:          akscluster.ResourceTMCAKSCluster(),
-			workspace.ResourceName:           workspace.ResourceWorkspace(),
-			namespace.ResourceName:           namespace.ResourceNamespace(),
-			clustergroup.ResourceName:        clustergroup.ResourceClusterGroup(),
-			nodepools.ResourceName:           nodepools.ResourceNodePool(),
-			iampolicy.ResourceName:           iampolicy.ResourceIAMPolicy(),
-			custompolicy.ResourceName:        custompolicyresource.ResourceCustomPolicy(),
-			securitypolicy.ResourceName:      securitypolicyresource.ResourceSecurityPolicy(),
-			imagepolicy.ResourceName:         imagepolicyresource.ResourceImagePolicy(),
-			quotapolicy.ResourceName:         quotapolicyresource.ResourceQuotaPolicy(),
-			networkpolicy.ResourceName:       networkpolicyresource.ResourceNetworkPolicy(),
-			credential.ResourceName:          credential.ResourceCredential(),
-			integration.ResourceName:         integration.ResourceIntegration(),
-			gitrepository.ResourceName:       gitrepository.ResourceGitRepository(),
-			kustomization.ResourceName:       kustomization.ResourceKustomization(),
-			sourcesecret.ResourceName:        sourcesecret.ResourceSourceSecret(),
-			packagerepository.ResourceName:   packagerepository.ResourcePackageRepository(),
-			tanzupackageinstall.ResourceName: tanzupackageinstall.ResourcePackageInstall(),
-			kubernetessecret.ResourceName:    kubernetessecret.ResourceSecret(),
-			mutationpolicy.ResourceName:      mutationpolicyresource.ResourceMutationPolicy(),
-			helmrelease.ResourceName:         helmrelease.ResourceHelmRelease(),
-			helmfeature.ResourceName:         helmfeature.ResourceHelm(),
-			backupschedule.ResourceName:      backupschedule.ResourceBackupSchedule(),
-			dataprotection.ResourceName:      dataprotection.ResourceEnableDataProtection(),
-			targetlocation.ResourceName:      targetlocation.ResourceTargetLocation(),
-			managementcluster.ResourceName:   managementcluster.ResourceManagementClusterRegistration(),
-			utkgresource.ResourceName:        utkgresource.ResourceTanzuKubernetesCluster(),
+			cluster.ResourceName:                cluster.ResourceTMCCluster(),
+			ekscluster.ResourceName:             ekscluster.ResourceTMCEKSCluster(),
+			akscluster.ResourceName:             akscluster.ResourceTMCAKSCluster(),
+			workspace.ResourceName:              workspace.ResourceWorkspace(),
+			namespace.ResourceName:              namespace.ResourceNamespace(),
+			clustergroup.ResourceName:           clustergroup.ResourceClusterGroup(),
+			clustergroupmembership.ResourceName: clustergroupmembership.ResourceClusterGroupMembership(),
+			nodepools.ResourceName:              nodepools.ResourceNodePool(),
+			iampolicy.ResourceName:              iampolicy.ResourceIAMPolicy(),
+			iampolicy.MemberResourceName:        iampolicy.ResourceIAMMember(),
+			iamrole.ResourceName:                iamrole.ResourceCustomRole(),
+			custompolicy.ResourceName:           custompolicyresource.ResourceCustomPolicy(),
+			securitypolicy.ResourceName:         securitypolicyresource.ResourceSecurityPolicy(),
+			imagepolicy.ResourceName:            imagepolicyresource.ResourceImagePolicy(),
+			quotapolicy.ResourceName:            quotapolicyresource.ResourceQuotaPolicy(),
+			networkpolicy.ResourceName:          networkpolicyresource.ResourceNetworkPolicy(),
+			credential.ResourceName:             credential.ResourceCredential(),
+			integration.ResourceName:            integration.ResourceIntegration(),
+			gitrepository.ResourceName:          gitrepository.ResourceGitRepository(),
+			kustomization.ResourceName:          kustomization.ResourceKustomization(),
+			sourcesecret.ResourceName:           sourcesecret.ResourceSourceSecret(),
+			packagerepository.ResourceName:      packagerepository.ResourcePackageRepository(),
+			tanzupackageinstall.ResourceName:    tanzupackageinstall.ResourcePackageInstall(),
+			kubernetessecret.ResourceName:       kubernetessecret.ResourceSecret(),
+			mutationpolicy.ResourceName:         mutationpolicyresource.ResourceMutationPolicy(),
+			helmrelease.ResourceName:            helmrelease.ResourceHelmRelease(),
+			helmfeature.ResourceName:            helmfeature.ResourceHelm(),
+			backupschedule.ResourceName:         backupschedule.ResourceBackupSchedule(),
+			dataprotection.ResourceName:         dataprotection.ResourceEnableDataProtection(),
+			targetlocation.ResourceName:         targetlocation.ResourceTargetLocation(),
+			managementcluster.ResourceName:      managementcluster.ResourceManagementClusterRegistration(),
+			utkgresource.ResourceName:           utkgresource.ResourceTanzuKubernetesCluster(),
+			policytemplate.ResourceName:         policytemplate.ResourceCustomPolicyTemplate(),
+			policyassignment.ResourceName:       policyassignment.ResourcePolicyAssignment(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			cluster.ResourceName:             cluster.DataSourceTMCCluster(),
-			ekscluster.ResourceName:          ekscluster.DataSourceTMCEKSCluster(),
-			akscluster.ResourceName:          akscluster.DataSourceTMCAKSCluster(),
-			workspace.ResourceName:           workspace.DataSourceWorkspace(),
-			namespace.ResourceName:           namespace.DataSourceNamespace(),
-			clustergroup.ResourceName:        clustergroup.DataSourceClusterGroup(),
-			nodepools.ResourceName:           nodepools.DataSourceClusterNodePool(),
-			credential.ResourceName:          credential.DataSourceCredential(),
-			integration.ResourceName:         integration.DataSourceIntegration(),
-			gitrepository.ResourceName:       gitrepository.DataSourceGitRepository(),
-			sourcesecret.ResourceName:        sourcesecret.DataSourceSourcesecret(),
-			packagerepository.ResourceName:   packagerepository.DataSourcePackageRepository(),
-			tanzupackage.ResourceName:        tanzupackage.DataSourceTanzuPackage(),
-			tanzupackages.ResourceName:       tanzupackages.DataSourceTanzuPackages(),
-			tanzupackageinstall.ResourceName: tanzupackageinstall.DataSourcePackageInstall(),
-			kubernetessecret.ResourceName:    kubernetessecret.DataSourceSecret(),
-			helmfeature.ResourceName:         helmfeature.DataSourceHelm(),
-			helmcharts.ResourceName:          helmcharts.DataSourceHelmCharts(),
-			helmrepository.ResourceName:      helmrepository.DataSourceHelmRepository(),
-			backupschedule.ResourceName:      backupschedule.DataSourceBackupSchedule(),
-			targetlocation.ResourceName:      targetlocation.DataSourceTargetLocations(),
-			managementcluster.ResourceName:   managementcluster.DataSourceManagementClusterRegistration(),
-			clusterclass.ResourceName:        clusterclass.DataSourceClusterClass(),
+			cluster.ResourceName:                 cluster.DataSourceTMCCluster(),
+			ekscluster.ResourceName:              ekscluster.DataSourceTMCEKSCluster(),
+			akscluster.ResourceName:              akscluster.DataSourceTMCAKSCluster(),
+			workspace.ResourceName:               workspace.DataSourceWorkspace(),
+			namespace.ResourceName:               namespace.DataSourceNamespace(),
+			namespace.ListDataSourceName:         namespace.DataSourceNamespaces(),
+			clustergroup.ResourceName:            clustergroup.DataSourceClusterGroup(),
+			nodepools.ResourceName:               nodepools.DataSourceClusterNodePool(),
+			credential.ResourceName:              credential.DataSourceCredential(),
+			integration.ResourceName:             integration.DataSourceIntegration(),
+			gitrepository.ResourceName:           gitrepository.DataSourceGitRepository(),
+			sourcesecret.ResourceName:            sourcesecret.DataSourceSourcesecret(),
+			packagerepository.ResourceName:       packagerepository.DataSourcePackageRepository(),
+			tanzupackage.ResourceName:            tanzupackage.DataSourceTanzuPackage(),
+			tanzupackages.ResourceName:           tanzupackages.DataSourceTanzuPackages(),
+			tanzupackageinstall.ResourceName:     tanzupackageinstall.DataSourcePackageInstall(),
+			kubernetessecret.ResourceName:        kubernetessecret.DataSourceSecret(),
+			helmfeature.ResourceName:             helmfeature.DataSourceHelm(),
+			helmcharts.ResourceName:              helmcharts.DataSourceHelmCharts(),
+			helmrepository.ResourceName:          helmrepository.DataSourceHelmRepository(),
+			backupschedule.ResourceName:          backupschedule.DataSourceBackupSchedule(),
+			targetlocation.ResourceName:          targetlocation.DataSourceTargetLocations(),
+			managementcluster.ResourceName:       managementcluster.DataSourceManagementClusterRegistration(),
+			managementcluster.ListDataSourceName: managementcluster.DataSourceManagementClusters(),
+			cluster.ListDataSourceName:           cluster.DataSourceClusters(),
+			clustergroup.ListDataSourceName:      clustergroup.DataSourceClusterGroups(),
+			workspace.ListDataSourceName:         workspace.DataSourceWorkspaces(),
+			clusterclass.ResourceName:            clusterclass.DataSourceClusterClass(),
+			kubeconfig.ResourceName:              kubeconfig.DataSourceClusterKubeconfig(),
+			policyinsights.ResourceName:          policyinsights.DataSourcePolicyInsights(),
+			effectivepolicies.ResourceName:       effectivepolicies.DataSourceEffectivePolicies(),
+			policyassignment.ResourceName:        policyassignment.DataSourcePolicyAssignment(),
+			iampolicy.ResourceName:               iampolicy.DataSourceIAMPolicy(),
+			iamrole.ListDataSourceName:           iamrole.DataSourceIAMRoles(),
 		},
 		ConfigureContextFunc: authctx.ProviderConfigureContext,
 	}
@@ -643,3 +643,11 @@ func (m *mockKubeConfigClient) KubeconfigServiceGet(fn *configModels.VmwareTanzu
 
 	return m.kubeConfigResponse, m.kubeConfigError
 }
+
+func (m *mockKubeConfigClient) KubeconfigServiceGetForCli(fn *configModels.VmwareTanzuManageV1alpha1ClusterFullName, _ configModels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType) (*configModels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
+	return m.KubeconfigServiceGet(fn)
+}
+
+func (m *mockKubeConfigClient) AdminKubeconfigServiceGet(fn *configModels.VmwareTanzuManageV1alpha1ClusterFullName) (*configModels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
+	return m.KubeconfigServiceGet(fn)
+}
@@ -21,6 +21,7 @@ import (
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	models "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
 	configModels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubeconfig"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
 func ResourceTMCAKSCluster() *schema.Resource {
@@ -49,6 +50,8 @@ func resourceClusterCreate(ctx context.Context, data *schema.ResourceData, confi
 		return diag.FromErr(cErr)
 	}
 
+	cluster.Meta.Labels[common.ManagedByLabelKey] = ResourceName
+
 	nodepools := ConstructNodepools(data)
 
 	if err := validateCluster(cluster); err != nil {
@@ -103,6 +106,10 @@ func resourceClusterInPlaceUpdate(ctx context.Context, data *schema.ResourceData
 		return diag.FromErr(errors.Errorf("Unable to get Tanzu Mission Control AKS cluster entry, name : %s", data.Get(NameKey)))
 	}
 
+	if err := common.CheckResourceVersion(data, clusterResp.AksCluster.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control AKS cluster entry, name : %s", data.Get(NameKey)))
+	}
+
 	// Make changes to the cluster config.
 	if clusterChange := data.HasChange("spec.0.config.0"); clusterChange {
 		if updateErr := updateClusterConfig(ctx, data, clusterResp, tc); updateErr != nil {
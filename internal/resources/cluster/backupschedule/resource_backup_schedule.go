@@ -19,6 +19,7 @@ import (
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	backupschedulemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/backupschedule"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
 func ResourceBackupSchedule() *schema.Resource {
@@ -164,6 +165,20 @@ func resourceBackupScheduleUpdate(ctx context.Context, data *schema.ResourceData
 		return diags
 	}
 
+	getResp, err := config.TMCConnection.BackupScheduleService.BackupScheduleResourceServiceGet(model.FullName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't read Tanzu Mission Control backup schedule.\nManagement Cluster Name: %s, Provisioner Name: %s, Cluster Name: %s, Schedule Name: %s",
+			model.FullName.ManagementClusterName, model.FullName.ProvisionerName, model.FullName.ClusterName, model.FullName.Name))
+	}
+
+	if err := common.CheckResourceVersion(data, getResp.Schedule.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't update Tanzu Mission Control backup schedule, name : %s", model.FullName.Name))
+	}
+
+	if model.Meta != nil && getResp.Schedule.Meta != nil {
+		model.Meta.Labels = common.WithSystemManagedKeys(model.Meta.Labels, getResp.Schedule.Meta.Labels)
+	}
+
 	systemExcludedNamespaces := getExcludedNamespaces(data, SystemExcludedNamespacesKey)
 	model.Spec.Template.ExcludedNamespaces = append(model.Spec.Template.ExcludedNamespaces, systemExcludedNamespaces...)
 
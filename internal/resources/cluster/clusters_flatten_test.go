@@ -0,0 +1,188 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestMatchKubernetesVersion(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		version     string
+		wanted      string
+		expected    bool
+	}{
+		{
+			description: "same version",
+			version:     "v1.26.5",
+			wanted:      "v1.26.5",
+			expected:    true,
+		},
+		{
+			description: "patch of the minor version",
+			version:     "v1.26.5+vmware.2",
+			wanted:      "v1.26",
+			expected:    true,
+		},
+		{
+			description: "wanted version without the leading v",
+			version:     "v1.26.5+vmware.2",
+			wanted:      "1.26.5",
+			expected:    true,
+		},
+		{
+			description: "different minor version with the same prefix",
+			version:     "v1.26.5",
+			wanted:      "v1.2",
+			expected:    false,
+		},
+		{
+			description: "unknown version",
+			version:     "",
+			wanted:      "v1.26",
+			expected:    false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, matchKubernetesVersion(test.version, test.wanted))
+		})
+	}
+}
+
+func TestFilterClusters(t *testing.T) {
+	t.Parallel()
+
+	healthy := clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY
+	unhealthy := clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthUNHEALTHY
+	ready := clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseREADY
+	upgrading := clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADING
+
+	prodAttached := &clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+		FullName: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{ManagementClusterName: "attached", ProvisionerName: "attached", Name: "prod-1"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "prod"}},
+		Status:   &clustermodel.VmwareTanzuManageV1alpha1ClusterStatus{KubeServerVersion: "v1.26.5", Health: &healthy, Phase: &ready},
+	}
+	prodTKG := &clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+		FullName: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{ManagementClusterName: "tkgm", ProvisionerName: "default", Name: "prod-2"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "prod"}},
+		Status:   &clustermodel.VmwareTanzuManageV1alpha1ClusterStatus{KubeServerVersion: "v1.25.9+vmware.1", Health: &unhealthy, Phase: &upgrading},
+	}
+	dev := &clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+		FullName: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{ManagementClusterName: "attached", ProvisionerName: "attached", Name: "dev-1"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "dev"}},
+	}
+	clusters := []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{prodTKG, nil, dev, prodAttached}
+
+	cases := []struct {
+		description string
+		filter      *clusterFilter
+		expected    []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster
+	}{
+		{
+			description: "no filter lists all the clusters sorted by full name",
+			filter:      &clusterFilter{},
+			expected:    []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{dev, prodAttached, prodTKG},
+		},
+		{
+			description: "filter by label",
+			filter:      &clusterFilter{labels: map[string]string{"env": "prod"}},
+			expected:    []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{prodAttached, prodTKG},
+		},
+		{
+			description: "filter by kubernetes version",
+			filter:      &clusterFilter{kubernetesVersion: "v1.25"},
+			expected:    []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{prodTKG},
+		},
+		{
+			description: "filter by health skips clusters without status",
+			filter:      &clusterFilter{health: []string{"HEALTHY", "WARNING"}},
+			expected:    []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{prodAttached},
+		},
+		{
+			description: "filter by phase and label without match",
+			filter:      &clusterFilter{labels: map[string]string{"env": "dev"}, phase: []string{"READY"}},
+			expected:    []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := filterClusters(clusters, test.filter)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenClusters(t *testing.T) {
+	t.Parallel()
+
+	healthy := clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY
+	ready := clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseREADY
+	provider := clustermodel.VmwareTanzuManageV1alpha1CommonClusterInfrastructureProvider("AWS_EC2")
+
+	cases := []struct {
+		description string
+		input       []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster list",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with cluster list",
+			input: []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+				{
+					FullName: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{ManagementClusterName: "attached", ProvisionerName: "attached", Name: "prod-1"},
+					Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "c:01", Labels: map[string]string{"env": "prod"}},
+					Spec:     &clustermodel.VmwareTanzuManageV1alpha1ClusterSpec{ClusterGroupName: "production"},
+					Status: &clustermodel.VmwareTanzuManageV1alpha1ClusterStatus{
+						KubeServerVersion:      "v1.26.5",
+						Health:                 &healthy,
+						Phase:                  &ready,
+						InfrastructureProvider: &provider,
+					},
+				},
+				nil,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NameKey:                   "prod-1",
+					ManagementClusterNameKey:  "attached",
+					ProvisionerNameKey:        "attached",
+					uidKey:                    "c:01",
+					labelsKey:                 map[string]string{"env": "prod"},
+					clusterGroupKey:           "production",
+					kubernetesVersionKey:      "v1.26.5",
+					healthKey:                 "HEALTHY",
+					phaseKey:                  "READY",
+					infrastructureProviderKey: "AWS_EC2",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenClusters(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
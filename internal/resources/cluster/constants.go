@@ -19,6 +19,7 @@ const (
 	attachClusterKubeConfigRawKey  = "kubeconfig_raw"
 	waitKey                        = "ready_wait_timeout"
 	ResourceName                   = "tanzu-mission-control_cluster"
+	ListDataSourceName             = "tanzu-mission-control_clusters"
 	tkgAWSClusterKey               = "tkg_aws"
 	tkgServiceVsphereKey           = "tkg_service_vsphere"
 	tkgVsphereClusterKey           = "tkg_vsphere"
@@ -33,4 +34,20 @@ const (
 	workerNodeCountKey             = "worker_node_count"
 	classKey                       = "class"
 	storageClassKey                = "storage_class"
+	upgradeStatusKey               = "upgrade_status"
+	upgradeWaitTimeoutKey          = "upgrade_wait_timeout"
+	previousVersionKey             = "previous_version"
+	targetVersionKey               = "target_version"
+	upgradeStateKey                = "state"
+	controlPlanePhaseKey           = "control_plane_phase"
+	nodePoolPhasesKey              = "node_pool_phases"
+	queryKey                       = "query"
+	labelsKey                      = "labels"
+	kubernetesVersionKey           = "kubernetes_version"
+	healthKey                      = "health"
+	phaseKey                       = "phase"
+	clustersKey                    = "clusters"
+	totalCountKey                  = "total_count"
+	uidKey                         = "uid"
+	infrastructureProviderKey      = "infrastructure_provider"
 )
@@ -0,0 +1,359 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+var (
+	clusterHealthValues = []string{
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY),
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthWARNING),
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthUNHEALTHY),
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthDISCONNECTED),
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHUNSPECIFIED),
+	}
+
+	clusterPhaseValues = []string{
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhasePENDING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhasePROCESSING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseCREATING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseREADY),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseDELETING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseERROR),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseDETACHING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADEFAILED),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhasePHASEUNSPECIFIED),
+	}
+)
+
+func DataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClustersRead,
+		Schema:      clustersSchema,
+		Description: "Tanzu Mission Control Clusters Data Source",
+	}
+}
+
+var clustersSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the clusters to search for; supports globbing.",
+		Optional:    true,
+		Default:     "*",
+	},
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster of the clusters to search for; supports globbing.",
+		Optional:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner of the clusters to search for; supports globbing.",
+		Optional:    true,
+	},
+	clusterGroupKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster group of the clusters to search for; supports globbing.",
+		Optional:    true,
+	},
+	queryKey: {
+		Type:        schema.TypeString,
+		Description: "TQL query to filter the clusters.",
+		Optional:    true,
+	},
+	labelsKey: {
+		Type:        schema.TypeMap,
+		Description: "Only list the clusters carrying all of these labels.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	kubernetesVersionKey: {
+		Type:        schema.TypeString,
+		Description: "Only list the clusters running this Kubernetes version or a patch of it, for example: v1.26 or v1.26.5.",
+		Optional:    true,
+	},
+	healthKey: {
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("Only list the clusters having one of these health values: %s.", strings.Join(clusterHealthValues, ", ")),
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(clusterHealthValues, false),
+		},
+	},
+	phaseKey: {
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("Only list the clusters in one of these phases: %s.", strings.Join(clusterPhaseValues, ", ")),
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(clusterPhaseValues, false),
+		},
+	},
+	clustersKey: {
+		Type:        schema.TypeList,
+		Description: "List of clusters matching the search criteria, sorted by full name.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the cluster",
+					Computed:    true,
+				},
+				ManagementClusterNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the management cluster",
+					Computed:    true,
+				},
+				ProvisionerNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the provisioner",
+					Computed:    true,
+				},
+				clusterGroupKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the cluster group",
+					Computed:    true,
+				},
+				uidKey: {
+					Type:        schema.TypeString,
+					Description: "UID of the cluster",
+					Computed:    true,
+				},
+				labelsKey: {
+					Type:        schema.TypeMap,
+					Description: "Labels of the cluster",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				kubernetesVersionKey: {
+					Type:        schema.TypeString,
+					Description: "Kubernetes server version of the cluster",
+					Computed:    true,
+				},
+				healthKey: {
+					Type:        schema.TypeString,
+					Description: "Health of the cluster",
+					Computed:    true,
+				},
+				phaseKey: {
+					Type:        schema.TypeString,
+					Description: "Phase of the cluster",
+					Computed:    true,
+				},
+				infrastructureProviderKey: {
+					Type:        schema.TypeString,
+					Description: "Infrastructure provider of the cluster",
+					Computed:    true,
+				},
+			},
+		},
+	},
+	totalCountKey: {
+		Type:        schema.TypeInt,
+		Description: "Total count of clusters matching the search criteria.",
+		Computed:    true,
+	},
+}
+
+// clusterFilter holds the criteria which are not supported by the search scope of the list API.
+type clusterFilter struct {
+	labels            map[string]string
+	kubernetesVersion string
+	health            []string
+	phase             []string
+}
+
+func dataSourceClustersRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	request := &clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequest{
+		SearchScope: &clustermodel.VmwareTanzuManageV1alpha1ClusterSearchScope{},
+	}
+
+	request.SearchScope.Name, _ = d.Get(NameKey).(string)
+	request.SearchScope.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+	request.SearchScope.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+	request.SearchScope.ClusterGroupName, _ = d.Get(clusterGroupKey).(string)
+	request.Query, _ = d.Get(queryKey).(string)
+
+	labels, _ := d.Get(labelsKey).(map[string]interface{})
+	filter := &clusterFilter{
+		labels: common.GetTypeStringMapData(labels),
+		health: helper.SetPrimitiveList[string](d.Get(healthKey), healthKey),
+		phase:  helper.SetPrimitiveList[string](d.Get(phaseKey), phaseKey),
+	}
+	filter.kubernetesVersion, _ = d.Get(kubernetesVersionKey).(string)
+
+	clusters, err := ListClusters(config, request)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to list Tanzu Mission Control clusters, name : %s", request.SearchScope.Name))
+	}
+
+	clusters = filterClusters(clusters, filter)
+
+	if err := d.Set(clustersKey, flattenClusters(clusters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(totalCountKey, len(clusters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idKeys := []string{
+		request.SearchScope.Name,
+		request.SearchScope.ManagementClusterName,
+		request.SearchScope.ProvisionerName,
+		request.SearchScope.ClusterGroupName,
+		request.Query,
+		helper.LabelSelectorString(filter.labels),
+		filter.kubernetesVersion,
+		strings.Join(filter.health, ","),
+		strings.Join(filter.phase, ","),
+	}
+	d.SetId(fmt.Sprintf("clusters/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+// filterClusters returns the clusters matching all the criteria of the filter, sorted by full name.
+func filterClusters(clusters []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster, filter *clusterFilter) []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster {
+	filtered := make([]*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		if cluster == nil || cluster.FullName == nil {
+			continue
+		}
+
+		var labels map[string]string
+
+		if cluster.Meta != nil {
+			labels = cluster.Meta.Labels
+		}
+
+		if !helper.MatchLabels(labels, filter.labels) {
+			continue
+		}
+
+		status := cluster.Status
+		if status == nil {
+			status = &clustermodel.VmwareTanzuManageV1alpha1ClusterStatus{}
+		}
+
+		if filter.kubernetesVersion != "" && !matchKubernetesVersion(status.KubeServerVersion, filter.kubernetesVersion) {
+			continue
+		}
+
+		if len(filter.health) != 0 && (status.Health == nil || !containsValue(filter.health, string(*status.Health))) {
+			continue
+		}
+
+		if len(filter.phase) != 0 && (status.Phase == nil || !containsValue(filter.phase, string(*status.Phase))) {
+			continue
+		}
+
+		filtered = append(filtered, cluster)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return clusterFullNameString(filtered[i].FullName) < clusterFullNameString(filtered[j].FullName)
+	})
+
+	return filtered
+}
+
+// matchKubernetesVersion reports whether the version is the wanted version or one of its patches, ignoring the leading v.
+// For example v1.26.5+vmware.1 matches v1.26 and 1.26.5, but not v1.2.
+func matchKubernetesVersion(version, wanted string) bool {
+	version = strings.TrimPrefix(version, "v")
+	wanted = strings.TrimPrefix(wanted, "v")
+
+	if !strings.HasPrefix(version, wanted) {
+		return false
+	}
+
+	if len(version) == len(wanted) {
+		return true
+	}
+
+	return strings.ContainsAny(version[len(wanted):len(wanted)+1], ".+-")
+}
+
+func containsValue(values []string, value string) bool {
+	for _, each := range values {
+		if each == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func clusterFullNameString(fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) string {
+	return strings.Join([]string{fn.ManagementClusterName, fn.ProvisionerName, fn.Name}, "/")
+}
+
+func flattenClusters(clusters []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) (data []interface{}) {
+	for _, cluster := range clusters {
+		if cluster == nil {
+			continue
+		}
+
+		flattenData := make(map[string]interface{})
+
+		if cluster.FullName != nil {
+			flattenData[NameKey] = cluster.FullName.Name
+			flattenData[ManagementClusterNameKey] = cluster.FullName.ManagementClusterName
+			flattenData[ProvisionerNameKey] = cluster.FullName.ProvisionerName
+		}
+
+		if cluster.Meta != nil {
+			flattenData[uidKey] = cluster.Meta.UID
+			flattenData[labelsKey] = cluster.Meta.Labels
+		}
+
+		if cluster.Spec != nil {
+			flattenData[clusterGroupKey] = cluster.Spec.ClusterGroupName
+		}
+
+		if status := cluster.Status; status != nil {
+			flattenData[kubernetesVersionKey] = status.KubeServerVersion
+
+			if status.Health != nil {
+				flattenData[healthKey] = string(*status.Health)
+			}
+
+			if status.Phase != nil {
+				flattenData[phaseKey] = string(*status.Phase)
+			}
+
+			if status.InfrastructureProvider != nil {
+				flattenData[infrastructureProviderKey] = string(*status.InfrastructureProvider)
+			}
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data
+}
@@ -18,6 +18,7 @@ import (
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	dataprotectionmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/dataprotection"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
 func ResourceEnableDataProtection() *schema.Resource {
@@ -156,6 +157,22 @@ func resourceEnableDataProtectionUpdate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(errors.Wrapf(err, "Couldn't update Tanzu Mission Control data protection configurations."))
 	}
 
+	listResp, err := config.TMCConnection.DataProtectionService.DataProtectionResourceServiceList(model.FullName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't read Tanzu Mission Control data protection configurations.\nManagement Cluster Name: %s, Provisioner Name: %s, Cluster Name: %s",
+			model.FullName.ManagementClusterName, model.FullName.ProvisionerName, model.FullName.ClusterName))
+	}
+
+	if len(listResp.DataProtections) > 0 {
+		if err := common.CheckResourceVersion(data, listResp.DataProtections[0].Meta); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Couldn't update Tanzu Mission Control data protection configurations, cluster name : %s", model.FullName.ClusterName))
+		}
+
+		if model.Meta != nil && listResp.DataProtections[0].Meta != nil {
+			model.Meta.Labels = common.WithSystemManagedKeys(model.Meta.Labels, listResp.DataProtections[0].Meta.Labels)
+		}
+	}
+
 	request := &dataprotectionmodels.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest{
 		DataProtection: model,
 	}
@@ -62,6 +62,7 @@ func (r *resourceIntegration) create(ctx context.Context, d *schema.ResourceData
 	return append(diags, r.read(ctx, d, m)...)
 }
 
+// update rejects every change, the integration is never updated in place so there is no resource version to check.
 func (r *resourceIntegration) update(context.Context, *schema.ResourceData, interface{}) (diags diag.Diagnostics) {
 	return diag.FromErr(errors.New("update of Tanzu Mission Control integration is not supported"))
 }
@@ -0,0 +1,28 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kubeconfig
+
+const (
+	ResourceName = "tanzu-mission-control_cluster_kubeconfig"
+
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	NameKey                  = "name"
+	cliTypeKey               = "cli_type"
+	adminKey                 = "admin"
+	waitForKubeconfigKey     = "wait_for_kubeconfig"
+	waitTimeoutKey           = "wait_timeout"
+	kubeconfigKey            = "kubeconfig"
+	statusKey                = "status"
+	hostKey                  = "host"
+	clusterCACertificateKey  = "cluster_ca_certificate"
+	tokenKey                 = "token"
+	clientCertificateKey     = "client_certificate"
+	clientKeyKey             = "client_key"
+
+	attachedValue      = "attached"
+	defaultWaitTimeout = "10m"
+)
@@ -0,0 +1,228 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	kubeconfigmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubeconfig"
+)
+
+func DataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterKubeconfigRead,
+		Schema:      kubeconfigSchema,
+		Description: "Tanzu Mission Control Cluster Kubeconfig Data Source",
+	}
+}
+
+var kubeconfigSchema = map[string]*schema.Schema{
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster, e.g. attached, eks, aks or the name of a Tanzu Kubernetes Grid management cluster",
+		Default:     attachedValue,
+		Optional:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Provisioner of the cluster",
+		Default:     attachedValue,
+		Optional:    true,
+	},
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster",
+		Required:    true,
+	},
+	cliTypeKey: {
+		Type:        schema.TypeString,
+		Description: "CLI the kubeconfig is generated for: TANZU_CLI returns a pinniped based kubeconfig, TMC_CLI returns a kubeconfig authenticating through the tmc CLI. Ignored when admin is set.",
+		Default:     string(kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTANZUCLI),
+		Optional:    true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTANZUCLI),
+			string(kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTMCCLI),
+		}, false),
+	},
+	adminKey: {
+		Type:        schema.TypeBool,
+		Description: "Fetch the admin kubeconfig of a provisioned cluster instead of the user kubeconfig",
+		Default:     false,
+		Optional:    true,
+	},
+	waitForKubeconfigKey: {
+		Type:        schema.TypeBool,
+		Description: "Wait until the kubeconfig of the cluster is available",
+		Default:     false,
+		Optional:    true,
+	},
+	waitTimeoutKey: {
+		Type:        schema.TypeString,
+		Description: "Wait timeout duration until the kubeconfig is available. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero.",
+		Default:     defaultWaitTimeout,
+		Optional:    true,
+	},
+	kubeconfigKey: {
+		Type:        schema.TypeString,
+		Description: "Kubeconfig of the cluster",
+		Computed:    true,
+		Sensitive:   true,
+	},
+	statusKey: {
+		Type:        schema.TypeString,
+		Description: "Status of the kubeconfig",
+		Computed:    true,
+	},
+	hostKey: {
+		Type:        schema.TypeString,
+		Description: "API server endpoint of the cluster",
+		Computed:    true,
+	},
+	clusterCACertificateKey: {
+		Type:        schema.TypeString,
+		Description: "PEM encoded certificate authority of the cluster",
+		Computed:    true,
+	},
+	tokenKey: {
+		Type:        schema.TypeString,
+		Description: "Bearer token of the kubeconfig user, if any",
+		Computed:    true,
+		Sensitive:   true,
+	},
+	clientCertificateKey: {
+		Type:        schema.TypeString,
+		Description: "PEM encoded client certificate of the kubeconfig user, if any",
+		Computed:    true,
+	},
+	clientKeyKey: {
+		Type:        schema.TypeString,
+		Description: "PEM encoded client key of the kubeconfig user, if any",
+		Computed:    true,
+		Sensitive:   true,
+	},
+}
+
+func constructFullname(data *schema.ResourceData) *kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterFullName {
+	fullName := &kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterFullName{}
+
+	fullName.ManagementClusterName, _ = data.Get(ManagementClusterNameKey).(string)
+	fullName.ProvisionerName, _ = data.Get(ProvisionerNameKey).(string)
+	fullName.Name, _ = data.Get(NameKey).(string)
+
+	return fullName
+}
+
+func dataSourceClusterKubeconfigRead(_ context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+	fullName := constructFullname(data)
+	admin, _ := data.Get(adminKey).(bool)
+	cliType, _ := data.Get(cliTypeKey).(string)
+
+	var resp *kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse
+
+	getKubeconfigFn := func() (retry bool, err error) {
+		if admin {
+			resp, err = config.TMCConnection.KubeConfigResourceService.AdminKubeconfigServiceGet(fullName)
+		} else {
+			resp, err = config.TMCConnection.KubeConfigResourceService.KubeconfigServiceGetForCli(fullName, kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType(cliType))
+		}
+
+		if err != nil {
+			// refresh auth bearer token if it expired
+			authctx.RefreshUserAuthContext(&config, clienterrors.IsUnauthorizedError, err)
+
+			return true, errors.Wrapf(err, "Unable to get Tanzu Mission Control kubeconfig for cluster, name : %s", fullName.Name)
+		}
+
+		if !isKubeconfigReady(resp) {
+			log.Printf("[DEBUG] waiting for kubeconfig of cluster(%s) to be available, present status:%v", fullName.Name, kubeconfigStatus(resp))
+			return true, errors.Errorf("kubeconfig for cluster %s is not available, status: %s %s", fullName.Name, kubeconfigStatus(resp), resp.Msg)
+		}
+
+		return false, nil
+	}
+
+	var err error
+
+	if wait, _ := data.Get(waitForKubeconfigKey).(bool); wait {
+		timeoutValueData, _ := data.Get(waitTimeoutKey).(string)
+
+		timeoutDuration, parseErr := time.ParseDuration(timeoutValueData)
+		if parseErr != nil {
+			log.Printf("[INFO] unable to prase the duration value for the key %s. Defaulting to %s"+
+				" Please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", waitTimeoutKey, defaultWaitTimeout)
+
+			timeoutDuration, _ = time.ParseDuration(defaultWaitTimeout)
+		}
+
+		_, err = helper.RetryUntilTimeout(getKubeconfigFn, 10*time.Second, timeoutDuration)
+	} else {
+		_, err = getKubeconfigFn()
+	}
+
+	if err == nil && !isKubeconfigReady(resp) {
+		err = errors.Errorf("kubeconfig for cluster %s is not available", fullName.Name)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parsed, err := parseKubeconfig(resp.Kubeconfig)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to parse kubeconfig for cluster, name : %s", fullName.Name))
+	}
+
+	parsed[kubeconfigKey] = resp.Kubeconfig
+	parsed[statusKey] = kubeconfigStatus(resp)
+
+	if parsed[hostKey] == "" {
+		parsed[hostKey] = resp.Endpoint
+	}
+
+	for key, value := range parsed {
+		if err := data.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(strings.Join([]string{fullName.ManagementClusterName, fullName.ProvisionerName, fullName.Name}, "/"))
+
+	return nil
+}
+
+// isKubeconfigReady treats a response without status, as returned for admin kubeconfigs, as ready when it carries a kubeconfig.
+func isKubeconfigReady(resp *kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse) bool {
+	if resp == nil || resp.Kubeconfig == "" {
+		return false
+	}
+
+	return resp.Status == nil || *resp.Status == kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponseStatusREADY
+}
+
+func kubeconfigStatus(resp *kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse) string {
+	switch {
+	case resp == nil:
+		return ""
+	case resp.Status != nil:
+		return string(*resp.Status)
+	case resp.Kubeconfig != "":
+		return string(kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponseStatusREADY)
+	}
+
+	return ""
+}
@@ -0,0 +1,52 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kubeconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// parseKubeconfig extracts the connection details of the current context so they can be passed to the kubernetes and helm providers.
+func parseKubeconfig(raw string) (map[string]interface{}, error) {
+	parsed := map[string]interface{}{
+		hostKey:                 "",
+		clusterCACertificateKey: "",
+		tokenKey:                "",
+		clientCertificateKey:    "",
+		clientKeyKey:            "",
+	}
+
+	config, err := clientcmd.Load([]byte(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	currentContext := config.CurrentContext
+	if currentContext == "" && len(config.Contexts) == 1 {
+		for name := range config.Contexts {
+			currentContext = name
+		}
+	}
+
+	kubeContext, ok := config.Contexts[currentContext]
+	if !ok {
+		return nil, errors.Errorf("context %q not found in kubeconfig", currentContext)
+	}
+
+	if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+		parsed[hostKey] = cluster.Server
+		parsed[clusterCACertificateKey] = string(cluster.CertificateAuthorityData)
+	}
+
+	if authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+		parsed[tokenKey] = authInfo.Token
+		parsed[clientCertificateKey] = string(authInfo.ClientCertificateData)
+		parsed[clientKeyKey] = string(authInfo.ClientKeyData)
+	}
+
+	return parsed, nil
+}
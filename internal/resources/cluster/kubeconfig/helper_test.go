@@ -0,0 +1,105 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package kubeconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const tokenKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: demo
+  cluster:
+    server: https://10.0.0.1:6443
+    certificate-authority-data: Y2EtZGF0YQ==
+users:
+- name: demo-admin
+  user:
+    token: secret-token
+contexts:
+- name: demo-admin@demo
+  context:
+    cluster: demo
+    user: demo-admin
+current-context: demo-admin@demo
+`
+
+const pinnipedKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: demo
+  cluster:
+    server: https://10.0.0.1:6443
+    certificate-authority-data: Y2EtZGF0YQ==
+users:
+- name: tanzu-cli-demo
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: tanzu
+      args: ["pinniped-auth", "login"]
+contexts:
+- name: tanzu-cli-demo@demo
+  context:
+    cluster: demo
+    user: tanzu-cli-demo
+`
+
+func TestParseKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       string
+		expected    map[string]interface{}
+		expectError bool
+	}{
+		{
+			description: "token based kubeconfig",
+			input:       tokenKubeconfig,
+			expected: map[string]interface{}{
+				hostKey:                 "https://10.0.0.1:6443",
+				clusterCACertificateKey: "ca-data",
+				tokenKey:                "secret-token",
+				clientCertificateKey:    "",
+				clientKeyKey:            "",
+			},
+		},
+		{
+			description: "pinniped kubeconfig with a single context and no current context",
+			input:       pinnipedKubeconfig,
+			expected: map[string]interface{}{
+				hostKey:                 "https://10.0.0.1:6443",
+				clusterCACertificateKey: "ca-data",
+				tokenKey:                "",
+				clientCertificateKey:    "",
+				clientKeyKey:            "",
+			},
+		},
+		{
+			description: "invalid kubeconfig",
+			input:       "clusters: [",
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := parseKubeconfig(test.input)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
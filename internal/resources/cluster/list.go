@@ -0,0 +1,49 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// nolint: dupl
+package cluster
+
+import (
+	"strconv"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// ListClusters returns all the clusters matching the search scope and query of the request, across all pages.
+func ListClusters(config authctx.TanzuContext, request *clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequest) ([]*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster, error) {
+	clusters := make([]*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster, 0)
+
+	pageRequest := *request
+	pageRequest.SortBy = "fullName.name"
+	pageRequest.IncludeTotalCount = true
+
+	err := helper.ListAllPages(helper.DefaultPageSize, func(offset, size int) (int, int, error) {
+		pageRequest.Pagination = &optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{
+			Offset: strconv.Itoa(offset),
+			Size:   strconv.Itoa(size),
+		}
+
+		resp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceList(&pageRequest)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return 0, 0, nil
+			}
+
+			return 0, 0, err
+		}
+
+		clusters = append(clusters, resp.Clusters...)
+		totalCount, _ := strconv.Atoi(resp.TotalCount)
+
+		return len(resp.Clusters), totalCount, nil
+	})
+
+	return clusters, err
+}
@@ -144,6 +144,19 @@ func createObjects(k8sclient *k8sClient.Client, manifests []manifest) error {
 	return nil
 }
 
+func deleteObjects(k8sclient *k8sClient.Client, manifests []manifest) error {
+	for i := len(manifests) - 1; i >= 0; i-- {
+		unstruct := &unstructured.Unstructured{Object: manifests[i].usObj}
+
+		err := ensureObjectDeleted(k8sclient, unstruct)
+		if err != nil {
+			return fmt.Errorf("failed to delete object %v of type %v, error:%v", manifests[i].namespacedName, manifests[i].gvk, err)
+		}
+	}
+
+	return nil
+}
+
 func ensureObjectDeleted(k8sclient *k8sClient.Client, object *unstructured.Unstructured) (err error) {
 	deleteFn := func() (bool, error) {
 		err = (*k8sclient).Delete(context.Background(), object)
@@ -55,3 +55,27 @@ func Create(
 
 	return nil
 }
+
+// Delete removes the k8s objects described in the manifest from the cluster, in reverse order of creation.
+func Delete(
+	k8sclient *k8sClient.Client,
+	k8sManifest string,
+) error {
+	if k8sclient == nil {
+		return errors.New("kubernetes client cannot be empty")
+	}
+
+	manifests, err := getManifests(k8sManifest)
+	if err != nil {
+		return errors.WithMessage(err, "failure to fetch manifests")
+	}
+
+	err = deleteObjects(k8sclient, manifests)
+	if err != nil {
+		return errors.WithMessage(err, "error while cleaning up the cluster")
+	}
+
+	fmt.Println("TMC resources removed from the cluster successfully")
+
+	return nil
+}
@@ -38,4 +38,9 @@ const (
 	statusKey                   = "status"
 	ready                       = "Ready"
 	waitKey                     = "ready_wait_timeout"
+	autoscalingConfigKey        = "auto_scaling_config"
+	enableKey                   = "enable"
+	minCountKey                 = "min_count"
+	maxCountKey                 = "max_count"
+	maxNodePlacements           = 3
 )
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
@@ -33,6 +34,7 @@ func ResourceNodePool() *schema.Resource {
 		CreateContext: resourceNodePoolCreate,
 		UpdateContext: resourceClusterNodePoolInPlaceUpdate,
 		DeleteContext: resourceClusterNodePoolDelete,
+		CustomizeDiff: validateNodePoolSpec,
 		Schema:        nodePoolSchema,
 	}
 }
@@ -86,9 +88,10 @@ var nodePoolSpec = &schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			workerNodeCountKey: {
-				Type:        schema.TypeString,
-				Description: "Count is the number of nodes",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "Count is the number of nodes, changes are ignored while the cluster autoscaler is enabled",
+				Required:         true,
+				DiffSuppressFunc: suppressAutoScaledWorkerNodeCount,
 			},
 			cloudLabelsKey: {
 				Type:        schema.TypeMap,
@@ -209,6 +212,7 @@ var NodePoolTkgAWS = &schema.Schema{
 	Type:        schema.TypeList,
 	Description: "TKGAWSNodepool is the nodepool spec for TKG AWS cluster",
 	Optional:    true,
+	MaxItems:    1,
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			classKey: {
@@ -221,6 +225,73 @@ var NodePoolTkgAWS = &schema.Schema{
 				Description: "Storage Class to be used for storage of the disks which store the root filesystem of the nodes",
 				Optional:    true,
 			},
+			nodepoolInstanceTypeKey: {
+				Type:        schema.TypeString,
+				Description: "Nodepool instance type, the potential values could be found using cluster:options api",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			nodepoolAvailabilityZoneKey: {
+				Type:        schema.TypeString,
+				Description: "Availability zone for the nodepool, only for clusters in TMC hosted AWS solution. Use node_placement for TKG workload clusters",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			nodePlacementKey: {
+				Type:        schema.TypeList,
+				Description: "List of AWS availability zones to place the nodes on. Specify 1 AZ for a dev cluster and up to 3 AZs for production cluster",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    maxNodePlacements,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						awsAvailabilityZoneKey: {
+							Type:        schema.TypeString,
+							Description: "The availability zone for the nodes",
+							Required:    true,
+						},
+					},
+				},
+			},
+			privateSubnetIDKey: {
+				Type:        schema.TypeString,
+				Description: "Subnet ID of the private subnet in which the nodes are created, the availability zone is ignored when specified",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			nodepoolVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Kubernetes version of the node pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			autoscalingConfigKey: {
+				Type:        schema.TypeList,
+				Description: "Cluster autoscaler config for the node pool",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						enableKey: {
+							Type:        schema.TypeBool,
+							Description: "Enable auto scaling",
+							Optional:    true,
+						},
+						minCountKey: {
+							Type:             schema.TypeInt,
+							Description:      "Minimum node count",
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+						},
+						maxCountKey: {
+							Type:             schema.TypeInt,
+							Description:      "Maximum node count",
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+					},
+				},
+			},
 		},
 	},
 }
@@ -338,9 +409,46 @@ func constructTkgAWS(data []interface{}) (tkgAWS *nodepoolmodel.VmwareTanzuManag
 		tkgAWS.Version, _ = v.(string)
 	}
 
+	if v, ok := lookUpTKGAWS[autoscalingConfigKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			tkgAWS.AutoScaling = constructTKGAWSAutoScalingConfig(v1)
+		}
+	}
+
 	return tkgAWS
 }
 
+// suppressAutoScaledWorkerNodeCount ignores the difference between the configured worker node count and the count
+// set by the cluster autoscaler while it is enabled, the count is still sent on creation.
+func suppressAutoScaledWorkerNodeCount(_, oldValue, _ string, d *schema.ResourceData) bool {
+	enabled, _ := d.Get(helper.GetFirstElementOf(specKey, tkgAWSKey, autoscalingConfigKey, enableKey)).(bool)
+
+	return enabled && oldValue != ""
+}
+
+func constructTKGAWSAutoScalingConfig(data []interface{}) (autoScaling *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig) {
+	if len(data) == 0 || data[0] == nil {
+		return autoScaling
+	}
+
+	lookUpAutoScaling, _ := data[0].(map[string]interface{})
+	autoScaling = &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{}
+
+	if v, ok := lookUpAutoScaling[enableKey]; ok {
+		helper.SetPrimitiveValue(v, &autoScaling.Enabled, enableKey)
+	}
+
+	if v, ok := lookUpAutoScaling[minCountKey]; ok {
+		helper.SetPrimitiveValue(v, &autoScaling.MinCount, minCountKey)
+	}
+
+	if v, ok := lookUpAutoScaling[maxCountKey]; ok {
+		helper.SetPrimitiveValue(v, &autoScaling.MaxCount, maxCountKey)
+	}
+
+	return autoScaling
+}
+
 func constructTKGAWSNodePlacement(data interface{}) (nodeplacement *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement) {
 	if data == nil {
 		return nodeplacement
@@ -502,10 +610,15 @@ func flattenNodePoolTKGAWS(tkgAWS *nodepoolmodel.VmwareTanzuManageV1alpha1Cluste
 	flattenTKGAWS[privateSubnetIDKey] = tkgAWS.SubnetID
 	flattenTKGAWS[nodepoolVersionKey] = tkgAWS.Version
 
+	// A disabled config without bounds is what removing the block leaves, it is not shown.
+	if tkgAWS.AutoScaling != nil && *tkgAWS.AutoScaling != (nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{}) {
+		flattenTKGAWS[autoscalingConfigKey] = flattenTKGAWSAutoScalingConfig(tkgAWS.AutoScaling)
+	}
+
 	return []interface{}{flattenTKGAWS}
 }
 
-func flattenTKGAWSNodePlacement(nodeplacement *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement) (data []interface{}) {
+func flattenTKGAWSNodePlacement(nodeplacement *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement) (data interface{}) {
 	flattenNodePlacement := make(map[string]interface{})
 
 	if nodeplacement == nil {
@@ -514,7 +627,17 @@ func flattenTKGAWSNodePlacement(nodeplacement *nodepoolmodel.VmwareTanzuManageV1
 
 	flattenNodePlacement[awsAvailabilityZoneKey] = nodeplacement.AvailabilityZone
 
-	return []interface{}{flattenNodePlacement}
+	return flattenNodePlacement
+}
+
+func flattenTKGAWSAutoScalingConfig(autoScaling *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig) (data []interface{}) {
+	flattenAutoScaling := make(map[string]interface{})
+
+	flattenAutoScaling[enableKey] = autoScaling.Enabled
+	flattenAutoScaling[minCountKey] = int(autoScaling.MinCount)
+	flattenAutoScaling[maxCountKey] = int(autoScaling.MaxCount)
+
+	return []interface{}{flattenAutoScaling}
 }
 
 func flattenNodePoolTKGVsphere(tkgVsphere *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGVsphereNodepool) (data []interface{}) {
@@ -613,6 +736,10 @@ func resourceClusterNodePoolInPlaceUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(errors.Wrapf(err, "Unable to get tanzu cluster node pool entry"))
 	}
 
+	if err := common.CheckResourceVersion(d, getResp.Nodepool.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update tanzu cluster node pool entry"))
+	}
+
 	switch {
 	case getResp.Nodepool.Spec.TkgServiceVsphere != nil:
 		if d.HasChange(helper.GetFirstElementOf(specKey, workerNodeCountKey)) ||
@@ -643,6 +770,37 @@ func resourceClusterNodePoolInPlaceUpdate(ctx context.Context, d *schema.Resourc
 			getResp.Nodepool.Spec.TkgServiceVsphere.StorageClass = incomingTkgServiceVsphereStorageClass.(string)
 		}
 
+	case getResp.Nodepool.Spec.TkgAws != nil:
+		if d.HasChange(helper.GetFirstElementOf(specKey, workerNodeCountKey)) ||
+			d.HasChange(helper.GetFirstElementOf(specKey, tkgAWSKey, nodepoolVersionKey)) ||
+			d.HasChange(helper.GetFirstElementOf(specKey, tkgAWSKey, autoscalingConfigKey)) {
+			updateRequired = true
+		}
+
+		if !updateRequired {
+			return diags
+		}
+
+		incomingWorkerNodeCount := d.Get(helper.GetFirstElementOf(specKey, workerNodeCountKey))
+
+		if incomingWorkerNodeCount.(string) != "" {
+			getResp.Nodepool.Spec.WorkerNodeCount = incomingWorkerNodeCount.(string)
+		}
+
+		incomingTkgAWSVersion := d.Get(helper.GetFirstElementOf(specKey, tkgAWSKey, nodepoolVersionKey))
+
+		if incomingTkgAWSVersion.(string) != "" {
+			getResp.Nodepool.Spec.TkgAws.Version = incomingTkgAWSVersion.(string)
+		}
+
+		incomingAutoScaling, _ := d.Get(helper.GetFirstElementOf(specKey, tkgAWSKey, autoscalingConfigKey)).([]interface{})
+		getResp.Nodepool.Spec.TkgAws.AutoScaling = constructTKGAWSAutoScalingConfig(incomingAutoScaling)
+
+		// An omitted config leaves the cluster autoscaler as is, removing the block must disable it.
+		if getResp.Nodepool.Spec.TkgAws.AutoScaling == nil {
+			getResp.Nodepool.Spec.TkgAws.AutoScaling = &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{Enabled: false}
+		}
+
 	case getResp.Nodepool.Spec.TkgVsphere != nil:
 		if d.HasChange(helper.GetFirstElementOf(specKey, workerNodeCountKey)) {
 			updateRequired = true
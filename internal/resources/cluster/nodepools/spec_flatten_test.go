@@ -242,6 +242,123 @@ func TestFlattenNodePoolSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "normal scenario with TKG AWS node placement and auto scaling",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolSpec{
+				CloudLabels: map[string]string{
+					"key": "value",
+				},
+				NodeLabels: map[string]string{
+					"key": "value",
+				},
+				TkgAws: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+					InstanceType: "m5.large",
+					NodePlacement: []*nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement{
+						{
+							AvailabilityZone: "us-west-2a",
+						},
+						{
+							AvailabilityZone: "us-west-2b",
+						},
+					},
+					SubnetID: "subnet-0a1b2c3d",
+					Version:  "v1.26.5+vmware.2-tkg.1",
+					AutoScaling: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{
+						Enabled:  true,
+						MinCount: 1,
+						MaxCount: 5,
+					},
+				},
+				WorkerNodeCount: "2",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					cloudLabelsKey: map[string]string{
+						"key": "value",
+					},
+					nodeLabelsKey: map[string]string{
+						"key": "value",
+					},
+					workerNodeCountKey: "2",
+					tkgAWSKey: []interface{}{
+						map[string]interface{}{
+							nodepoolAvailabilityZoneKey: "",
+							nodepoolInstanceTypeKey:     "m5.large",
+							nodePlacementKey: []interface{}{
+								map[string]interface{}{
+									awsAvailabilityZoneKey: "us-west-2a",
+								},
+								map[string]interface{}{
+									awsAvailabilityZoneKey: "us-west-2b",
+								},
+							},
+							privateSubnetIDKey: "subnet-0a1b2c3d",
+							nodepoolVersionKey: "v1.26.5+vmware.2-tkg.1",
+							autoscalingConfigKey: []interface{}{
+								map[string]interface{}{
+									enableKey:   true,
+									minCountKey: 1,
+									maxCountKey: 5,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "normal scenario with TKG AWS data without auto scaling",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolSpec{
+				TkgAws: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+					AvailabilityZone: "us-west-2a",
+					InstanceType:     "m5.large",
+				},
+				WorkerNodeCount: "1",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					cloudLabelsKey:     map[string]string(nil),
+					nodeLabelsKey:      map[string]string(nil),
+					workerNodeCountKey: "1",
+					tkgAWSKey: []interface{}{
+						map[string]interface{}{
+							nodepoolAvailabilityZoneKey: "us-west-2a",
+							nodepoolInstanceTypeKey:     "m5.large",
+							nodePlacementKey:            []interface{}{},
+							privateSubnetIDKey:          "",
+							nodepoolVersionKey:          "",
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "TKG AWS data with the auto scaling disabled by removing its config",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolSpec{
+				TkgAws: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+					AvailabilityZone: "us-west-2a",
+					InstanceType:     "m5.large",
+					AutoScaling:      &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{},
+				},
+				WorkerNodeCount: "1",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					cloudLabelsKey:     map[string]string(nil),
+					nodeLabelsKey:      map[string]string(nil),
+					workerNodeCountKey: "1",
+					tkgAWSKey: []interface{}{
+						map[string]interface{}{
+							nodepoolAvailabilityZoneKey: "us-west-2a",
+							nodepoolInstanceTypeKey:     "m5.large",
+							nodePlacementKey:            []interface{}{},
+							privateSubnetIDKey:          "",
+							nodepoolVersionKey:          "",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, each := range cases {
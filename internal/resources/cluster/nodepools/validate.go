@@ -0,0 +1,101 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package nodepools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	nodepoolmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/nodepool"
+)
+
+var (
+	awsAvailabilityZoneRegex = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d[a-z]$`)
+	awsSubnetIDRegex         = regexp.MustCompile(`^subnet-[0-9a-f]{8}([0-9a-f]{9})?$`)
+)
+
+func validateNodePoolSpec(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	tkgAWSData, _ := d.Get(helper.GetFirstElementOf(specKey, tkgAWSKey)).([]interface{})
+
+	tkgAWS := constructTkgAWS(tkgAWSData)
+	if tkgAWS == nil {
+		return nil
+	}
+
+	workerNodeCount, _ := d.Get(helper.GetFirstElementOf(specKey, workerNodeCountKey)).(string)
+
+	return validateTKGAWSNodePool(tkgAWS, workerNodeCount)
+}
+
+// validateTKGAWSNodePool validates the placement and autoscaling bounds of a TKG AWS node pool.
+func validateTKGAWSNodePool(tkgAWS *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool, workerNodeCount string) error {
+	if tkgAWS.AvailabilityZone != "" && len(tkgAWS.NodePlacement) != 0 {
+		return fmt.Errorf("%v and %v are mutually exclusive: use %v for TKG workload clusters", nodepoolAvailabilityZoneKey, nodePlacementKey, nodePlacementKey)
+	}
+
+	if tkgAWS.AvailabilityZone != "" && !awsAvailabilityZoneRegex.MatchString(tkgAWS.AvailabilityZone) {
+		return fmt.Errorf("%v %q is not a valid AWS availability zone", nodepoolAvailabilityZoneKey, tkgAWS.AvailabilityZone)
+	}
+
+	availabilityZones := make(map[string]bool)
+
+	for _, nodePlacement := range tkgAWS.NodePlacement {
+		if nodePlacement == nil {
+			continue
+		}
+
+		if !awsAvailabilityZoneRegex.MatchString(nodePlacement.AvailabilityZone) {
+			return fmt.Errorf("%v %q is not a valid AWS availability zone", awsAvailabilityZoneKey, nodePlacement.AvailabilityZone)
+		}
+
+		if availabilityZones[nodePlacement.AvailabilityZone] {
+			return fmt.Errorf("%v %q is specified more than once in %v", awsAvailabilityZoneKey, nodePlacement.AvailabilityZone, nodePlacementKey)
+		}
+
+		availabilityZones[nodePlacement.AvailabilityZone] = true
+	}
+
+	if tkgAWS.SubnetID != "" && !awsSubnetIDRegex.MatchString(tkgAWS.SubnetID) {
+		return fmt.Errorf("%v %q is not a valid AWS subnet ID", privateSubnetIDKey, tkgAWS.SubnetID)
+	}
+
+	return validateTKGAWSAutoScaling(tkgAWS.AutoScaling, workerNodeCount)
+}
+
+func validateTKGAWSAutoScaling(autoScaling *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig, workerNodeCount string) error {
+	if autoScaling == nil || !autoScaling.Enabled {
+		return nil
+	}
+
+	if autoScaling.MaxCount < 1 {
+		return fmt.Errorf("%v must be at least 1 when auto scaling is enabled", maxCountKey)
+	}
+
+	if autoScaling.MinCount > autoScaling.MaxCount {
+		return fmt.Errorf("%v (%d) must not be greater than %v (%d)", minCountKey, autoScaling.MinCount, maxCountKey, autoScaling.MaxCount)
+	}
+
+	// worker node count is unknown at plan time when it is derived from other resources.
+	if workerNodeCount == "" {
+		return nil
+	}
+
+	count, err := strconv.Atoi(workerNodeCount)
+	if err != nil {
+		return fmt.Errorf("%v %q is not a valid number", workerNodeCountKey, workerNodeCount)
+	}
+
+	if count < int(autoScaling.MinCount) || count > int(autoScaling.MaxCount) {
+		return fmt.Errorf("%v (%d) must be within the auto scaling bounds [%d, %d]", workerNodeCountKey, count, autoScaling.MinCount, autoScaling.MaxCount)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package nodepools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	nodepoolmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/nodepool"
+)
+
+func TestValidateTKGAWSNodePool(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description     string
+		input           *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool
+		workerNodeCount string
+		expectErr       bool
+	}{
+		{
+			description: "node placement across three availability zones",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				NodePlacement: []*nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement{
+					{AvailabilityZone: "us-west-2a"},
+					{AvailabilityZone: "us-west-2b"},
+					{AvailabilityZone: "us-west-2c"},
+				},
+				SubnetID: "subnet-0123456789abcdef0",
+			},
+			workerNodeCount: "3",
+		},
+		{
+			description: "availability zone and node placement both provided",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				AvailabilityZone: "us-west-2a",
+				NodePlacement: []*nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement{
+					{AvailabilityZone: "us-west-2b"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			description: "duplicate node placement availability zone",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				NodePlacement: []*nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement{
+					{AvailabilityZone: "us-west-2a"},
+					{AvailabilityZone: "us-west-2a"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			description: "invalid node placement availability zone",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				NodePlacement: []*nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodePlacement{
+					{AvailabilityZone: "us-west-2"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			description: "invalid subnet ID",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				SubnetID: "vpc-0123456789abcdef0",
+			},
+			expectErr: true,
+		},
+		{
+			description: "auto scaling with worker node count within bounds",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				AutoScaling: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{
+					Enabled:  true,
+					MinCount: 1,
+					MaxCount: 5,
+				},
+			},
+			workerNodeCount: "2",
+		},
+		{
+			description: "auto scaling with min count greater than max count",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				AutoScaling: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{
+					Enabled:  true,
+					MinCount: 6,
+					MaxCount: 5,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			description: "auto scaling with worker node count outside bounds",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				AutoScaling: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{
+					Enabled:  true,
+					MinCount: 2,
+					MaxCount: 5,
+				},
+			},
+			workerNodeCount: "1",
+			expectErr:       true,
+		},
+		{
+			description: "disabled auto scaling bounds are not validated",
+			input: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSNodepool{
+				AutoScaling: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGAWSAutoScalingConfig{
+					MinCount: 6,
+					MaxCount: 5,
+				},
+			},
+			workerNodeCount: "1",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateTKGAWSNodePool(test.input, test.workerNodeCount)
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -40,13 +40,12 @@ type (
 
 func ResourceTMCCluster() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			return dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m)
-		},
+		ReadContext:   resourceClusterRead,
 		CreateContext: resourceClusterCreate,
 		UpdateContext: resourceClusterInPlaceUpdate,
 		DeleteContext: resourceClusterDelete,
 		Schema:        clusterSchema,
+		CustomizeDiff: validateClusterVersionUpgrade,
 	}
 }
 
@@ -86,6 +85,13 @@ var clusterSchema = map[string]*schema.Schema{
 		Default:     "default",
 		Optional:    true,
 	},
+	upgradeWaitTimeoutKey: {
+		Type:        schema.TypeString,
+		Description: "Wait timeout duration until the control plane and node pools of the cluster finish a Kubernetes version upgrade. Accepted timeout duration values like 30m or 2h, higher than zero.",
+		Default:     "60m",
+		Optional:    true,
+	},
+	upgradeStatusKey: upgradeStatus,
 }
 
 func constructFullname(d *schema.ResourceData) (fullname *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) {
@@ -366,10 +372,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
+	clusterMeta := common.ConstructMeta(d)
+	clusterMeta.Labels[common.ManagedByLabelKey] = ResourceName
+
 	clusterReq := &clustermodel.VmwareTanzuManageV1alpha1ClusterRequest{
 		Cluster: &clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
 			FullName: constructFullname(d),
-			Meta:     common.ConstructMeta(d),
+			Meta:     clusterMeta,
 			Spec:     constructSpec(d),
 		},
 	}
@@ -580,12 +589,9 @@ func withMetaUpdate(d *schema.ResourceData, cluster *clustermodel.VmwareTanzuMan
 	}
 
 	objectMeta := common.ConstructMeta(d)
+	objectMeta.Labels[common.ManagedByLabelKey] = ResourceName
 
-	if value, ok := cluster.Meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
-
-	cluster.Meta.Labels = objectMeta.Labels
+	cluster.Meta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, cluster.Meta.Labels)
 	cluster.Meta.Description = objectMeta.Description
 
 	log.Printf("[INFO] updating cluster meta data")
@@ -643,6 +649,19 @@ func withTKGNodePoolUpdate(d *schema.ResourceData, nodepool *nodepoolmodel.Vmwar
 	return false
 }
 
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	if err := refreshUpgradeStatus(m.(authctx.TanzuContext), d); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
 func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	config := m.(authctx.TanzuContext)
 
@@ -654,6 +673,22 @@ func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
 	}
 
+	if err := common.CheckResourceVersion(d, getResp.Cluster.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+	}
+
+	previousVersion := getClusterDistributionVersion(getResp.Cluster)
+	targetVersion, versionUpgrade := getVersionUpgrade(d)
+
+	if versionUpgrade {
+		if err := clusterUpgradePreflight(config, getResp.Cluster, targetVersion); err != nil {
+			// keep the previous version in state as the upgrade was never requested.
+			d.Partial(true)
+
+			return diag.FromErr(errors.Wrapf(err, "Unable to upgrade Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		}
+	}
+
 	updates := updateCheck{withMetaUpdate, withClusterGroupUpdate, withTKGsVsphereVersionUpdate, withTKGmVsphereVersionUpdate}
 
 	for _, update := range updates {
@@ -674,6 +709,12 @@ func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 		log.Printf("[INFO] cluster update successful")
 	}
+
+	if versionUpgrade {
+		if err := waitForClusterUpgrade(config, d, previousVersion, targetVersion); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Unable to upgrade Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		}
+	}
 	// check default nodepool configuration update
 	npFullName := constructNodePoolFullName(d)
 
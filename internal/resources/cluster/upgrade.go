@@ -0,0 +1,571 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	nodepoolmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/nodepool"
+	tkrmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetesrelease"
+)
+
+const (
+	upgradeStateControlPlane = "CONTROL_PLANE_UPGRADING"
+	upgradeStateNodePools    = "NODE_POOLS_UPGRADING"
+	upgradeStateCompleted    = "COMPLETED"
+	upgradeStateFailed       = "FAILED"
+
+	nodePoolReadyCondition = "Ready"
+
+	defaultUpgradeTimeout = 60 * time.Minute
+	upgradePollInterval   = 30 * time.Second
+)
+
+// kubernetesVersionRegex matches the leading semantic version of TKG distribution versions such as
+// v1.26.5+vmware.2-tkg.1 or the TKGs release name form v1.26.5---vmware.2-tkg.1.
+var kubernetesVersionRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)`)
+
+var upgradeStatus = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Progress of the latest Kubernetes version upgrade of the cluster",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			previousVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Version of the cluster before the upgrade",
+				Computed:    true,
+			},
+			targetVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Version the cluster is being upgraded to",
+				Computed:    true,
+			},
+			upgradeStateKey: {
+				Type:        schema.TypeString,
+				Description: "State of the upgrade: CONTROL_PLANE_UPGRADING, NODE_POOLS_UPGRADING, COMPLETED or FAILED",
+				Computed:    true,
+			},
+			controlPlanePhaseKey: {
+				Type:        schema.TypeString,
+				Description: "Last observed phase of the cluster control plane",
+				Computed:    true,
+			},
+			nodePoolPhasesKey: {
+				Type:        schema.TypeMap,
+				Description: "Last observed phase of each node pool of the cluster",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+type kubernetesVersion struct {
+	major int
+	minor int
+	patch int
+}
+
+func parseKubernetesVersion(version string) (kubernetesVersion, error) {
+	matches := kubernetesVersionRegex.FindStringSubmatch(strings.TrimSpace(version))
+	if matches == nil {
+		return kubernetesVersion{}, errors.Errorf("unable to parse kubernetes version %q", version)
+	}
+
+	parsed := make([]int, 0, 3)
+
+	for _, match := range matches[1:] {
+		value, err := strconv.Atoi(match)
+		if err != nil {
+			return kubernetesVersion{}, errors.Wrapf(err, "unable to parse kubernetes version %q", version)
+		}
+
+		parsed = append(parsed, value)
+	}
+
+	return kubernetesVersion{major: parsed[0], minor: parsed[1], patch: parsed[2]}, nil
+}
+
+// validateVersionUpgrade blocks downgrades, major version changes and upgrades that skip a minor version.
+func validateVersionUpgrade(currentVersion, targetVersion string) error {
+	if currentVersion == "" || targetVersion == "" || currentVersion == targetVersion {
+		return nil
+	}
+
+	current, err := parseKubernetesVersion(currentVersion)
+	if err != nil {
+		return err
+	}
+
+	target, err := parseKubernetesVersion(targetVersion)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case target.major != current.major:
+		return errors.Errorf("upgrading cluster from %s to %s is not supported: major version upgrades are not allowed", currentVersion, targetVersion)
+	case target.minor < current.minor || (target.minor == current.minor && target.patch < current.patch):
+		return errors.Errorf("downgrading cluster from %s to %s is not supported", currentVersion, targetVersion)
+	case target.minor > current.minor+1:
+		return errors.Errorf("upgrading cluster from %s to %s skips a minor version: upgrade to v%d.%d.x first",
+			currentVersion, targetVersion, current.major, current.minor+1)
+	}
+
+	return nil
+}
+
+// validateVersionAvailable checks that the target version is one of the compatible releases of the provisioner.
+func validateVersionAvailable(targetVersion string, releases []*tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease) error {
+	available := make([]string, 0, len(releases))
+
+	for _, release := range releases {
+		if release == nil || release.Spec == nil {
+			continue
+		}
+
+		if release.Status != nil && !release.Status.Compatible {
+			continue
+		}
+
+		releaseName := ""
+		if release.FullName != nil {
+			releaseName = strings.ReplaceAll(release.FullName.Name, "---", "+")
+		}
+
+		if release.Spec.Version == targetVersion || releaseName == targetVersion {
+			return nil
+		}
+
+		available = append(available, release.Spec.Version)
+	}
+
+	return errors.Errorf("version %s is not available on the provisioner, available versions: [%s]", targetVersion, strings.Join(available, ", "))
+}
+
+// getVersionUpgrade returns the target version when the TKGm or TKGs distribution version has changed.
+func getVersionUpgrade(d *schema.ResourceData) (targetVersion string, ok bool) {
+	for _, key := range []string{tkgVsphereClusterKey, tkgServiceVsphereKey} {
+		versionPath := helper.GetFirstElementOf(SpecKey, key, distributionKey, versionKey)
+
+		if d.HasChange(versionPath) {
+			if v, _ := d.Get(versionPath).(string); v != "" {
+				return v, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func getClusterDistributionVersion(cluster *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) string {
+	switch {
+	case cluster == nil || cluster.Spec == nil:
+		return ""
+	case cluster.Spec.TkgVsphere != nil && cluster.Spec.TkgVsphere.Distribution != nil:
+		return cluster.Spec.TkgVsphere.Distribution.Version
+	case cluster.Spec.TkgServiceVsphere != nil && cluster.Spec.TkgServiceVsphere.Distribution != nil:
+		return cluster.Spec.TkgServiceVsphere.Distribution.Version
+	}
+
+	return ""
+}
+
+func validateClusterVersionUpgrade(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{tkgVsphereClusterKey, tkgServiceVsphereKey} {
+		versionPath := helper.GetFirstElementOf(SpecKey, key, distributionKey, versionKey)
+
+		if !diff.HasChange(versionPath) {
+			continue
+		}
+
+		oldVersion, newVersion := diff.GetChange(versionPath)
+
+		if err := validateVersionUpgrade(oldVersion.(string), newVersion.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clusterUpgradePreflight verifies that the cluster can be upgraded to the target version before the update is sent.
+func clusterUpgradePreflight(config authctx.TanzuContext, cluster *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster, targetVersion string) error {
+	if cluster.Status == nil || cluster.Status.Phase == nil ||
+		*cluster.Status.Phase != clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseREADY {
+		return errors.Errorf("cluster %s must be in %s phase before it can be upgraded", cluster.FullName.Name, clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseREADY)
+	}
+
+	if err := validateVersionUpgrade(getClusterDistributionVersion(cluster), targetVersion); err != nil {
+		return err
+	}
+
+	releases, err := config.TMCConnection.TanzuKubernetesReleaseResourceService.TanzuKubernetesReleaseResourceServiceList(
+		&tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName{
+			ManagementClusterName: cluster.FullName.ManagementClusterName,
+			ProvisionerName:       cluster.FullName.ProvisionerName,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to list the kubernetes versions available on provisioner %s", cluster.FullName.ProvisionerName)
+	}
+
+	return validateVersionAvailable(targetVersion, releases.TanzuKubernetesReleases)
+}
+
+func getUpgradeTimeout(d *schema.ResourceData) time.Duration {
+	timeoutValueData, _ := d.Get(upgradeWaitTimeoutKey).(string)
+
+	timeoutDuration, err := time.ParseDuration(timeoutValueData)
+	if err != nil || timeoutDuration <= 0 {
+		log.Printf("[INFO] unable to prase the duration value for the key %s. Defaulting to %s"+
+			" Please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", upgradeWaitTimeoutKey, defaultUpgradeTimeout)
+
+		return defaultUpgradeTimeout
+	}
+
+	return timeoutDuration
+}
+
+// waitForClusterUpgrade waits for the control plane and then each node pool to finish the upgrade,
+// recording the observed progress in the upgrade status attribute.
+func waitForClusterUpgrade(config authctx.TanzuContext, d *schema.ResourceData, previousVersion, targetVersion string) error {
+	deadline := time.Now().Add(getUpgradeTimeout(d))
+	fullName := constructFullname(d)
+	nodePoolPhases := make(map[string]interface{})
+	status := map[string]interface{}{
+		previousVersionKey:   previousVersion,
+		targetVersionKey:     targetVersion,
+		upgradeStateKey:      upgradeStateControlPlane,
+		controlPlanePhaseKey: "",
+		nodePoolPhasesKey:    nodePoolPhases,
+	}
+
+	defer func() {
+		if err := d.Set(upgradeStatusKey, []interface{}{status}); err != nil {
+			log.Printf("[ERROR] unable to set %s for cluster(%s): %s", upgradeStatusKey, fullName.ToString(), err)
+		}
+	}()
+
+	target, err := parseKubernetesVersion(targetVersion)
+	if err != nil {
+		status[upgradeStateKey] = upgradeStateFailed
+		return err
+	}
+
+	controlPlaneUpgradedFn := func() (retry bool, err error) {
+		resp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(fullName)
+		if err != nil {
+			authctx.RefreshUserAuthContext(&config, clienterrors.IsUnauthorizedError, err)
+			return true, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", fullName.Name)
+		}
+
+		if resp == nil || resp.Cluster == nil {
+			return true, errors.Errorf("Tanzu Mission Control cluster entry not found, name : %s", fullName.Name)
+		}
+
+		if resp.Cluster.Status == nil || resp.Cluster.Status.Phase == nil {
+			return true, errors.Errorf("Status or Phase not found for Tanzu Mission Control cluster entry, name : %s", fullName.Name)
+		}
+
+		phase := *resp.Cluster.Status.Phase
+		status[controlPlanePhaseKey] = string(phase)
+
+		switch phase {
+		case clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADEFAILED, clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseERROR:
+			return false, errors.Errorf("control plane upgrade of cluster %s to %s failed, phase: %s", fullName.Name, targetVersion, phase)
+		case clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseREADY:
+			if resp.Cluster.Status.Health == nil || *resp.Cluster.Status.Health != clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY {
+				break
+			}
+
+			if serverVersion, err := parseKubernetesVersion(resp.Cluster.Status.KubeServerVersion); err == nil && serverVersion != target {
+				break
+			}
+
+			return false, nil
+		}
+
+		log.Printf("[DEBUG] waiting for control plane of cluster(%s) to be upgraded to %s, present phase:%v", fullName.ToString(), targetVersion, phase)
+
+		return true, errors.Errorf("control plane upgrade of cluster %s to %s did not complete in time, phase: %s", fullName.Name, targetVersion, phase)
+	}
+
+	if _, err = retryUntilDeadline(controlPlaneUpgradedFn, deadline); err != nil {
+		status[upgradeStateKey] = upgradeStateFailed
+		return err
+	}
+
+	log.Printf("[INFO] control plane of cluster(%s) upgraded to %s", fullName.ToString(), targetVersion)
+
+	status[upgradeStateKey] = upgradeStateNodePools
+
+	nodePoolsFullName := &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName{
+		ManagementClusterName: fullName.ManagementClusterName,
+		ProvisionerName:       fullName.ProvisionerName,
+		ClusterName:           fullName.Name,
+	}
+
+	nodePools, err := config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceList(nodePoolsFullName)
+	if err != nil {
+		status[upgradeStateKey] = upgradeStateFailed
+		return errors.Wrapf(err, "Unable to list Tanzu Mission Control cluster node pools, cluster name : %s", fullName.Name)
+	}
+
+	for _, nodePool := range nodePools.Nodepools {
+		if nodePool == nil || nodePool.FullName == nil {
+			continue
+		}
+
+		if err := upgradeNodePool(config, nodePool.FullName, targetVersion, nodePoolPhases, deadline); err != nil {
+			status[upgradeStateKey] = upgradeStateFailed
+			return err
+		}
+	}
+
+	status[upgradeStateKey] = upgradeStateCompleted
+
+	log.Printf("[INFO] cluster(%s) upgraded to %s", fullName.ToString(), targetVersion)
+
+	return nil
+}
+
+// upgradeNodePool requests the upgrade of the node pool to the target version, unless it already runs it,
+// and waits for the node pool to become ready with the target version.
+func upgradeNodePool(config authctx.TanzuContext, fn *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName, targetVersion string, phases map[string]interface{}, deadline time.Time) error {
+	resp, err := config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceGet(fn)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster node pool entry, name : %s", fn.Name)
+	}
+
+	if resp == nil || resp.Nodepool == nil || resp.Nodepool.Spec == nil {
+		return errors.Errorf("Tanzu Mission Control cluster node pool entry not found, name : %s", fn.Name)
+	}
+
+	var requestedAt time.Time
+
+	if getNodePoolVersion(resp.Nodepool.Spec) != targetVersion {
+		if !setNodePoolVersion(resp.Nodepool.Spec, targetVersion) {
+			return errors.Errorf("upgrade of node pool %s is not supported: the node pool has no TKG vSphere, TKG service vSphere or TKG AWS configuration", fn.Name)
+		}
+
+		requestedAt = time.Now()
+
+		_, err = config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceUpdate(
+			&nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolRequest{
+				Nodepool: resp.Nodepool,
+			},
+		)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to upgrade Tanzu Mission Control cluster node pool entry, name : %s", fn.Name)
+		}
+
+		log.Printf("[INFO] upgrade of node pool(%s) of cluster(%s) to %s requested", fn.Name, fn.ClusterName, targetVersion)
+	}
+
+	nodePoolUpgradedFn := func() (retry bool, err error) {
+		resp, err := config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceGet(fn)
+		if err != nil {
+			authctx.RefreshUserAuthContext(&config, clienterrors.IsUnauthorizedError, err)
+			return true, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster node pool entry, name : %s", fn.Name)
+		}
+
+		if resp == nil || resp.Nodepool == nil || resp.Nodepool.Status == nil || resp.Nodepool.Status.Phase == nil {
+			return true, errors.Errorf("Status or Phase not found for Tanzu Mission Control cluster node pool entry, name : %s", fn.Name)
+		}
+
+		phases[fn.Name] = string(*resp.Nodepool.Status.Phase)
+
+		upgraded, err := isNodePoolUpgraded(resp.Nodepool, targetVersion, requestedAt)
+		if err != nil {
+			return false, errors.Wrapf(err, "Unable to upgrade Tanzu Mission Control cluster node pool entry, name : %s", fn.Name)
+		}
+
+		if upgraded {
+			return false, nil
+		}
+
+		log.Printf("[DEBUG] waiting for node pool(%s) of cluster(%s) to be upgraded to %s, present phase:%v", fn.Name, fn.ClusterName, targetVersion, *resp.Nodepool.Status.Phase)
+
+		return true, errors.Errorf("upgrade of node pool %s to %s did not complete in time, phase: %s", fn.Name, targetVersion, *resp.Nodepool.Status.Phase)
+	}
+
+	_, err = retryUntilDeadline(nodePoolUpgradedFn, deadline)
+
+	return err
+}
+
+// isNodePoolUpgraded reports whether the node pool is ready with the target version. When the upgrade was requested at
+// requestedAt, the Ready condition must also have transitioned since, so a node pool which has not started upgrading yet is not reported as done.
+func isNodePoolUpgraded(nodePool *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolNodepool, targetVersion string, requestedAt time.Time) (bool, error) {
+	if nodePool.Status == nil || nodePool.Status.Phase == nil {
+		return false, nil
+	}
+
+	switch *nodePool.Status.Phase {
+	case nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseUPGRADEFAILED, nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseERROR:
+		return false, errors.Errorf("upgrade to %s failed, phase: %s", targetVersion, *nodePool.Status.Phase)
+	case nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseREADY:
+	default:
+		return false, nil
+	}
+
+	if nodePool.Spec == nil || getNodePoolVersion(nodePool.Spec) != targetVersion {
+		return false, nil
+	}
+
+	if requestedAt.IsZero() {
+		return true, nil
+	}
+
+	ready, ok := nodePool.Status.Conditions[nodePoolReadyCondition]
+
+	return ok && time.Time(ready.LastTransitionTime).After(requestedAt), nil
+}
+
+func getNodePoolVersion(spec *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolSpec) string {
+	switch {
+	case spec.TkgVsphere != nil:
+		return spec.TkgVsphere.Version
+	case spec.TkgServiceVsphere != nil:
+		return spec.TkgServiceVsphere.Version
+	case spec.TkgAws != nil:
+		return spec.TkgAws.Version
+	}
+
+	return ""
+}
+
+func setNodePoolVersion(spec *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolSpec, version string) bool {
+	switch {
+	case spec.TkgVsphere != nil:
+		spec.TkgVsphere.Version = version
+	case spec.TkgServiceVsphere != nil:
+		spec.TkgServiceVsphere.Version = version
+	case spec.TkgAws != nil:
+		spec.TkgAws.Version = version
+	default:
+		return false
+	}
+
+	return true
+}
+
+// refreshUpgradeStatus recomputes the upgrade progress from the phases reported by the server, keeping the versions of the
+// latest upgrade from the state. For an imported cluster, or a cluster which was never upgraded, the current version is the target.
+func refreshUpgradeStatus(config authctx.TanzuContext, d *schema.ResourceData) error {
+	currentVersion := getStateDistributionVersion(d)
+	if currentVersion == "" {
+		return nil
+	}
+
+	status := map[string]interface{}{
+		previousVersionKey: "",
+		targetVersionKey:   currentVersion,
+	}
+
+	if data, _ := d.Get(upgradeStatusKey).([]interface{}); len(data) > 0 && data[0] != nil {
+		previous, _ := data[0].(map[string]interface{})
+		if previous[targetVersionKey] == currentVersion {
+			status[previousVersionKey] = previous[previousVersionKey]
+		}
+	}
+
+	clusterStatus, _ := d.Get(StatusKey).(map[string]interface{})
+	controlPlanePhase, _ := clusterStatus["phase"].(string)
+	status[controlPlanePhaseKey] = controlPlanePhase
+
+	fullName := constructFullname(d)
+
+	nodePools, err := config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceList(
+		&nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName{
+			ManagementClusterName: fullName.ManagementClusterName,
+			ProvisionerName:       fullName.ProvisionerName,
+			ClusterName:           fullName.Name,
+		},
+	)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "Unable to list Tanzu Mission Control cluster node pools, cluster name : %s", fullName.Name)
+	}
+
+	nodePoolPhases := make(map[string]interface{})
+
+	if nodePools != nil {
+		for _, nodePool := range nodePools.Nodepools {
+			if nodePool == nil || nodePool.FullName == nil || nodePool.Status == nil || nodePool.Status.Phase == nil {
+				continue
+			}
+
+			nodePoolPhases[nodePool.FullName.Name] = string(*nodePool.Status.Phase)
+		}
+	}
+
+	status[nodePoolPhasesKey] = nodePoolPhases
+	status[upgradeStateKey] = upgradeStateFromPhases(controlPlanePhase, nodePoolPhases)
+
+	return d.Set(upgradeStatusKey, []interface{}{status})
+}
+
+// upgradeStateFromPhases derives the state of the upgrade from the phases of the control plane and the node pools.
+func upgradeStateFromPhases(controlPlanePhase string, nodePoolPhases map[string]interface{}) string {
+	switch clustermodel.VmwareTanzuManageV1alpha1ClusterPhase(controlPlanePhase) {
+	case clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADEFAILED, clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseERROR:
+		return upgradeStateFailed
+	case clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADING:
+		return upgradeStateControlPlane
+	}
+
+	state := upgradeStateCompleted
+
+	for _, phase := range nodePoolPhases {
+		switch nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhase(fmt.Sprint(phase)) {
+		case nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseUPGRADEFAILED, nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseERROR:
+			return upgradeStateFailed
+		case nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseUPGRADING:
+			state = upgradeStateNodePools
+		}
+	}
+
+	return state
+}
+
+func getStateDistributionVersion(d *schema.ResourceData) string {
+	for _, key := range []string{tkgVsphereClusterKey, tkgServiceVsphereKey} {
+		if v, _ := d.Get(helper.GetFirstElementOf(SpecKey, key, distributionKey, versionKey)).(string); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// retryUntilDeadline shares a single upgrade timeout across the control plane and node pool waits.
+func retryUntilDeadline(f helper.Retryable, deadline time.Time) (int, error) {
+	remaining := time.Until(deadline)
+	if remaining < time.Second {
+		return 0, errors.New("timed out waiting for the cluster upgrade to complete")
+	}
+
+	return helper.RetryUntilTimeout(f, upgradePollInterval, remaining)
+}
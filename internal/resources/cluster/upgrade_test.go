@@ -0,0 +1,263 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	nodepoolmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/nodepool"
+	tkrmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetesrelease"
+)
+
+func TestValidateVersionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description    string
+		currentVersion string
+		targetVersion  string
+		expectError    bool
+	}{
+		{
+			description:    "unchanged version",
+			currentVersion: "v1.25.7+vmware.2-tkg.1",
+			targetVersion:  "v1.25.7+vmware.2-tkg.1",
+		},
+		{
+			description:    "patch upgrade",
+			currentVersion: "v1.25.7+vmware.2-tkg.1",
+			targetVersion:  "v1.25.9+vmware.1-tkg.1",
+		},
+		{
+			description:    "distribution only upgrade",
+			currentVersion: "v1.25.7+vmware.1-tkg.1",
+			targetVersion:  "v1.25.7+vmware.2-tkg.1",
+		},
+		{
+			description:    "next minor upgrade",
+			currentVersion: "v1.25.7+vmware.2-tkg.1",
+			targetVersion:  "v1.26.5+vmware.2-tkg.1",
+		},
+		{
+			description:    "skip-level minor upgrade",
+			currentVersion: "v1.24.10+vmware.1-tkg.1",
+			targetVersion:  "v1.26.5+vmware.2-tkg.1",
+			expectError:    true,
+		},
+		{
+			description:    "minor downgrade",
+			currentVersion: "v1.26.5+vmware.2-tkg.1",
+			targetVersion:  "v1.25.7+vmware.2-tkg.1",
+			expectError:    true,
+		},
+		{
+			description:    "patch downgrade",
+			currentVersion: "v1.26.5+vmware.2-tkg.1",
+			targetVersion:  "v1.26.4+vmware.1-tkg.1",
+			expectError:    true,
+		},
+		{
+			description:    "invalid target version",
+			currentVersion: "v1.26.5+vmware.2-tkg.1",
+			targetVersion:  "latest",
+			expectError:    true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateVersionUpgrade(test.currentVersion, test.targetVersion)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateVersionAvailable(t *testing.T) {
+	t.Parallel()
+
+	releases := []*tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseTanzuKubernetesRelease{
+		{
+			FullName: &tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName{Name: "v1.26.5---vmware.2-tkg.1"},
+			Spec:     &tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec{Version: "v1.26.5+vmware.2-tkg.1"},
+			Status:   &tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus{Compatible: true},
+		},
+		{
+			FullName: &tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseFullName{Name: "v1.27.2---vmware.1-tkg.1"},
+			Spec:     &tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseSpec{Version: "v1.27.2+vmware.1-tkg.1"},
+			Status:   &tkrmodels.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesreleaseStatus{Compatible: false},
+		},
+	}
+
+	cases := []struct {
+		description   string
+		targetVersion string
+		expectError   bool
+	}{
+		{
+			description:   "compatible release",
+			targetVersion: "v1.26.5+vmware.2-tkg.1",
+		},
+		{
+			description:   "incompatible release",
+			targetVersion: "v1.27.2+vmware.1-tkg.1",
+			expectError:   true,
+		},
+		{
+			description:   "unknown release",
+			targetVersion: "v1.26.8+vmware.1-tkg.1",
+			expectError:   true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateVersionAvailable(test.targetVersion, releases)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsNodePoolUpgraded(t *testing.T) {
+	t.Parallel()
+
+	targetVersion := "v1.26.5+vmware.2-tkg.1"
+	requestedAt := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	nodePool := func(phase nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhase, version string, readyAt time.Time) *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolNodepool {
+		return &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolNodepool{
+			Spec: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolSpec{
+				TkgVsphere: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolTKGVsphereNodepool{Version: version},
+			},
+			Status: &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatus{
+				Phase: &phase,
+				Conditions: map[string]nodepoolmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					nodePoolReadyCondition: {LastTransitionTime: strfmt.DateTime(readyAt)},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		description string
+		nodePool    *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolNodepool
+		requestedAt time.Time
+		expected    bool
+		expectError bool
+	}{
+		{
+			description: "ready node pool which has not started upgrading",
+			nodePool:    nodePool(nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseREADY, targetVersion, requestedAt.Add(-time.Hour)),
+			requestedAt: requestedAt,
+		},
+		{
+			description: "upgrading node pool",
+			nodePool:    nodePool(nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseUPGRADING, targetVersion, requestedAt.Add(time.Minute)),
+			requestedAt: requestedAt,
+		},
+		{
+			description: "ready node pool upgraded after the request",
+			nodePool:    nodePool(nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseREADY, targetVersion, requestedAt.Add(time.Minute)),
+			requestedAt: requestedAt,
+			expected:    true,
+		},
+		{
+			description: "ready node pool with another version",
+			nodePool:    nodePool(nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseREADY, "v1.25.7+vmware.2-tkg.1", requestedAt.Add(time.Minute)),
+			requestedAt: requestedAt,
+		},
+		{
+			description: "ready node pool already on the target version",
+			nodePool:    nodePool(nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseREADY, targetVersion, requestedAt.Add(-time.Hour)),
+			expected:    true,
+		},
+		{
+			description: "failed node pool upgrade",
+			nodePool:    nodePool(nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolStatusPhaseUPGRADEFAILED, targetVersion, requestedAt.Add(time.Minute)),
+			requestedAt: requestedAt,
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := isNodePoolUpgraded(test.nodePool, targetVersion, test.requestedAt)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestUpgradeStateFromPhases(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description       string
+		controlPlanePhase string
+		nodePoolPhases    map[string]interface{}
+		expected          string
+	}{
+		{
+			description:       "ready cluster",
+			controlPlanePhase: "READY",
+			nodePoolPhases:    map[string]interface{}{"np-1": "READY"},
+			expected:          upgradeStateCompleted,
+		},
+		{
+			description:       "control plane upgrading",
+			controlPlanePhase: "UPGRADING",
+			nodePoolPhases:    map[string]interface{}{"np-1": "READY"},
+			expected:          upgradeStateControlPlane,
+		},
+		{
+			description:       "node pool upgrading",
+			controlPlanePhase: "READY",
+			nodePoolPhases:    map[string]interface{}{"np-1": "READY", "np-2": "UPGRADING"},
+			expected:          upgradeStateNodePools,
+		},
+		{
+			description:       "control plane upgrade failed",
+			controlPlanePhase: "UPGRADE_FAILED",
+			expected:          upgradeStateFailed,
+		},
+		{
+			description:       "node pool upgrade failed",
+			controlPlanePhase: "READY",
+			nodePoolPhases:    map[string]interface{}{"np-1": "UPGRADING", "np-2": "UPGRADE_FAILED"},
+			expected:          upgradeStateFailed,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, upgradeStateFromPhases(test.controlPlanePhase, test.nodePoolPhases))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustergroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestFilterClusterGroups(t *testing.T) {
+	t.Parallel()
+
+	prodB := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{
+		FullName: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: "prod-b"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "prod"}},
+	}
+	prodA := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{
+		FullName: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: "prod-a"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "prod", "team": "payments"}},
+	}
+	dev := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{
+		FullName: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: "dev"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "dev"}},
+	}
+	noMeta := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{
+		FullName: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: "default"},
+	}
+	clusterGroups := []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{prodB, nil, dev, prodA, noMeta}
+
+	cases := []struct {
+		description string
+		selector    map[string]string
+		expected    []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup
+	}{
+		{
+			description: "no selector lists all the cluster groups sorted by name",
+			expected:    []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{noMeta, dev, prodA, prodB},
+		},
+		{
+			description: "filter by label",
+			selector:    map[string]string{"env": "prod"},
+			expected:    []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{prodA, prodB},
+		},
+		{
+			description: "filter by labels without match",
+			selector:    map[string]string{"env": "dev", "team": "payments"},
+			expected:    []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := filterClusterGroups(clusterGroups, test.selector)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenClusterGroups(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster group list",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with cluster group list",
+			input: []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup{
+				{
+					FullName: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: "prod-a"},
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+						UID:         "cg:01",
+						Description: "Production clusters",
+						Labels:      map[string]string{"env": "prod"},
+					},
+				},
+				nil,
+				{
+					FullName: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: "default"},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NameKey:        "prod-a",
+					uidKey:         "cg:01",
+					descriptionKey: "Production clusters",
+					labelsKey:      map[string]string{"env": "prod"},
+				},
+				map[string]interface{}{
+					NameKey: "default",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenClusterGroups(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
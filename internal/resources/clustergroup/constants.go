@@ -6,6 +6,14 @@ SPDX-License-Identifier: MPL-2.0
 package clustergroup
 
 const (
-	ResourceName = "tanzu-mission-control_cluster_group"
-	NameKey      = "name"
+	ResourceName       = "tanzu-mission-control_cluster_group"
+	ListDataSourceName = "tanzu-mission-control_cluster_groups"
+	NameKey            = "name"
+	queryKey           = "query"
+	labelsKey          = "labels"
+	clusterGroupsKey   = "cluster_groups"
+	namesKey           = "names"
+	totalCountKey      = "total_count"
+	uidKey             = "uid"
+	descriptionKey     = "description"
 )
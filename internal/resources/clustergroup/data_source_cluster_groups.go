@@ -0,0 +1,190 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// nolint: dupl
+package clustergroup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func DataSourceClusterGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterGroupsRead,
+		Schema:      clusterGroupsSchema,
+		Description: "Tanzu Mission Control Cluster Groups Data Source",
+	}
+}
+
+var clusterGroupsSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster groups to search for; supports globbing.",
+		Optional:    true,
+		Default:     "*",
+	},
+	queryKey: {
+		Type:        schema.TypeString,
+		Description: "TQL query to filter the cluster groups.",
+		Optional:    true,
+	},
+	labelsKey: {
+		Type:        schema.TypeMap,
+		Description: "Only list the cluster groups carrying all of these labels.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	clusterGroupsKey: {
+		Type:        schema.TypeList,
+		Description: "List of cluster groups matching the search criteria, sorted by name.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the cluster group",
+					Computed:    true,
+				},
+				uidKey: {
+					Type:        schema.TypeString,
+					Description: "UID of the cluster group",
+					Computed:    true,
+				},
+				descriptionKey: {
+					Type:        schema.TypeString,
+					Description: "Description of the cluster group",
+					Computed:    true,
+				},
+				labelsKey: {
+					Type:        schema.TypeMap,
+					Description: "Labels of the cluster group",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
+	namesKey: {
+		Type:        schema.TypeList,
+		Description: "Names of the cluster groups matching the search criteria, sorted by name.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	totalCountKey: {
+		Type:        schema.TypeInt,
+		Description: "Total count of cluster groups matching the search criteria.",
+		Computed:    true,
+	},
+}
+
+func dataSourceClusterGroupsRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	request := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest{
+		SearchScope: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupSearchScope{},
+	}
+
+	request.SearchScope.Name, _ = d.Get(NameKey).(string)
+	request.Query, _ = d.Get(queryKey).(string)
+	labels, _ := d.Get(labelsKey).(map[string]interface{})
+	selector := common.GetTypeStringMapData(labels)
+
+	clusterGroups, err := ListClusterGroups(config, request)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to list Tanzu Mission Control cluster groups, name : %s", request.SearchScope.Name))
+	}
+
+	clusterGroups = filterClusterGroups(clusterGroups, selector)
+
+	if err := d.Set(clusterGroupsKey, flattenClusterGroups(clusterGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(namesKey, clusterGroupNames(clusterGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(totalCountKey, len(clusterGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idKeys := []string{request.SearchScope.Name, request.Query, helper.LabelSelectorString(selector)}
+	d.SetId(fmt.Sprintf("cluster_groups/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+// filterClusterGroups returns the cluster groups carrying all the labels of the selector, sorted by name.
+func filterClusterGroups(clusterGroups []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup, selector map[string]string) []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup {
+	filtered := make([]*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup, 0, len(clusterGroups))
+
+	for _, clusterGroup := range clusterGroups {
+		if clusterGroup == nil || clusterGroup.FullName == nil {
+			continue
+		}
+
+		var labels map[string]string
+
+		if clusterGroup.Meta != nil {
+			labels = clusterGroup.Meta.Labels
+		}
+
+		if helper.MatchLabels(labels, selector) {
+			filtered = append(filtered, clusterGroup)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].FullName.Name < filtered[j].FullName.Name
+	})
+
+	return filtered
+}
+
+func clusterGroupNames(clusterGroups []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup) []string {
+	names := make([]string, 0, len(clusterGroups))
+
+	for _, clusterGroup := range clusterGroups {
+		names = append(names, clusterGroup.FullName.Name)
+	}
+
+	return names
+}
+
+func flattenClusterGroups(clusterGroups []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup) (data []interface{}) {
+	for _, clusterGroup := range clusterGroups {
+		if clusterGroup == nil {
+			continue
+		}
+
+		flattenData := make(map[string]interface{})
+
+		if clusterGroup.FullName != nil {
+			flattenData[NameKey] = clusterGroup.FullName.Name
+		}
+
+		if clusterGroup.Meta != nil {
+			flattenData[uidKey] = clusterGroup.Meta.UID
+			flattenData[descriptionKey] = clusterGroup.Meta.Description
+			flattenData[labelsKey] = clusterGroup.Meta.Labels
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data
+}
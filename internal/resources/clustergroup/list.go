@@ -0,0 +1,49 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// nolint: dupl
+package clustergroup
+
+import (
+	"strconv"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// ListClusterGroups returns all the cluster groups matching the search scope and query of the request, across all pages.
+func ListClusterGroups(config authctx.TanzuContext, request *clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest) ([]*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup, error) {
+	clusterGroups := make([]*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup, 0)
+
+	pageRequest := *request
+	pageRequest.SortBy = "fullName.name"
+	pageRequest.IncludeTotalCount = true
+
+	err := helper.ListAllPages(helper.DefaultPageSize, func(offset, size int) (int, int, error) {
+		pageRequest.Pagination = &optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{
+			Offset: strconv.Itoa(offset),
+			Size:   strconv.Itoa(size),
+		}
+
+		resp, err := config.TMCConnection.ClusterGroupResourceService.ManageV1alpha1ClusterGroupResourceServiceList(&pageRequest)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return 0, 0, nil
+			}
+
+			return 0, 0, err
+		}
+
+		clusterGroups = append(clusterGroups, resp.ClusterGroups...)
+		totalCount, _ := strconv.Atoi(resp.TotalCount)
+
+		return len(resp.ClusterGroups), totalCount, nil
+	})
+
+	return clusterGroups, err
+}
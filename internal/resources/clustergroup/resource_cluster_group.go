@@ -60,12 +60,14 @@ func resourceClusterGroupInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(errors.Wrapf(err, "Unable to get tanzu cluster group entry, name : %s", clusterGroupName))
 	}
 
+	if err := common.CheckResourceVersion(d, getResp.ClusterGroup.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+	}
+
 	if updateRequired {
 		meta := common.ConstructMeta(d)
 
-		if value, ok := getResp.ClusterGroup.Meta.Labels[common.CreatorLabelKey]; ok {
-			meta.Labels[common.CreatorLabelKey] = value
-		}
+		meta.Labels = common.WithSystemManagedKeys(meta.Labels, getResp.ClusterGroup.Meta.Labels)
 
 		getResp.ClusterGroup.Meta.Labels = meta.Labels
 		getResp.ClusterGroup.Meta.Description = meta.Description
@@ -0,0 +1,325 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustergroupmembership
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	aksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	eksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/ekscluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	tanzukubernetesclustermodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// clusterMembership is the cluster type agnostic view of a cluster, exposing only the
+// cluster group name and the meta data of the underlying cluster object.
+type clusterMembership struct {
+	meta             *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	clusterGroupName *string
+	update           func() error
+}
+
+// requiredAttributes lists the cluster identifiers which must be set for each cluster type.
+var requiredAttributes = map[string][]string{
+	classicClusterType: {},
+	eksClusterType:     {credentialNameKey, regionKey},
+	aksClusterType:     {credentialNameKey, subscriptionIDKey, resourceGroupKey},
+	tkcClusterType:     {managementClusterNameKey, provisionerNameKey},
+}
+
+// allowedAttributes lists the cluster identifiers which may be set for each cluster type.
+var allowedAttributes = map[string][]string{
+	classicClusterType: {managementClusterNameKey, provisionerNameKey},
+	eksClusterType:     {credentialNameKey, regionKey},
+	aksClusterType:     {credentialNameKey, subscriptionIDKey, resourceGroupKey},
+	tkcClusterType:     {managementClusterNameKey, provisionerNameKey},
+}
+
+var identifierKeys = []string{managementClusterNameKey, provisionerNameKey, credentialNameKey, regionKey, subscriptionIDKey, resourceGroupKey}
+
+func validateClusterTypeAttributes(clusterType string, attributes map[string]string) error {
+	required, ok := requiredAttributes[clusterType]
+	if !ok {
+		return errors.Errorf("cluster type %q is not supported, must be one of: %v", clusterType, clusterTypes)
+	}
+
+	for _, key := range identifierKeys {
+		if attributes[key] != "" && !slices.Contains(allowedAttributes[clusterType], key) {
+			return errors.Errorf("%s is not supported for cluster type %q", key, clusterType)
+		}
+	}
+
+	for _, key := range required {
+		if attributes[key] == "" {
+			return errors.Errorf("%s is required for cluster type %q", key, clusterType)
+		}
+	}
+
+	return nil
+}
+
+// parseImportID returns the attributes of a membership from an import ID made of the cluster type,
+// the cluster identifiers in the order of allowedAttributes and the cluster name, separated by /.
+// Attached classic clusters may be imported with the cluster type and name only.
+func parseImportID(id string) (map[string]string, error) {
+	parts := strings.Split(id, "/")
+	clusterType := parts[0]
+
+	keys, ok := allowedAttributes[clusterType]
+	if !ok {
+		return nil, errors.Errorf("cluster type %q is not supported, must be one of: %v", clusterType, clusterTypes)
+	}
+
+	identifiers := len(parts) - 2
+	if identifiers < 0 || parts[len(parts)-1] == "" || (identifiers != len(keys) && (clusterType != classicClusterType || identifiers != 0)) {
+		return nil, errors.Errorf("import ID of %s clusters must be comprised of %s, %s and %s - separated by /",
+			clusterType, clusterTypeKey, strings.Join(keys, ", "), nameKey)
+	}
+
+	attributes := map[string]string{
+		clusterTypeKey: clusterType,
+		nameKey:        parts[len(parts)-1],
+	}
+
+	for i, value := range parts[1 : len(parts)-1] {
+		attributes[keys[i]] = value
+	}
+
+	return attributes, validateClusterTypeAttributes(clusterType, attributes)
+}
+
+// checkOwner returns an error when the cluster group membership is owned by another configuration,
+// or when the cluster was created by a cluster resource which manages the cluster group in its spec.
+// Only clusters carrying the managed-by label are detected: the clusters created by an earlier provider version
+// get it on their next meta data update, and are not detected until then.
+func checkOwner(meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, owner string) error {
+	if meta == nil {
+		return nil
+	}
+
+	if managedBy := meta.Labels[common.ManagedByLabelKey]; managedBy != "" {
+		return errors.Errorf("cluster group membership is managed by the %s resource which created the cluster, set the cluster group in its spec instead", managedBy)
+	}
+
+	if current := meta.Labels[ownerLabelKey]; current != "" && current != owner {
+		return errors.Errorf("cluster group membership is already managed by another configuration, owner: %s", current)
+	}
+
+	return nil
+}
+
+func setOwner(meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, owner string) {
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+
+	if owner == "" {
+		delete(meta.Labels, ownerLabelKey)
+	} else {
+		meta.Labels[ownerLabelKey] = owner
+	}
+}
+
+func getClusterMembership(config authctx.TanzuContext, d *schema.ResourceData) (*clusterMembership, error) {
+	name, _ := d.Get(nameKey).(string)
+	clusterType, _ := d.Get(clusterTypeKey).(string)
+	managementClusterName, _ := d.Get(managementClusterNameKey).(string)
+	provisionerName, _ := d.Get(provisionerNameKey).(string)
+	credentialName, _ := d.Get(credentialNameKey).(string)
+
+	switch clusterType {
+	case classicClusterType:
+		if managementClusterName == "" {
+			managementClusterName = attachedValue
+		}
+
+		if provisionerName == "" {
+			provisionerName = attachedValue
+		}
+
+		return getClassicClusterMembership(config, &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+			ManagementClusterName: managementClusterName,
+			ProvisionerName:       provisionerName,
+			Name:                  name,
+		})
+	case eksClusterType:
+		region, _ := d.Get(regionKey).(string)
+
+		return getEKSClusterMembership(config, &eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName{
+			CredentialName: credentialName,
+			Region:         region,
+			Name:           name,
+		})
+	case aksClusterType:
+		subscriptionID, _ := d.Get(subscriptionIDKey).(string)
+		resourceGroup, _ := d.Get(resourceGroupKey).(string)
+
+		return getAKSClusterMembership(config, &aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName{
+			CredentialName:    credentialName,
+			SubscriptionID:    subscriptionID,
+			ResourceGroupName: resourceGroup,
+			Name:              name,
+		})
+	case tkcClusterType:
+		return getTKCClusterMembership(config, &tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName{
+			ManagementClusterName: managementClusterName,
+			ProvisionerName:       provisionerName,
+			Name:                  name,
+		})
+	}
+
+	return nil, errors.Errorf("cluster type %q is not supported, must be one of: %v", clusterType, clusterTypes)
+}
+
+// clusterNotFound is returned when the get response holds no cluster, so that it is handled as a deleted cluster.
+func clusterNotFound(name string) error {
+	return clienterrors.ErrorWithHTTPCode(http.StatusNotFound, errors.Errorf("cluster %s not found", name))
+}
+
+func getClassicClusterMembership(config authctx.TanzuContext, fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) (*clusterMembership, error) {
+	resp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.Cluster == nil {
+		return nil, clusterNotFound(fn.Name)
+	}
+
+	cluster := resp.Cluster
+	if cluster.Meta == nil {
+		cluster.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if cluster.Spec == nil {
+		cluster.Spec = &clustermodel.VmwareTanzuManageV1alpha1ClusterSpec{}
+	}
+
+	return &clusterMembership{
+		meta:             cluster.Meta,
+		clusterGroupName: &cluster.Spec.ClusterGroupName,
+		update: func() error {
+			_, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceUpdate(
+				&clustermodel.VmwareTanzuManageV1alpha1ClusterRequest{
+					Cluster: cluster,
+				},
+			)
+
+			return err
+		},
+	}, nil
+}
+
+func getEKSClusterMembership(config authctx.TanzuContext, fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*clusterMembership, error) {
+	resp, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.EksCluster == nil {
+		return nil, clusterNotFound(fn.Name)
+	}
+
+	cluster := resp.EksCluster
+	if cluster.Meta == nil {
+		cluster.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if cluster.Spec == nil {
+		cluster.Spec = &eksmodel.VmwareTanzuManageV1alpha1EksclusterSpec{}
+	}
+
+	return &clusterMembership{
+		meta:             cluster.Meta,
+		clusterGroupName: &cluster.Spec.ClusterGroupName,
+		update: func() error {
+			_, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceUpdate(
+				&eksmodel.VmwareTanzuManageV1alpha1EksclusterCreateUpdateEksClusterRequest{
+					EksCluster: cluster,
+				},
+			)
+
+			return err
+		},
+	}, nil
+}
+
+func getAKSClusterMembership(config authctx.TanzuContext, fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName) (*clusterMembership, error) {
+	resp, err := config.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceGet(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.AksCluster == nil {
+		return nil, clusterNotFound(fn.Name)
+	}
+
+	cluster := resp.AksCluster
+	if cluster.Meta == nil {
+		cluster.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if cluster.Spec == nil {
+		cluster.Spec = &aksmodel.VmwareTanzuManageV1alpha1AksclusterSpec{}
+	}
+
+	return &clusterMembership{
+		meta:             cluster.Meta,
+		clusterGroupName: &cluster.Spec.ClusterGroupName,
+		update: func() error {
+			_, err := config.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceUpdate(
+				&aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterRequest{
+					AksCluster: cluster,
+				},
+			)
+
+			return err
+		},
+	}, nil
+}
+
+func getTKCClusterMembership(config authctx.TanzuContext, fn *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName) (*clusterMembership, error) {
+	resp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGet(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.TanzuKubernetesCluster == nil {
+		return nil, clusterNotFound(fn.Name)
+	}
+
+	cluster := resp.TanzuKubernetesCluster
+	if cluster.Meta == nil {
+		cluster.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if cluster.Spec == nil {
+		cluster.Spec = &tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterSpec{}
+	}
+
+	return &clusterMembership{
+		meta:             cluster.Meta,
+		clusterGroupName: &cluster.Spec.ClusterGroupName,
+		update: func() error {
+			_, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceUpdate(
+				&tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterData{
+					TanzuKubernetesCluster: cluster,
+				},
+			)
+
+			return err
+		},
+	}, nil
+}
@@ -0,0 +1,232 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustergroupmembership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func TestValidateClusterTypeAttributes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		clusterType string
+		attributes  map[string]string
+		expectedErr string
+	}{
+		{
+			description: "classic cluster defaults to attached",
+			clusterType: classicClusterType,
+			attributes:  map[string]string{},
+		},
+		{
+			description: "classic cluster with management cluster and provisioner",
+			clusterType: classicClusterType,
+			attributes:  map[string]string{managementClusterNameKey: "mgmt", provisionerNameKey: "prov"},
+		},
+		{
+			description: "classic cluster with region",
+			clusterType: classicClusterType,
+			attributes:  map[string]string{regionKey: "us-west-2"},
+			expectedErr: `region is not supported for cluster type "classic"`,
+		},
+		{
+			description: "eks cluster",
+			clusterType: eksClusterType,
+			attributes:  map[string]string{credentialNameKey: "cred", regionKey: "us-west-2"},
+		},
+		{
+			description: "eks cluster without region",
+			clusterType: eksClusterType,
+			attributes:  map[string]string{credentialNameKey: "cred"},
+			expectedErr: `region is required for cluster type "eks"`,
+		},
+		{
+			description: "aks cluster",
+			clusterType: aksClusterType,
+			attributes:  map[string]string{credentialNameKey: "cred", subscriptionIDKey: "sub", resourceGroupKey: "rg"},
+		},
+		{
+			description: "aks cluster with management cluster",
+			clusterType: aksClusterType,
+			attributes:  map[string]string{credentialNameKey: "cred", subscriptionIDKey: "sub", resourceGroupKey: "rg", managementClusterNameKey: "mgmt"},
+			expectedErr: `management_cluster_name is not supported for cluster type "aks"`,
+		},
+		{
+			description: "tkc cluster without provisioner",
+			clusterType: tkcClusterType,
+			attributes:  map[string]string{managementClusterNameKey: "mgmt"},
+			expectedErr: `provisioner_name is required for cluster type "tkc"`,
+		},
+		{
+			description: "unknown cluster type",
+			clusterType: "gke",
+			attributes:  map[string]string{},
+			expectedErr: `cluster type "gke" is not supported, must be one of: [classic eks aks tkc]`,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateClusterTypeAttributes(test.clusterType, test.attributes)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		id          string
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			description: "attached classic cluster",
+			id:          "classic/cluster-1",
+			expected:    map[string]string{clusterTypeKey: classicClusterType, nameKey: "cluster-1"},
+		},
+		{
+			description: "provisioned classic cluster",
+			id:          "classic/mgmt/prov/cluster-1",
+			expected:    map[string]string{clusterTypeKey: classicClusterType, managementClusterNameKey: "mgmt", provisionerNameKey: "prov", nameKey: "cluster-1"},
+		},
+		{
+			description: "aks cluster",
+			id:          "aks/cred/sub/rg/cluster-1",
+			expected:    map[string]string{clusterTypeKey: aksClusterType, credentialNameKey: "cred", subscriptionIDKey: "sub", resourceGroupKey: "rg", nameKey: "cluster-1"},
+		},
+		{
+			description: "eks cluster without region",
+			id:          "eks/cred/cluster-1",
+			expectedErr: "import ID of eks clusters must be comprised of cluster_type, credential_name, region and name - separated by /",
+		},
+		{
+			description: "tkc cluster with empty provisioner",
+			id:          "tkc/mgmt//cluster-1",
+			expectedErr: `provisioner_name is required for cluster type "tkc"`,
+		},
+		{
+			description: "cluster type only",
+			id:          "classic",
+			expectedErr: "import ID of classic clusters must be comprised of cluster_type, management_cluster_name, provisioner_name and name - separated by /",
+		},
+		{
+			description: "unknown cluster type",
+			id:          "gke/cluster-1",
+			expectedErr: `cluster type "gke" is not supported, must be one of: [classic eks aks tkc]`,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseImportID(test.id)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, actual)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCheckOwner(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		meta        *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		owner       string
+		expectedErr string
+	}{
+		{
+			description: "nil meta",
+			owner:       "owner-a",
+		},
+		{
+			description: "cluster without owner label",
+			meta:        &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"team": "a"}},
+			owner:       "owner-a",
+		},
+		{
+			description: "cluster owned by the same configuration",
+			meta:        &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{ownerLabelKey: "owner-a"}},
+			owner:       "owner-a",
+		},
+		{
+			description: "cluster owned by another configuration",
+			meta:        &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{ownerLabelKey: "owner-b"}},
+			owner:       "owner-a",
+			expectedErr: "cluster group membership is already managed by another configuration, owner: owner-b",
+		},
+		{
+			description: "new membership for a cluster owned by another configuration",
+			meta:        &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{ownerLabelKey: "owner-b"}},
+			expectedErr: "cluster group membership is already managed by another configuration, owner: owner-b",
+		},
+		{
+			description: "cluster created by a cluster resource",
+			meta:        &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{common.ManagedByLabelKey: "tanzu-mission-control_ekscluster"}},
+			expectedErr: "cluster group membership is managed by the tanzu-mission-control_ekscluster resource which created the cluster, set the cluster group in its spec instead",
+		},
+		{
+			description: "cluster created by a cluster resource and owned by the same configuration",
+			meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{
+				common.ManagedByLabelKey: "tanzu-mission-control_cluster",
+				ownerLabelKey:            "owner-a",
+			}},
+			owner:       "owner-a",
+			expectedErr: "cluster group membership is managed by the tanzu-mission-control_cluster resource which created the cluster, set the cluster group in its spec instead",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkOwner(test.meta, test.owner)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestSetOwner(t *testing.T) {
+	t.Parallel()
+
+	meta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+
+	setOwner(meta, "owner-a")
+	require.Equal(t, map[string]string{ownerLabelKey: "owner-a"}, meta.Labels)
+
+	meta.Labels["team"] = "a"
+
+	setOwner(meta, "")
+	require.Equal(t, map[string]string{"team": "a"}, meta.Labels)
+}
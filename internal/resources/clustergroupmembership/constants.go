@@ -0,0 +1,37 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustergroupmembership
+
+const (
+	ResourceName = "tanzu-mission-control_cluster_group_membership"
+
+	nameKey                  = "name"
+	clusterTypeKey           = "cluster_type"
+	managementClusterNameKey = "management_cluster_name"
+	provisionerNameKey       = "provisioner_name"
+	credentialNameKey        = "credential_name" //nolint:gosec
+	regionKey                = "region"
+	subscriptionIDKey        = "subscription_id"
+	resourceGroupKey         = "resource_group"
+	clusterGroupKey          = "cluster_group"
+	ownerKey                 = "owner"
+
+	attachedValue = "attached"
+
+	// ownerLabelKey marks the cluster as having its cluster group membership managed by a
+	// tanzu-mission-control_cluster_group_membership resource, the value is the owning resource ID.
+	ownerLabelKey = "terraform.tanzu-mission-control/cluster-group-membership"
+)
+
+// Cluster types.
+const (
+	classicClusterType = "classic"
+	eksClusterType     = "eks"
+	aksClusterType     = "aks"
+	tkcClusterType     = "tkc"
+)
+
+var clusterTypes = []string{classicClusterType, eksClusterType, aksClusterType, tkcClusterType}
@@ -0,0 +1,271 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustergroupmembership
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+)
+
+func ResourceClusterGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterGroupMembershipCreate,
+		ReadContext:   resourceClusterGroupMembershipRead,
+		UpdateContext: resourceClusterGroupMembershipUpdate,
+		DeleteContext: resourceClusterGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterGroupMembershipImporter,
+		},
+		Schema:        clusterGroupMembershipSchema,
+		CustomizeDiff: validateClusterGroupMembership,
+	}
+}
+
+var clusterGroupMembershipSchema = map[string]*schema.Schema{
+	nameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	clusterTypeKey: {
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Type of the cluster, one of: %v. Defaults to %s, which covers attached and Tanzu Kubernetes Grid clusters managed through the cluster API.", clusterTypes, classicClusterType),
+		Optional:     true,
+		ForceNew:     true,
+		Default:      classicClusterType,
+		ValidateFunc: validation.StringInSlice(clusterTypes, false),
+	},
+	managementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster, used by classic and tkc clusters. Defaults to attached for classic clusters.",
+		Optional:    true,
+		ForceNew:    true,
+	},
+	provisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Provisioner of the cluster, used by classic and tkc clusters. Defaults to attached for classic clusters.",
+		Optional:    true,
+		ForceNew:    true,
+	},
+	credentialNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the credential, used by eks and aks clusters.",
+		Optional:    true,
+		ForceNew:    true,
+	},
+	regionKey: {
+		Type:        schema.TypeString,
+		Description: "AWS region of the cluster, used by eks clusters.",
+		Optional:    true,
+		ForceNew:    true,
+	},
+	subscriptionIDKey: {
+		Type:        schema.TypeString,
+		Description: "Azure subscription ID of the cluster, used by aks clusters.",
+		Optional:    true,
+		ForceNew:    true,
+	},
+	resourceGroupKey: {
+		Type:        schema.TypeString,
+		Description: "Azure resource group of the cluster, used by aks clusters.",
+		Optional:    true,
+		ForceNew:    true,
+	},
+	clusterGroupKey: {
+		Type:         schema.TypeString,
+		Description:  "Name of the cluster group the cluster must be a member of.",
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+}
+
+func validateClusterGroupMembership(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	clusterType, _ := d.Get(clusterTypeKey).(string)
+	attributes := make(map[string]string, len(identifierKeys))
+
+	for _, key := range identifierKeys {
+		attributes[key], _ = d.Get(key).(string)
+	}
+
+	return validateClusterTypeAttributes(clusterType, attributes)
+}
+
+func resourceClusterGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	clusterName, _ := d.Get(nameKey).(string)
+	clusterGroupName, _ := d.Get(clusterGroupKey).(string)
+
+	membership, err := getClusterMembership(config, d)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", clusterName))
+	}
+
+	if err := checkOwner(membership.meta, ""); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to manage Tanzu Mission Control cluster group membership, name : %s", clusterName))
+	}
+
+	owner := resource.UniqueId()
+
+	*membership.clusterGroupName = clusterGroupName
+	setOwner(membership.meta, owner)
+
+	if err := membership.update(); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group membership, name : %s", clusterName))
+	}
+
+	log.Printf("[INFO] cluster %s moved to cluster group %s", clusterName, clusterGroupName)
+
+	d.SetId(owner)
+
+	return resourceClusterGroupMembershipRead(ctx, d, m)
+}
+
+func resourceClusterGroupMembershipRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	clusterName, _ := d.Get(nameKey).(string)
+
+	membership, err := getClusterMembership(config, d)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", clusterName))
+	}
+
+	if err := checkOwner(membership.meta, d.Id()); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Cluster group membership conflict",
+			Detail:   fmt.Sprintf("Cluster %s: %s. Changes to the cluster group will be rejected until the other configuration releases the cluster.", clusterName, err),
+		})
+	} else if membership.meta.Labels[ownerLabelKey] == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Cluster group membership owner label removed",
+			Detail:   fmt.Sprintf("Cluster %s no longer carries the %s label, another configuration may be managing the cluster meta data.", clusterName, ownerLabelKey),
+		})
+	}
+
+	if err := d.Set(clusterGroupKey, *membership.clusterGroupName); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceClusterGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	clusterName, _ := d.Get(nameKey).(string)
+	clusterGroupName, _ := d.Get(clusterGroupKey).(string)
+
+	membership, err := getClusterMembership(config, d)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", clusterName))
+	}
+
+	if err := checkOwner(membership.meta, d.Id()); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to manage Tanzu Mission Control cluster group membership, name : %s", clusterName))
+	}
+
+	*membership.clusterGroupName = clusterGroupName
+	setOwner(membership.meta, d.Id())
+
+	if err := membership.update(); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group membership, name : %s", clusterName))
+	}
+
+	log.Printf("[INFO] cluster %s moved to cluster group %s", clusterName, clusterGroupName)
+
+	return resourceClusterGroupMembershipRead(ctx, d, m)
+}
+
+// resourceClusterGroupMembershipDelete releases the ownership of the cluster, the cluster stays in its current cluster group.
+func resourceClusterGroupMembershipDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	clusterName, _ := d.Get(nameKey).(string)
+
+	membership, err := getClusterMembership(config, d)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", clusterName))
+	}
+
+	if membership.meta.Labels[ownerLabelKey] == d.Id() {
+		setOwner(membership.meta, "")
+
+		if err := membership.update(); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Unable to release Tanzu Mission Control cluster group membership, name : %s", clusterName))
+		}
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+// resourceClusterGroupMembershipImporter takes the ownership of the cluster group membership, keeping the owner
+// recorded on the cluster when there is one so that a membership removed from the state can be imported back.
+func resourceClusterGroupMembershipImporter(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	config := m.(authctx.TanzuContext)
+
+	attributes, err := parseImportID(d.Id())
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to import Tanzu Mission Control cluster group membership, id : %s", d.Id())
+	}
+
+	for key, value := range attributes {
+		if err := d.Set(key, value); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set %s of the cluster group membership", key)
+		}
+	}
+
+	clusterName := attributes[nameKey]
+
+	membership, err := getClusterMembership(config, d)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", clusterName)
+	}
+
+	owner := membership.meta.Labels[ownerLabelKey]
+
+	if err := checkOwner(membership.meta, owner); err != nil {
+		return nil, errors.Wrapf(err, "Unable to import Tanzu Mission Control cluster group membership, name : %s", clusterName)
+	}
+
+	if owner == "" {
+		owner = resource.UniqueId()
+		setOwner(membership.meta, owner)
+
+		if err := membership.update(); err != nil {
+			return nil, errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group membership, name : %s", clusterName)
+		}
+	}
+
+	d.SetId(owner)
+
+	if err := d.Set(clusterGroupKey, *membership.clusterGroupName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set cluster group of the cluster %s", clusterName)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -7,7 +7,10 @@ package common
 
 import (
 	"testing"
+	"time"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
@@ -40,6 +43,10 @@ func TestFlattenMeta(t *testing.T) {
 					LabelsKey:          map[string]string{"test": "test"},
 					DescriptionKey:     "description of resource",
 					resourceVersionKey: "",
+					creationTimeKey:    "",
+					updateTimeKey:      "",
+					generationKey:      "",
+					parentRefsKey:      []interface{}{},
 					uidKey:             "abc",
 				},
 			},
@@ -58,6 +65,10 @@ func TestFlattenMeta(t *testing.T) {
 					LabelsKey:          map[string]string{},
 					DescriptionKey:     "description of resource",
 					resourceVersionKey: "",
+					creationTimeKey:    "",
+					updateTimeKey:      "",
+					generationKey:      "",
+					parentRefsKey:      []interface{}{},
 					uidKey:             "",
 				},
 			},
@@ -76,10 +87,45 @@ func TestFlattenMeta(t *testing.T) {
 					LabelsKey:          map[string]string{"test": "test"},
 					DescriptionKey:     "",
 					resourceVersionKey: "",
+					creationTimeKey:    "",
+					updateTimeKey:      "",
+					generationKey:      "",
+					parentRefsKey:      []interface{}{},
 					uidKey:             "123",
 				},
 			},
 		},
+		{
+			name: "normal scenario with generated fields of meta data",
+			input: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				Annotations:     map[string]string{},
+				Labels:          map[string]string{},
+				UID:             "123",
+				ResourceVersion: "4",
+				Generation:      "2",
+				CreationTime:    strfmt.DateTime(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)),
+				UpdateTime:      strfmt.DateTime(time.Date(2023, 5, 2, 10, 0, 0, 0, time.UTC)),
+				ParentReferences: []*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference{
+					{Rid: "rid:cg:org:test", UID: "cg-uid"},
+					nil,
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					AnnotationsKey:     map[string]string{},
+					LabelsKey:          map[string]string{},
+					DescriptionKey:     "",
+					resourceVersionKey: "4",
+					creationTimeKey:    "2023-05-01T10:00:00Z",
+					updateTimeKey:      "2023-05-02T10:00:00Z",
+					generationKey:      "2",
+					parentRefsKey: []interface{}{
+						map[string]interface{}{ridKey: "rid:cg:org:test", uidKey: "cg-uid"},
+					},
+					uidKey: "123",
+				},
+			},
+		},
 	}
 
 	for _, each := range cases {
@@ -90,3 +136,137 @@ func TestFlattenMeta(t *testing.T) {
 		})
 	}
 }
+
+func TestIsSystemManagedKey(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		key      string
+		expected bool
+	}{
+		{
+			name:     "user label",
+			key:      "team",
+			expected: false,
+		},
+		{
+			name:     "tanzu mission control label",
+			key:      "tmc.cloud.vmware.com/creator",
+			expected: true,
+		},
+		{
+			name:     "customer domain annotation",
+			key:      "x-customer-domain",
+			expected: true,
+		},
+		{
+			name:     "generated template annotation",
+			key:      "GeneratedTemplateID",
+			expected: true,
+		},
+		{
+			name:     "provider label",
+			key:      "terraform.tanzu-mission-control/cluster-group-membership",
+			expected: true,
+		},
+		{
+			name:     "attribute path of a system managed label",
+			key:      "meta.0.labels.tmc.cloud.vmware.com/creator",
+			expected: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, IsSystemManagedKey(test.key))
+		})
+	}
+}
+
+func TestWithoutSystemManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	actual := withoutSystemManagedKeys(map[string]interface{}{
+		"team":                         "a",
+		"tmc.cloud.vmware.com/creator": "admin",
+		"x-customer-domain":            "example.com",
+	})
+
+	require.Equal(t, map[string]interface{}{"team": "a"}, actual)
+	require.Equal(t, map[string]interface{}{}, withoutSystemManagedKeys(nil))
+}
+
+func TestWithSystemManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]string{
+		"team":            "a",
+		CreatorLabelKey:   "admin",
+		ManagedByLabelKey: "tanzu-mission-control_cluster",
+		"terraform.tanzu-mission-control/cluster-group-membership": "owner-a",
+	}
+
+	require.Equal(t, map[string]string{
+		"team":            "b",
+		CreatorLabelKey:   "admin",
+		ManagedByLabelKey: "tanzu-mission-control_cluster",
+		"terraform.tanzu-mission-control/cluster-group-membership": "owner-a",
+	}, WithSystemManagedKeys(map[string]string{"team": "b"}, existing))
+	require.Equal(t, map[string]string{}, WithSystemManagedKeys(nil, nil))
+}
+
+func TestCheckResourceVersion(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		stateVersion string
+		input        *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		expectedErr  string
+	}{
+		{
+			name:         "nil meta data",
+			stateVersion: "1",
+			input:        nil,
+		},
+		{
+			name:  "no resource version in the state",
+			input: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{ResourceVersion: "2"},
+		},
+		{
+			name:         "matching resource version",
+			stateVersion: "2",
+			input:        &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{ResourceVersion: "2"},
+		},
+		{
+			name:         "resource modified outside of terraform",
+			stateVersion: "1",
+			input:        &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{ResourceVersion: "2"},
+			expectedErr:  "resource was modified outside of Terraform, resource version 2 does not match 1 from the state: refresh the state and apply again",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{MetaKey: Meta}, map[string]interface{}{
+				MetaKey: []interface{}{
+					map[string]interface{}{resourceVersionKey: test.stateVersion},
+				},
+			})
+
+			err := CheckResourceVersion(d, test.input)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
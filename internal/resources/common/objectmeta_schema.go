@@ -7,9 +7,12 @@ package common
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper/converter"
@@ -23,9 +26,21 @@ const (
 	AnnotationsKey     = "annotations"
 	uidKey             = "uid"
 	resourceVersionKey = "resource_version"
+	creationTimeKey    = "creation_time"
+	updateTimeKey      = "update_time"
+	generationKey      = "generation"
+	parentRefsKey      = "parent_references"
+	ridKey             = "rid"
 	CreatorLabelKey    = "tmc.cloud.vmware.com/creator"
+
+	// ManagedByLabelKey marks the clusters created by the cluster resources of the provider, the value is the resource type.
+	ManagedByLabelKey = "terraform.tanzu-mission-control/managed-by"
 )
 
+// systemManagedKeyMarkers identify labels and annotations which are added and managed by Tanzu Mission Control,
+// or by the provider itself under the terraform.tanzu-mission-control prefix.
+var systemManagedKeyMarkers = []string{"tmc.cloud.vmware.com", "x-customer-domain", "GeneratedTemplateID", "terraform.tanzu-mission-control/"}
+
 var Meta = &schema.Schema{
 	Type:        schema.TypeList,
 	Description: "Metadata for the resource",
@@ -35,28 +50,19 @@ var Meta = &schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			AnnotationsKey: {
-				Type:        schema.TypeMap,
-				Description: "Annotations for the resource",
-				Optional:    true,
-				Computed:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if strings.Contains(k, "tmc.cloud.vmware.com") ||
-						strings.Contains(k, "x-customer-domain") ||
-						strings.Contains(k, "GeneratedTemplateID") {
-						return true
-					}
-					return false
-				},
+				Type:             schema.TypeMap,
+				Description:      "Annotations for the resource",
+				Optional:         true,
+				Computed:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressSystemManagedKeys,
 			},
 			LabelsKey: {
-				Type:        schema.TypeMap,
-				Description: "Labels for the resource",
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return strings.Contains(k, "tmc.cloud.vmware.com")
-				},
+				Type:             schema.TypeMap,
+				Description:      "Labels for the resource",
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressSystemManagedKeys,
 			},
 			DescriptionKey: {
 				Type:        schema.TypeString,
@@ -73,10 +79,99 @@ var Meta = &schema.Schema{
 				Description: "Resource version of the resource",
 				Computed:    true,
 			},
+			creationTimeKey: {
+				Type:        schema.TypeString,
+				Description: "Creation time of the resource",
+				Computed:    true,
+			},
+			updateTimeKey: {
+				Type:        schema.TypeString,
+				Description: "Update time of the resource",
+				Computed:    true,
+			},
+			generationKey: {
+				Type:        schema.TypeString,
+				Description: "Generation of the resource, increments on changes",
+				Computed:    true,
+			},
+			parentRefsKey: {
+				Type:        schema.TypeList,
+				Description: "References to the parents of the resource",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ridKey: {
+							Type:        schema.TypeString,
+							Description: "RID of the parent",
+							Computed:    true,
+						},
+						uidKey: {
+							Type:        schema.TypeString,
+							Description: "UID of the parent",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	},
 }
 
+// IsSystemManagedKey reports whether a label or annotation key is managed by Tanzu Mission Control or by the provider.
+func IsSystemManagedKey(key string) bool {
+	for _, marker := range systemManagedKeyMarkers {
+		if strings.Contains(key, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// suppressSystemManagedKeys ignores labels and annotations added by Tanzu Mission Control,
+// including the change they cause in the number of map elements.
+func suppressSystemManagedKeys(k, _, _ string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		oldValue, newValue := d.GetChange(strings.TrimSuffix(k, ".%"))
+
+		return reflect.DeepEqual(withoutSystemManagedKeys(oldValue), withoutSystemManagedKeys(newValue))
+	}
+
+	return IsSystemManagedKey(k)
+}
+
+func withoutSystemManagedKeys(value interface{}) map[string]interface{} {
+	filtered := make(map[string]interface{})
+
+	data, _ := value.(map[string]interface{})
+
+	for key, v := range data {
+		if !IsSystemManagedKey(key) {
+			filtered[key] = v
+		}
+	}
+
+	return filtered
+}
+
+// WithSystemManagedKeys returns the labels or annotations built from the configuration together with
+// the system managed keys of the existing object, which an update must not remove.
+func WithSystemManagedKeys(values, existing map[string]string) map[string]string {
+	merged := make(map[string]string, len(values))
+
+	for key, value := range values {
+		merged[key] = value
+	}
+
+	for key, value := range existing {
+		if IsSystemManagedKey(key) {
+			merged[key] = value
+		}
+	}
+
+	return merged
+}
+
 func HasMetaChanged(d *schema.ResourceData) bool {
 	updateRequired := false
 
@@ -90,6 +185,27 @@ func HasMetaChanged(d *schema.ResourceData) bool {
 	return updateRequired
 }
 
+// CheckResourceVersion implements optimistic concurrency for updates, it returns an error when the object
+// was modified outside of Terraform since the resource version in the state was read.
+func CheckResourceVersion(d *schema.ResourceData, objectMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) error {
+	expected, _ := d.Get(helper.GetFirstElementOf(MetaKey, resourceVersionKey)).(string)
+
+	return MatchResourceVersion(expected, objectMeta)
+}
+
+// MatchResourceVersion is CheckResourceVersion for resources which record the resource version of several objects outside of their meta data.
+func MatchResourceVersion(expected string, objectMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) error {
+	if objectMeta == nil {
+		return nil
+	}
+
+	if expected == "" || objectMeta.ResourceVersion == "" || expected == objectMeta.ResourceVersion {
+		return nil
+	}
+
+	return errors.Errorf("resource was modified outside of Terraform, resource version %s does not match %s from the state: refresh the state and apply again", objectMeta.ResourceVersion, expected)
+}
+
 func ConstructMeta(d *schema.ResourceData) (objectMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) {
 	objectMeta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
 		Annotations: make(map[string]string),
@@ -144,10 +260,39 @@ func FlattenMeta(objectMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta)
 	flattenMetaData[DescriptionKey] = objectMeta.Description
 	flattenMetaData[uidKey] = objectMeta.UID
 	flattenMetaData[resourceVersionKey] = objectMeta.ResourceVersion
+	flattenMetaData[creationTimeKey] = flattenDateTime(time.Time(objectMeta.CreationTime))
+	flattenMetaData[updateTimeKey] = flattenDateTime(time.Time(objectMeta.UpdateTime))
+	flattenMetaData[generationKey] = objectMeta.Generation
+	flattenMetaData[parentRefsKey] = flattenParentReferences(objectMeta.ParentReferences)
 
 	return []interface{}{flattenMetaData}
 }
 
+func flattenDateTime(value time.Time) string {
+	if value.IsZero() || value.Unix() == 0 {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
+
+func flattenParentReferences(references []*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference) []interface{} {
+	data := make([]interface{}, 0, len(references))
+
+	for _, reference := range references {
+		if reference == nil {
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			ridKey: reference.Rid,
+			uidKey: reference.UID,
+		})
+	}
+
+	return data
+}
+
 func GetTypeStringMapData(data map[string]interface{}) map[string]string {
 	convertedMapData := make(map[string]string)
 
@@ -182,7 +327,43 @@ func GetMetaConverterMap(modelPathSeparator string) *converter.BlockToStruct {
 		DescriptionKey:     converter.BuildModelPath(modelPathSeparator, "meta", "description"),
 		resourceVersionKey: converter.BuildModelPath(modelPathSeparator, "meta", "resourceVersion"),
 		uidKey:             converter.BuildModelPath(modelPathSeparator, "meta", "uid"),
+		creationTimeKey:    readOnlyConverterField(converter.BuildModelPath(modelPathSeparator, "meta", "creationTime"), flattenDateTimeValue),
+		updateTimeKey:      readOnlyConverterField(converter.BuildModelPath(modelPathSeparator, "meta", "updateTime"), flattenDateTimeValue),
+		generationKey:      readOnlyConverterField(converter.BuildModelPath(modelPathSeparator, "meta", "generation"), nil),
+		parentRefsKey:      readOnlyConverterField(converter.BuildModelPath(modelPathSeparator, "meta", "parentReferences"), nil),
 	}
 
 	return MetaConverterMap
 }
+
+// readOnlyConverterField maps a server generated field, which is never sent back to the API.
+func readOnlyConverterField(field string, flatten func(interface{}) interface{}) *converter.EvaluatedField {
+	return &converter.EvaluatedField{
+		Field: field,
+		EvalFunc: func(mode converter.EvaluationMode, value interface{}) interface{} {
+			if mode == converter.ConstructModel {
+				return nil
+			}
+
+			if flatten != nil {
+				return flatten(value)
+			}
+
+			return value
+		},
+	}
+}
+
+func flattenDateTimeValue(value interface{}) interface{} {
+	dateTime, ok := value.(string)
+	if !ok {
+		return ""
+	}
+
+	parsed, err := time.Parse(time.RFC3339, dateTime)
+	if err != nil {
+		return ""
+	}
+
+	return flattenDateTime(parsed)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clustergroup"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/workspace"
 )
 
 const (
@@ -20,6 +21,7 @@ const (
 	AttachedValue            = "attached"
 	ClusterKey               = "cluster"
 	ClusterGroupKey          = "cluster_group"
+	WorkspaceKey             = "workspace"
 )
 
 // Scopes.
@@ -30,12 +32,21 @@ const (
 	WorkspaceScope
 )
 
+// workspaceFullname is the workspace full name schema with a description which is not specific to IAM policies.
+var workspaceFullname = func() *schema.Schema {
+	fullname := *workspace.WorkspaceFullname
+	fullname.Description = "The schema for workspace full name"
+
+	return &fullname
+}()
+
 func getSchemaForScope() func(string) *schema.Schema {
 	// Emulate a map with a closure, innerMap is captured in the closure returned below.
 	// Since the return value is always the same it gives the pseudo-constant output, which can be referred to in the same map-alike fashion.
 	innerMap := map[string]*schema.Schema{
 		ClusterKey:      cluster.ClusterFullname,
 		ClusterGroupKey: clustergroup.ClusterGroupFullname,
+		WorkspaceKey:    workspaceFullname,
 	}
 
 	return func(key string) *schema.Schema {
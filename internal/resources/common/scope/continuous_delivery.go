@@ -0,0 +1,74 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package commonscope
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/namespace"
+)
+
+// EnableWorkspaceContinuousDelivery enables continuous delivery on the clusters hosting the namespaces of the workspace.
+// Workspaces have no continuous delivery feature of their own, clusters joining the workspace later need it enabled separately.
+func EnableWorkspaceContinuousDelivery(config *authctx.TanzuContext, workspaceName string, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) error {
+	namespaces, err := namespace.ListNamespaces(*config, &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest{
+		SearchScope: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope{
+			WorkspaceName: workspaceName,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to list the namespaces of workspace %s", workspaceName)
+	}
+
+	for _, fullName := range workspaceClusters(namespaces) {
+		continuousDeliveryReq := &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryContinuousDeliveryRequest{
+			ContinuousDelivery: &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryContinuousDelivery{
+				FullName: fullName,
+				Meta:     meta,
+			},
+		}
+
+		_, err := config.TMCConnection.ClusterContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryResourceServiceCreate(continuousDeliveryReq)
+		if err != nil && status.Code(err) != codes.AlreadyExists {
+			return errors.Wrapf(err, "unable to enable continuous delivery on cluster %s of workspace %s", fullName.ClusterName, workspaceName)
+		}
+	}
+
+	return nil
+}
+
+// workspaceClusters returns the continuous delivery full name of each distinct cluster hosting the namespaces.
+func workspaceClusters(namespaces []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace) []*continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName {
+	clusters := make([]*continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName, 0)
+	found := make(map[continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName]bool)
+
+	for _, ns := range namespaces {
+		if ns == nil || ns.FullName == nil {
+			continue
+		}
+
+		fullName := continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
+			ClusterName:           ns.FullName.ClusterName,
+			ManagementClusterName: ns.FullName.ManagementClusterName,
+			ProvisionerName:       ns.FullName.ProvisionerName,
+		}
+
+		if found[fullName] {
+			continue
+		}
+
+		found[fullName] = true
+		clusters = append(clusters, &fullName)
+	}
+
+	return clusters
+}
@@ -0,0 +1,70 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package commonscope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+)
+
+func TestWorkspaceClusters(t *testing.T) {
+	t.Parallel()
+
+	namespaceOn := func(clusterName, name string) *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace {
+		return &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+			FullName: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+				ManagementClusterName: AttachedValue,
+				ProvisionerName:       AttachedValue,
+				ClusterName:           clusterName,
+				Name:                  name,
+			},
+		}
+	}
+
+	clusterFullName := func(clusterName string) *continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName {
+		return &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
+			ManagementClusterName: AttachedValue,
+			ProvisionerName:       AttachedValue,
+			ClusterName:           clusterName,
+		}
+	}
+
+	cases := []struct {
+		description string
+		namespaces  []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace
+		expected    []*continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName
+	}{
+		{
+			description: "workspace without namespaces",
+			namespaces:  nil,
+			expected:    []*continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{},
+		},
+		{
+			description: "namespaces on several clusters",
+			namespaces: []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				namespaceOn("cluster-1", "ns-1"),
+				namespaceOn("cluster-2", "ns-1"),
+				namespaceOn("cluster-1", "ns-2"),
+				nil,
+			},
+			expected: []*continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
+				clusterFullName("cluster-1"),
+				clusterFullName("cluster-2"),
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, workspaceClusters(test.namespaces))
+		})
+	}
+}
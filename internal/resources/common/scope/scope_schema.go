@@ -82,31 +82,43 @@ func ValidateScope(scopesAllowed []string) ValidateScopeType {
 			return fmt.Errorf("scope data: %v is not valid: minimum one valid scope block is required among: %v", data, strings.Join(scopesAllowed, `, `))
 		}
 
-		scopeData := data[0].(map[string]interface{})
-		scopesFound := make([]string, 0)
+		scopeData, _ := data[0].(map[string]interface{})
 
-		if clusterData, ok := scopeData[ClusterKey]; ok {
-			if clusterValue, ok := clusterData.([]interface{}); ok && len(clusterValue) != 0 {
-				scopesFound = append(scopesFound, ClusterKey)
-			}
-		}
+		return validateScopeData(scopeData, scopesAllowed)
+	}
+}
 
-		if clusterGroupData, ok := scopeData[ClusterGroupKey]; ok {
-			if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
-				scopesFound = append(scopesFound, ClusterGroupKey)
-			}
+// validateScopeData checks that exactly one scope block is set and that it is one of the allowed scopes.
+func validateScopeData(scopeData map[string]interface{}, scopesAllowed []string) error {
+	scopesFound := make([]string, 0)
+
+	if clusterData, ok := scopeData[ClusterKey]; ok {
+		if clusterValue, ok := clusterData.([]interface{}); ok && len(clusterValue) != 0 {
+			scopesFound = append(scopesFound, ClusterKey)
 		}
+	}
 
-		if len(scopesFound) == 0 {
-			return fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v", strings.Join(scopesAllowed, `, `))
-		} else if len(scopesFound) > 1 {
-			return fmt.Errorf("found scopes: %v are not valid: maximum one valid scope type block is allowed", strings.Join(scopesFound, `, `))
+	if clusterGroupData, ok := scopeData[ClusterGroupKey]; ok {
+		if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
+			scopesFound = append(scopesFound, ClusterGroupKey)
 		}
+	}
 
-		if !slices.Contains(scopesAllowed, scopesFound[0]) {
-			return fmt.Errorf("found scope: %v is not valid: minimum one valid scope type block is required among: %v", scopesFound[0], strings.Join(scopesAllowed, `, `))
+	if workspaceData, ok := scopeData[WorkspaceKey]; ok {
+		if workspaceValue, ok := workspaceData.([]interface{}); ok && len(workspaceValue) != 0 {
+			scopesFound = append(scopesFound, WorkspaceKey)
 		}
+	}
 
-		return nil
+	if len(scopesFound) == 0 {
+		return fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v", strings.Join(scopesAllowed, `, `))
+	} else if len(scopesFound) > 1 {
+		return fmt.Errorf("found scopes: %v are not valid: maximum one valid scope type block is allowed", strings.Join(scopesFound, `, `))
 	}
+
+	if !slices.Contains(scopesAllowed, scopesFound[0]) {
+		return fmt.Errorf("found scope: %v is not valid: minimum one valid scope type block is required among: %v", scopesFound[0], strings.Join(scopesAllowed, `, `))
+	}
+
+	return nil
 }
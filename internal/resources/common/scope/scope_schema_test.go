@@ -0,0 +1,63 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package commonscope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateScopeData(t *testing.T) {
+	t.Parallel()
+
+	scopesAllowed := []string{ClusterKey, ClusterGroupKey, WorkspaceKey}
+	workspaceScope := []interface{}{map[string]interface{}{NameKey: "ws-1"}}
+	clusterGroupScope := []interface{}{map[string]interface{}{NameKey: "cg-1"}}
+
+	cases := []struct {
+		description   string
+		scopeData     map[string]interface{}
+		scopesAllowed []string
+		expectedErr   string
+	}{
+		{
+			description:   "workspace scope",
+			scopeData:     map[string]interface{}{WorkspaceKey: workspaceScope, ClusterGroupKey: []interface{}{}},
+			scopesAllowed: scopesAllowed,
+		},
+		{
+			description:   "no scope block",
+			scopeData:     map[string]interface{}{WorkspaceKey: []interface{}{}},
+			scopesAllowed: scopesAllowed,
+			expectedErr:   "no valid scope type block found: minimum one valid scope type block is required among: cluster, cluster_group, workspace",
+		},
+		{
+			description:   "more than one scope block",
+			scopeData:     map[string]interface{}{WorkspaceKey: workspaceScope, ClusterGroupKey: clusterGroupScope},
+			scopesAllowed: scopesAllowed,
+			expectedErr:   "found scopes: cluster_group, workspace are not valid: maximum one valid scope type block is allowed",
+		},
+		{
+			description:   "workspace scope not allowed",
+			scopeData:     map[string]interface{}{WorkspaceKey: workspaceScope},
+			scopesAllowed: []string{ClusterKey, ClusterGroupKey},
+			expectedErr:   "found scope: workspace is not valid: minimum one valid scope type block is required among: cluster, cluster_group",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateScopeData(test.scopeData, test.scopesAllowed)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -145,7 +145,7 @@ func (shr *ScopeHelperResources) GetTestResourceHelperAndScope(scopeType Scope,
 		scopeBlock = fmt.Sprintf(`
 	scope {
 	  workspace {
-	    workspace = %s.name
+	    name = %s.name
 		}
 	}
 	`, shr.Workspace.ResourceName)
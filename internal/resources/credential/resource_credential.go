@@ -142,6 +142,7 @@ func resourceCredentialDelete(_ context.Context, d *schema.ResourceData, m inter
 	return diags
 }
 
+// resourceCredentialUpdate rejects every change, the credential is never updated in place so there is no resource version to check.
 func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return diag.FromErr(errors.New("update of Tanzu Mission Control credential is not supported"))
 }
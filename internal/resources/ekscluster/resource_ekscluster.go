@@ -586,11 +586,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	clusterFn := constructFullname(d)
 	clusterSpec, nps := constructEksClusterSpec(d)
+	clusterMeta := common.ConstructMeta(d)
+	clusterMeta.Labels[common.ManagedByLabelKey] = ResourceName
 
 	clusterReq := &eksmodel.VmwareTanzuManageV1alpha1EksclusterCreateUpdateEksClusterRequest{
 		EksCluster: &eksmodel.VmwareTanzuManageV1alpha1EksclusterEksCluster{
 			FullName: clusterFn,
-			Meta:     common.ConstructMeta(d),
+			Meta:     clusterMeta,
 			Spec:     clusterSpec,
 		},
 	}
@@ -672,6 +674,10 @@ func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
 	}
 
+	if err := common.CheckResourceVersion(d, getResp.EksCluster.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
+	}
+
 	opsRetryTimeout := getRetryTimeout(d)
 
 	clusterSpec, nodepools := constructEksClusterSpec(d)
@@ -738,6 +744,9 @@ func resourceClusterImporter(ctx context.Context, d *schema.ResourceData, m inte
 func handleClusterDiff(config authctx.TanzuContext, tmcCluster *eksmodel.VmwareTanzuManageV1alpha1EksclusterEksCluster, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, clusterSpec *eksmodel.VmwareTanzuManageV1alpha1EksclusterSpec) error {
 	updateCluster := false
 
+	meta.Labels[common.ManagedByLabelKey] = ResourceName
+	meta.Labels = common.WithSystemManagedKeys(meta.Labels, tmcCluster.Meta.Labels)
+
 	if meta.Description != tmcCluster.Meta.Description ||
 		!mapEqual(meta.Labels, tmcCluster.Meta.Labels) {
 		updateCluster = true
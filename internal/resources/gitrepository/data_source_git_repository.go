@@ -18,6 +18,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	gitrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/cluster"
 	gitrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/clustergroup"
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -32,6 +33,7 @@ type dataFromServer struct {
 	atomicSpec              *gitrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdGitrepositorySpec
 	clusterScopeStatus      *gitrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdGitrepositoryStatus
 	clusterGroupScopeStatus *gitrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdGitrepositoryStatus
+	workspaceScopeStatus    *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryStatus
 }
 
 func DataSourceGitRepository() *schema.Resource {
@@ -94,6 +96,12 @@ func dataSourceGitRepositoryRead(ctx context.Context, d *schema.ResourceData, m
 		}
 		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
 		flattenedStatus = status.FlattenStatusForClusterGroupScope(gitRepositoryDataFromServer.clusterGroupScopeStatus)
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec{
+			AtomicSpec: gitRepositoryDataFromServer.atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForWorkspaceScope(workspaceScopeSpec)
+		flattenedStatus = status.FlattenStatusForWorkspaceScope(gitRepositoryDataFromServer.workspaceScopeStatus)
 	}
 
 	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
@@ -147,6 +155,24 @@ func retrieveGitRepositoryDataFromServer(config authctx.TanzuContext, scopedFull
 			gitRepositoryDataFromServer.atomicSpec = resp.GitRepository.Spec.AtomicSpec
 			gitRepositoryDataFromServer.clusterGroupScopeStatus = resp.GitRepository.Status
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			resp, err := config.TMCConnection.WorkspaceGitRepositoryResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceGet(scopedFullnameData.FullnameWorkspace)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return gitRepositoryDataFromServer, err
+				}
+
+				return gitRepositoryDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace git repository entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+			}
+
+			scopedFullnameData.FullnameWorkspace = resp.GitRepository.FullName
+			gitRepositoryDataFromServer.UID = resp.GitRepository.Meta.UID
+			gitRepositoryDataFromServer.meta = resp.GitRepository.Meta
+			gitRepositoryDataFromServer.atomicSpec = resp.GitRepository.Spec.AtomicSpec
+			gitRepositoryDataFromServer.workspaceScopeStatus = resp.GitRepository.Status
+		}
 	case commonscope.UnknownScope:
 		return gitRepositoryDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	gitrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/cluster"
 	gitrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/clustergroup"
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -34,7 +35,7 @@ func ResourceGitRepository() *schema.Resource {
 		ReadContext:   dataSourceGitRepositoryRead,
 		UpdateContext: resourceGitRepositoryInPlaceUpdate,
 		DeleteContext: resourceGitRepositoryDelete,
-		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey, commonscope.WorkspaceKey})),
 	}
 }
 
@@ -134,6 +135,23 @@ func resourceGitRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
 			}
 
+			UID = gitRepositoryResponse.GitRepository.Meta.UID
+		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			gitRepositoryReq := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest{
+				GitRepository: &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepository{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForWorkspaceScope(d),
+				},
+			}
+
+			gitRepositoryResponse, err := config.TMCConnection.WorkspaceGitRepositoryResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceCreate(gitRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace git repository entry, name : %s", gitRepositoryName))
+			}
+
 			UID = gitRepositoryResponse.GitRepository.Meta.UID
 		}
 	case commonscope.UnknownScope:
@@ -180,6 +198,13 @@ func resourceGitRepositoryDelete(ctx context.Context, d *schema.ResourceData, m
 				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			err := config.TMCConnection.WorkspaceGitRepositoryResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceDelete(scopedFullnameData.FullnameWorkspace)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace git repository entry, name : %s", gitRepositoryName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -215,6 +240,10 @@ func resourceGitRepositoryInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, gitRepositoryDataFromServer.meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var updateAvailable bool
 
 	if updateCheckForMeta(d, gitRepositoryDataFromServer.meta) {
@@ -263,6 +292,23 @@ func resourceGitRepositoryInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			gitRepositoryReq := &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepositoryRequest{
+				GitRepository: &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryGitRepository{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     gitRepositoryDataFromServer.meta,
+					Spec: &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec{
+						AtomicSpec: gitRepositoryDataFromServer.atomicSpec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.WorkspaceGitRepositoryResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdGitrepositoryResourceServiceUpdate(gitRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace git repository entry, name : %s", gitRepositoryName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -279,9 +325,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
@@ -304,6 +348,9 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *gitrepositorycluster
 	case commonscope.ClusterGroupScope:
 		clusterGroupScopeSpec := spec.ConstructSpecForClusterGroupScope(d)
 		gitRepositorySpec = clusterGroupScopeSpec.AtomicSpec
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := spec.ConstructSpecForWorkspaceScope(d)
+		gitRepositorySpec = workspaceScopeSpec.AtomicSpec
 	}
 
 	atomicSpec.GitImplementation = gitRepositorySpec.GitImplementation
@@ -12,6 +12,7 @@ import (
 
 	gitrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/cluster"
 	gitrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/clustergroup"
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
@@ -80,6 +81,28 @@ func TestFlattenScope(t *testing.T) {
 			expectedName:      "n",
 			expectedNamespace: "nn",
 		},
+		{
+			description: "normal scenario with complete workspace scope",
+			input: &ScopedFullname{
+				Scope: commonscope.WorkspaceScope,
+				FullnameWorkspace: &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName{
+					Name:          "n",
+					NamespaceName: "nn",
+					WorkspaceName: "c",
+				},
+			},
+			expectedData: []interface{}{
+				map[string]interface{}{
+					commonscope.WorkspaceKey: []interface{}{
+						map[string]interface{}{
+							commonscope.NameKey: "c",
+						},
+					},
+				},
+			},
+			expectedName:      "n",
+			expectedNamespace: "nn",
+		},
 	}
 
 	for _, each := range cases {
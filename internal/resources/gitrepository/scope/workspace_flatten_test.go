@@ -0,0 +1,50 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func TestFlattenWorkspaceGitRepositoryFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil workspace git repository full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace git repository full name",
+			input: &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryFullName{
+				WorkspaceName: "c",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					commonscope.NameKey: "c",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenWorkspaceGitRepositoryFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
+)
+
+func ConstructSpecForWorkspaceScope(d *schema.ResourceData) (spec *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	spec = &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec{}
+	spec.AtomicSpec = ConstructSpecForClusterScope(d)
+
+	return spec
+}
+
+func FlattenSpecForWorkspaceScope(spec *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositorySpec) (data []interface{}) {
+	if spec == nil || spec.AtomicSpec == nil {
+		return data
+	}
+
+	return FlattenSpecForClusterScope(spec.AtomicSpec)
+}
@@ -13,6 +13,7 @@ import (
 
 	gitrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/cluster"
 	gitrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/clustergroup"
+	gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
 	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 )
 
@@ -94,3 +95,41 @@ func TestFlattenStatusForClusterGroupScope(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenStatusForWorkspaceScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryStatus
+		expected    interface{}
+	}{
+		{
+			description: "check for nil workspace git repository status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace git repository status",
+			input: &gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryStatus{
+				Phase: statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer(),
+				Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{
+					Overridden:       0,
+					Applied:          3,
+					AvailableTargets: 3,
+				},
+			},
+			expected: map[string]interface{}{
+				phaseKey: fmt.Sprint(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenStatusForWorkspaceScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+import gitrepositoryworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/workspace"
+
+func FlattenStatusForWorkspaceScope(status *gitrepositoryworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdGitrepositoryStatus) (data interface{}) {
+	if status == nil {
+		return data
+	}
+
+	if status.Phase == nil {
+		return data
+	}
+
+	flattenStatusData := make(map[string]interface{})
+
+	flattenStatusData[phaseKey] = string(*status.Phase)
+
+	return flattenStatusData
+}
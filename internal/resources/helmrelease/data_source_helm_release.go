@@ -18,6 +18,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
 	releaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	releaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -32,6 +33,7 @@ type dataFromServer struct {
 	atomicSpec              *releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec
 	clusterScopeStatus      *releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus
 	clusterGroupScopeStatus *releaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseStatus
+	workspaceScopeStatus    *releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseStatus
 }
 
 func DataSourceHelmRelease() *schema.Resource {
@@ -94,6 +96,12 @@ func dataSourceHelmReleaseRead(ctx context.Context, d *schema.ResourceData, m in
 		}
 		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
 		flattenedStatus = status.FlattenStatusForClusterGroupScope(helmReleaseDataFromServer.clusterGroupScopeStatus)
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec{
+			AtomicSpec: helmReleaseDataFromServer.atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForWorkspaceScope(workspaceScopeSpec)
+		flattenedStatus = status.FlattenStatusForWorkspaceScope(helmReleaseDataFromServer.workspaceScopeStatus)
 	}
 
 	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
@@ -147,6 +155,24 @@ func retrieveHelmReleaseDataFromServer(config authctx.TanzuContext, scopedFullna
 			helmReleaseDataFromServer.atomicSpec = resp.Release.Spec.AtomicSpec
 			helmReleaseDataFromServer.clusterGroupScopeStatus = resp.Release.Status
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			resp, err := config.TMCConnection.WorkspaceHelmReleaseResourceService.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceGet(scopedFullnameData.FullnameWorkspace)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return helmReleaseDataFromServer, err
+				}
+
+				return helmReleaseDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace helm release entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+			}
+
+			scopedFullnameData.FullnameWorkspace = resp.Release.FullName
+			helmReleaseDataFromServer.UID = resp.Release.Meta.UID
+			helmReleaseDataFromServer.meta = resp.Release.Meta
+			helmReleaseDataFromServer.atomicSpec = resp.Release.Spec.AtomicSpec
+			helmReleaseDataFromServer.workspaceScopeStatus = resp.Release.Status
+		}
 	case commonscope.UnknownScope:
 		return helmReleaseDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -21,6 +21,7 @@ import (
 	helmclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
 	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
 	releaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	releaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -46,7 +47,7 @@ func ResourceHelmRelease() *schema.Resource {
 		ReadContext:   dataSourceHelmReleaseRead,
 		UpdateContext: resourceHelmReleaseInPlaceUpdate,
 		DeleteContext: resourceHelmReleaseDelete,
-		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey, commonscope.WorkspaceKey})),
 	}
 }
 
@@ -163,6 +164,29 @@ func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m in
 				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group helm release entry, name : %s", helmReleaseName))
 			}
 
+			UID = helmReleaseResponse.Release.Meta.UID
+		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			specVal, err := spec.ConstructSpecForWorkspaceScope(d)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			helmReleaseReq := &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest{
+				Release: &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     meta,
+					Spec:     specVal,
+				},
+			}
+
+			helmReleaseResponse, err := config.TMCConnection.WorkspaceHelmReleaseResourceService.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceCreate(helmReleaseReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace helm release entry, name : %s", helmReleaseName))
+			}
+
 			UID = helmReleaseResponse.Release.Meta.UID
 		}
 	case commonscope.UnknownScope:
@@ -209,6 +233,13 @@ func resourceHelmReleaseDelete(ctx context.Context, d *schema.ResourceData, m in
 				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group helm release entry, name : %s", helmReleaseName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			err := config.TMCConnection.WorkspaceHelmReleaseResourceService.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceDelete(scopedFullnameData.FullnameWorkspace)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace helm release entry, name : %s", helmReleaseName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -250,6 +281,10 @@ func resourceHelmReleaseInPlaceUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, helmReleaseDataFromServer.meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var updateAvailable bool
 
 	if updateCheckForMeta(d, helmReleaseDataFromServer.meta) {
@@ -304,6 +339,23 @@ func resourceHelmReleaseInPlaceUpdate(ctx context.Context, d *schema.ResourceDat
 				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group helm release entry, name : %s", helmReleaseName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			helmReleaseReq := &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRequest{
+				Release: &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseRelease{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     helmReleaseDataFromServer.meta,
+					Spec: &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec{
+						AtomicSpec: helmReleaseDataFromServer.atomicSpec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.WorkspaceHelmReleaseResourceService.VmwareTanzuManageV1alpha1WorkspaceReleaseResourceServiceUpdate(helmReleaseReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace helm release entry, name : %s", helmReleaseName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -320,9 +372,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
@@ -355,6 +405,14 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *releaseclustermodel.
 
 		clusterGroupScopeSpec := specVal
 		helmreleaseSpec = clusterGroupScopeSpec.AtomicSpec
+	case commonscope.WorkspaceScope:
+		specVal, err := spec.ConstructSpecForWorkspaceScope(d)
+		if err != nil {
+			return false, err
+		}
+
+		workspaceScopeSpec := specVal
+		helmreleaseSpec = workspaceScopeSpec.AtomicSpec
 	}
 
 	atomicSpec.InlineConfiguration = helmreleaseSpec.InlineConfiguration
@@ -415,6 +473,8 @@ func checkHelmFeature(config authctx.TanzuContext, scopedFullnameData *scope.Sco
 		if resp.Helms[0].Status.Phase == nil {
 			return errors.Errorf("Tanzu mission control helm feature is disable on cluster group, name: %s", scopedFullnameData.FullnameCluster.ClusterName)
 		}
+	case commonscope.WorkspaceScope:
+		// Workspaces have no helm feature of their own, it must be enabled on the clusters.
 	}
 
 	return nil
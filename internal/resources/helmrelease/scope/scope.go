@@ -14,6 +14,7 @@ import (
 
 	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
 	releaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	releaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
@@ -22,10 +23,11 @@ type ScopedFullname struct {
 	Scope                commonscope.Scope
 	FullnameCluster      *releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName
 	FullnameClusterGroup *releaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName
+	FullnameWorkspace    *releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName
 }
 
 var (
-	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey, commonscope.WorkspaceKey}
 	ScopeSchema   = commonscope.GetScopeSchema(
 		commonscope.WithDescription(fmt.Sprintf("Scope for the Helm release, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
 		commonscope.WithScopes(ScopesAllowed[:]))
@@ -64,6 +66,15 @@ func ConstructScope(d *schema.ResourceData, name, namespace string) (scopedFulln
 		}
 	}
 
+	if workspaceData, ok := scopeData[commonscope.WorkspaceKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.WorkspaceKey) {
+		if workspaceValue, ok := workspaceData.([]interface{}); ok && len(workspaceValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:             commonscope.WorkspaceScope,
+				FullnameWorkspace: ConstructWorkspaceReleaseFullname(workspaceValue, name, namespace),
+			}
+		}
+	}
+
 	return scopedFullnameData
 }
 
@@ -87,6 +98,12 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name, nam
 			namespace = scopedFullname.FullnameClusterGroup.NamespaceName
 			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupReleaseFullname(scopedFullname.FullnameClusterGroup)
 		}
+	case commonscope.WorkspaceScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.WorkspaceKey) {
+			name = scopedFullname.FullnameWorkspace.Name
+			namespace = scopedFullname.FullnameWorkspace.NamespaceName
+			flattenScopeData[commonscope.WorkspaceKey] = FlattenWorkspaceReleaseFullname(scopedFullname.FullnameWorkspace)
+		}
 	case commonscope.UnknownScope:
 		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
 	}
@@ -12,6 +12,7 @@ import (
 
 	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
 	releaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	releaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
@@ -80,6 +81,28 @@ func TestFlattenScope(t *testing.T) {
 			expectedName:      "n",
 			expectedNamespace: "nn",
 		},
+		{
+			description: "normal scenario with complete workspace scope",
+			input: &ScopedFullname{
+				Scope: commonscope.WorkspaceScope,
+				FullnameWorkspace: &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName{
+					Name:          "n",
+					NamespaceName: "nn",
+					WorkspaceName: "c",
+				},
+			},
+			expectedData: []interface{}{
+				map[string]interface{}{
+					commonscope.WorkspaceKey: []interface{}{
+						map[string]interface{}{
+							commonscope.NameKey: "c",
+						},
+					},
+				},
+			},
+			expectedName:      "n",
+			expectedNamespace: "nn",
+		},
 	}
 
 	for _, each := range cases {
@@ -0,0 +1,50 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	releaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func TestFlattenWorkspaceHelmReleaseFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil workspace helm release full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace helm release full name",
+			input: &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseFullName{
+				WorkspaceName: "c",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					commonscope.NameKey: "c",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenWorkspaceReleaseFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
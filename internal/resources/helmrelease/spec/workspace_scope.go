@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	releaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
+)
+
+func ConstructSpecForWorkspaceScope(d *schema.ResourceData) (spec *releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec, err error) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec, nil
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec, nil
+	}
+
+	spec = &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec{}
+	spec.AtomicSpec, err = ConstructSpecForClusterScope(d)
+
+	return spec, err
+}
+
+func FlattenSpecForWorkspaceScope(spec *releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseSpec) (data []interface{}) {
+	if spec == nil || spec.AtomicSpec == nil {
+		return data
+	}
+
+	return FlattenSpecForClusterScope(spec.AtomicSpec)
+}
@@ -13,6 +13,7 @@ import (
 
 	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
 	releaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	releaseworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/workspace"
 	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 )
 
@@ -110,3 +111,43 @@ func TestFlattenStatusForClusterGroupScope(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenStatusForWorkspaceScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseStatus
+		expected    interface{}
+	}{
+		{
+			description: "check for nil workspace helm release status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace helm release status",
+			input: &releaseworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdHelmReleaseStatus{
+				Phase: statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer(),
+				Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{
+					Overridden:       0,
+					Applied:          3,
+					AvailableTargets: 3,
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					phaseKey: fmt.Sprint(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenStatusForWorkspaceScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
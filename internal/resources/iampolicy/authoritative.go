@@ -0,0 +1,259 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+var authoritativeSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Description: "When set to true, the resource owns the complete list of role bindings for the scope and removes any binding not declared in the configuration. Only one authoritative IAM policy resource is allowed per scope. Defaults to false, where role bindings are added to and removed from the scope without touching other bindings.",
+	Optional:    true,
+	Default:     false,
+	ForceNew:    true,
+}
+
+func isAuthoritative(d *schema.ResourceData) bool {
+	value, _ := d.Get(authoritativeKey).(bool)
+
+	return value
+}
+
+// isOwnedByAuthoritativeResource checks if the scope policy is already managed by an authoritative IAM policy resource.
+func isOwnedByAuthoritativeResource(policy *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy) bool {
+	if policy == nil || policy.Meta == nil {
+		return false
+	}
+
+	_, ok := policy.Meta.Labels[authoritativeLabelKey]
+
+	return ok
+}
+
+// constructAuthoritativeMeta copies the server meta of the policy, marking or un-marking it as owned by an authoritative resource.
+// Resource version is kept as is so that concurrent updates on the same scope are rejected by the server.
+func constructAuthoritativeMeta(meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, owned bool) *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta {
+	newMeta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		Labels: make(map[string]string),
+	}
+
+	if meta != nil {
+		copied := *meta
+		newMeta = &copied
+		newMeta.Labels = make(map[string]string)
+
+		for k, v := range meta.Labels {
+			newMeta.Labels[k] = v
+		}
+	}
+
+	if owned {
+		newMeta.Labels[authoritativeLabelKey] = "true"
+	} else {
+		delete(newMeta.Labels, authoritativeLabelKey)
+	}
+
+	return newMeta
+}
+
+// mergeRoleBindingLists returns all the role bindings on TMC server, ordered as in the terraform state.
+// Role bindings and subjects missing from the state are appended at the end, so that they show up as drift.
+func mergeRoleBindingLists(state, server []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding) []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding {
+	serverSubjects := make(map[string][]*iammodel.VmwareTanzuCoreV1alpha1PolicySubject)
+	serverRoles := make([]string, 0)
+
+	for _, rb := range server {
+		if _, ok := serverSubjects[rb.Role]; !ok {
+			serverRoles = append(serverRoles, rb.Role)
+		}
+
+		serverSubjects[rb.Role] = append(serverSubjects[rb.Role], rb.Subjects...)
+	}
+
+	merged := make([]*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding, 0)
+	visited := make(map[string]bool)
+
+	for _, stateRB := range state {
+		subjects, ok := serverSubjects[stateRB.Role]
+		if !ok || visited[stateRB.Role] {
+			continue
+		}
+
+		visited[stateRB.Role] = true
+
+		merged = append(merged, &iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+			Role:     stateRB.Role,
+			Subjects: append(getIntersectionOfSubs(stateRB.Subjects, subjects), getDifferenceOfSubs(subjects, stateRB.Subjects)...),
+		})
+	}
+
+	for _, role := range serverRoles {
+		if visited[role] {
+			continue
+		}
+
+		merged = append(merged, &iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+			Role:     role,
+			Subjects: serverSubjects[role],
+		})
+	}
+
+	return merged
+}
+
+func getDifferenceOfSubs(
+	server, state []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject,
+) []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject {
+	var newList []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject
+
+	for _, sub := range server {
+		found := false
+
+		for _, each := range state {
+			if sub.Name == each.Name && *sub.Kind == *each.Kind {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			newList = append(newList, sub)
+		}
+	}
+
+	return newList
+}
+
+func resourceAuthoritativeIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullname := constructScope(d)
+	if scopedFullname == nil {
+		return diag.Errorf("unable to create authoritative IAM policy; Scope full name is empty")
+	}
+
+	policy, err := getScopeIAMPolicy(config, scopedFullname)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to create authoritative IAM policy"))
+	}
+
+	if isOwnedByAuthoritativeResource(policy) {
+		return diag.Errorf("unable to create authoritative IAM policy; the scope is already managed by another authoritative IAM policy resource")
+	}
+
+	policy, err = updateScopeIAMPolicy(config, scopedFullname, &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+		Meta:         constructAuthoritativeMeta(policyMeta(policy), true),
+		RoleBindings: constructRoleBindingList(d),
+	})
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to create authoritative IAM policy"))
+	}
+
+	if meta := policyMeta(policy); meta != nil {
+		d.SetId(meta.UID)
+	}
+
+	return append(
+		diags,
+		resourceIAMPolicyRead(context.WithValue(ctx, contextMethodKey{}, createKey), d, m)...,
+	)
+}
+
+func resourceAuthoritativeIAMPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullname := constructScope(d)
+	if scopedFullname == nil {
+		return diag.Errorf("unable to update authoritative IAM policy; Scope full name is empty")
+	}
+
+	policy, err := getScopeIAMPolicy(config, scopedFullname)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to update authoritative IAM policy"))
+	}
+
+	if err := common.CheckResourceVersion(d, policyMeta(policy)); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to update authoritative IAM policy"))
+	}
+
+	policy, err = updateScopeIAMPolicy(config, scopedFullname, &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+		Meta:         constructAuthoritativeMeta(policyMeta(policy), true),
+		RoleBindings: constructRoleBindingList(d),
+	})
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to update authoritative IAM policy"))
+	}
+
+	if meta := policyMeta(policy); meta != nil {
+		d.SetId(meta.UID)
+	}
+
+	log.Printf("[INFO] authoritative IAM policy update successful")
+
+	return append(
+		diags,
+		resourceIAMPolicyRead(context.WithValue(ctx, contextMethodKey{}, updateKey), d, m)...,
+	)
+}
+
+func resourceAuthoritativeIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullname := constructScope(d)
+	if scopedFullname == nil {
+		return diag.Errorf("unable to delete authoritative IAM policy; Scope full name is empty")
+	}
+
+	policy, err := getScopeIAMPolicy(config, scopedFullname)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "unable to delete authoritative IAM policy"))
+	}
+
+	roleBindings := make([]*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding, 0)
+
+	// Clearing the role bindings of the organization would lock every user out of it,
+	// so they are left in place and the scope is only released.
+	if scopedFullname.scope == organizationScope && policy != nil {
+		roleBindings = policy.RoleBindings
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Role bindings of the organization were kept",
+			Detail:   "Destroying an authoritative IAM policy of the organization scope does not remove its role bindings, the organization is only released from the resource.",
+		})
+	}
+
+	_, err = updateScopeIAMPolicy(config, scopedFullname, &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+		Meta:         constructAuthoritativeMeta(policyMeta(policy), false),
+		RoleBindings: roleBindings,
+	})
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return diag.FromErr(errors.Wrapf(err, "unable to delete authoritative IAM policy"))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
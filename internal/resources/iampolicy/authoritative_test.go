@@ -0,0 +1,189 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestMergeRoleBindingLists(t *testing.T) {
+	t.Parallel()
+
+	group := iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()
+	user := iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindUSER.Pointer()
+
+	cases := []struct {
+		description string
+		state       []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding
+		server      []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding
+		expected    []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding
+	}{
+		{
+			description: "no role bindings on server",
+			state: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}}},
+			},
+			server:   nil,
+			expected: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{},
+		},
+		{
+			description: "server and state role bindings are the same",
+			state: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}}},
+			},
+			server: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}}},
+			},
+			expected: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}}},
+			},
+		},
+		{
+			description: "subjects and roles added outside of terraform are appended in server order",
+			state: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}}},
+			},
+			server: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.admin", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-3", Kind: user}}},
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-2", Kind: user}, {Name: "test-1", Kind: group}}},
+			},
+			expected: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}, {Name: "test-2", Kind: user}}},
+				{Role: "cluster.admin", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-3", Kind: user}}},
+			},
+		},
+		{
+			description: "subjects removed outside of terraform are dropped",
+			state: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}, {Name: "test-2", Kind: user}}},
+				{Role: "cluster.admin", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-3", Kind: user}}},
+			},
+			server: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-2", Kind: user}}},
+			},
+			expected: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-2", Kind: user}}},
+			},
+		},
+		{
+			description: "role split across server role bindings is merged",
+			state: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}, {Name: "test-2", Kind: user}}},
+			},
+			server: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-2", Kind: user}}},
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}}},
+			},
+			expected: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.view", Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{{Name: "test-1", Kind: group}, {Name: "test-2", Kind: user}}},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := mergeRoleBindingLists(test.state, test.server)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestConstructAuthoritativeMeta(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		meta        *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		owned       bool
+		expected    *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	}{
+		{
+			description: "nil meta marked as owned",
+			meta:        nil,
+			owned:       true,
+			expected: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				Labels: map[string]string{authoritativeLabelKey: "true"},
+			},
+		},
+		{
+			description: "server meta marked as owned keeps resource version and labels",
+			meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				UID:             "policy-uid",
+				ResourceVersion: "5",
+				Labels:          map[string]string{"key": "value"},
+			},
+			owned: true,
+			expected: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				UID:             "policy-uid",
+				ResourceVersion: "5",
+				Labels:          map[string]string{"key": "value", authoritativeLabelKey: "true"},
+			},
+		},
+		{
+			description: "owned server meta released",
+			meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				UID:    "policy-uid",
+				Labels: map[string]string{authoritativeLabelKey: "true"},
+			},
+			owned: false,
+			expected: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				UID:    "policy-uid",
+				Labels: map[string]string{},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := constructAuthoritativeMeta(test.meta, test.owned)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestIsOwnedByAuthoritativeResource(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		policy      *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+		expected    bool
+	}{
+		{
+			description: "nil policy",
+			policy:      nil,
+			expected:    false,
+		},
+		{
+			description: "policy without ownership label",
+			policy: &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"key": "value"}},
+			},
+			expected: false,
+		},
+		{
+			description: "policy with ownership label",
+			policy: &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{authoritativeLabelKey: "true"}},
+			},
+			expected: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, isOwnedByAuthoritativeResource(test.policy))
+		})
+	}
+}
@@ -6,21 +6,27 @@ SPDX-License-Identifier: MPL-2.0
 package iampolicy
 
 const (
-	ResourceName      = "tanzu-mission-control_iam_policy"
-	scopeKey          = "scope"
-	clusterKey        = "cluster"
-	clusterGroupKey   = "cluster_group"
-	namespaceKey      = "namespace"
-	workspaceKey      = "workspace"
-	organizationKey   = "organization"
-	organizationIDKey = "org_id"
-	roleBindingsKey   = "role_bindings"
-	roleKey           = "role"
-	subjectsKey       = "subjects"
-	subjectNameKey    = "name"
-	subjectKindKey    = "kind"
-	createKey         = "create"
-	updateKey         = "update"
+	ResourceName       = "tanzu-mission-control_iam_policy"
+	MemberResourceName = "tanzu-mission-control_iam_member"
+	scopeKey           = "scope"
+	clusterKey         = "cluster"
+	clusterGroupKey    = "cluster_group"
+	namespaceKey       = "namespace"
+	workspaceKey       = "workspace"
+	organizationKey    = "organization"
+	organizationIDKey  = "org_id"
+	roleBindingsKey    = "role_bindings"
+	roleKey            = "role"
+	subjectsKey        = "subjects"
+	subjectKey         = "subject"
+	subjectNameKey     = "name"
+	subjectKindKey     = "kind"
+	createKey          = "create"
+	updateKey          = "update"
+	authoritativeKey   = "authoritative"
+	policiesKey        = "policies"
+	policyUIDKey       = "uid"
+	bindingsKey        = "bindings"
 )
 
 // Allowed scopes.
@@ -34,3 +40,8 @@ const (
 )
 
 const roleSubjectDelimiter = ";"
+
+const scopeIDDelimiter = ":"
+
+// authoritativeLabelKey marks the IAM policy of a scope as owned by an authoritative IAM policy resource.
+const authoritativeLabelKey = "terraform.tanzu-mission-control/iam-policy-authoritative"
@@ -0,0 +1,180 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+)
+
+func DataSourceIAMPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIAMPolicyRead,
+		Schema:      iamPolicyDataSourceSchema,
+		Description: "Tanzu Mission Control IAM Policy Data Source",
+	}
+}
+
+var iamPolicyDataSourceSchema = map[string]*schema.Schema{
+	scopeKey: scopeSchema,
+	policiesKey: {
+		Type:        schema.TypeList,
+		Description: "Effective IAM policies of the scope: the policy defined directly on the scope and the policies inherited from its parents.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				policyUIDKey: {
+					Type:        schema.TypeString,
+					Description: "UID of the policy",
+					Computed:    true,
+				},
+				roleBindingsKey: {
+					Type:        schema.TypeList,
+					Description: "List of role bindings associated with the policy",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							roleKey: {
+								Type:        schema.TypeString,
+								Description: "Role for this rolebinding",
+								Computed:    true,
+							},
+							subjectsKey: {
+								Type:        schema.TypeList,
+								Description: "Subject for this rolebinding",
+								Computed:    true,
+								Elem:        bindingSubjectDataSourceResource,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	bindingsKey: {
+		Type:        schema.TypeList,
+		Description: "Flattened list of the unique role bindings across the effective IAM policies, one entry per role and subject.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				roleKey: {
+					Type:        schema.TypeString,
+					Description: "Role of the binding",
+					Computed:    true,
+				},
+				subjectKey: {
+					Type:        schema.TypeList,
+					Description: "Subject of the binding",
+					Computed:    true,
+					Elem:        bindingSubjectDataSourceResource,
+				},
+			},
+		},
+	},
+}
+
+var bindingSubjectDataSourceResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		subjectNameKey: {
+			Type:        schema.TypeString,
+			Description: "Subject name",
+			Computed:    true,
+		},
+		subjectKindKey: {
+			Type:        schema.TypeString,
+			Description: "Subject type, having one of the subject types: USER or GROUP or K8S_SERVICEACCOUNT",
+			Computed:    true,
+		},
+	},
+}
+
+func dataSourceIAMPolicyRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullnameData := constructScope(d)
+	if scopedFullnameData == nil {
+		return diag.Errorf("unable to get IAM policy; No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scopesAllowed[:], `, `))
+	}
+
+	policyList, err := retrieveRoleBindingListFromServer(config, scopedFullnameData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(policiesKey, flattenPolicyList(policyList)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(bindingsKey, flattenEffectiveBindings(policyList)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(constructScopeID(scopedFullnameData))
+
+	return diags
+}
+
+func flattenPolicyList(policyList []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy) []interface{} {
+	policies := make([]interface{}, 0, len(policyList))
+
+	for _, policy := range policyList {
+		if policy == nil {
+			continue
+		}
+
+		flattenPolicy := make(map[string]interface{})
+
+		if policy.Meta != nil {
+			flattenPolicy[policyUIDKey] = policy.Meta.UID
+		}
+
+		flattenPolicy[roleBindingsKey] = flattenRoleBindingList(policy.RoleBindings)
+
+		policies = append(policies, flattenPolicy)
+	}
+
+	return policies
+}
+
+// flattenEffectiveBindings returns one entry per role and subject, bindings repeated by inherited policies are listed once.
+func flattenEffectiveBindings(policyList []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy) []interface{} {
+	bindings := make([]interface{}, 0)
+	visited := make(map[string]bool)
+
+	for _, policy := range policyList {
+		if policy == nil {
+			continue
+		}
+
+		for _, rb := range policy.RoleBindings {
+			for _, sub := range rb.Subjects {
+				if sub == nil || sub.Kind == nil {
+					continue
+				}
+
+				key := strings.Join([]string{rb.Role, sub.Name, string(*sub.Kind)}, roleSubjectDelimiter)
+				if visited[key] {
+					continue
+				}
+
+				visited[key] = true
+
+				bindings = append(bindings, map[string]interface{}{
+					roleKey:    rb.Role,
+					subjectKey: []interface{}{flattenSubject(sub)},
+				})
+			}
+		}
+	}
+
+	return bindings
+}
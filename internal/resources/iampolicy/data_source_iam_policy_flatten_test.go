@@ -0,0 +1,150 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestFlattenPolicyList(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil policy list",
+			input:       nil,
+			expected:    []interface{}{},
+		},
+		{
+			description: "normal scenario with direct and inherited policies",
+			input: []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+				{
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "policy-1"},
+					RoleBindings: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+						{
+							Role: "cluster.view",
+							Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+								{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()},
+							},
+						},
+					},
+				},
+				nil,
+				{
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "policy-2"},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					policyUIDKey: "policy-1",
+					roleBindingsKey: []interface{}{
+						map[string]interface{}{
+							roleKey: "cluster.view",
+							subjectsKey: []interface{}{
+								map[string]interface{}{
+									subjectNameKey: "test-1",
+									subjectKindKey: "GROUP",
+								},
+							},
+						},
+					},
+				},
+				map[string]interface{}{
+					policyUIDKey:    "policy-2",
+					roleBindingsKey: []interface{}(nil),
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenPolicyList(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenEffectiveBindings(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil policy list",
+			input:       nil,
+			expected:    []interface{}{},
+		},
+		{
+			description: "bindings repeated by inherited policies are listed once",
+			input: []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+				{
+					RoleBindings: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+						{
+							Role: "cluster.admin",
+							Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+								{Name: "user@example.com", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindUSER.Pointer()},
+								{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()},
+							},
+						},
+					},
+				},
+				{
+					RoleBindings: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+						{
+							Role: "cluster.admin",
+							Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+								{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()},
+								{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindUSER.Pointer()},
+							},
+						},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					roleKey: "cluster.admin",
+					subjectKey: []interface{}{
+						map[string]interface{}{subjectNameKey: "user@example.com", subjectKindKey: "USER"},
+					},
+				},
+				map[string]interface{}{
+					roleKey: "cluster.admin",
+					subjectKey: []interface{}{
+						map[string]interface{}{subjectNameKey: "test-1", subjectKindKey: "GROUP"},
+					},
+				},
+				map[string]interface{}{
+					roleKey: "cluster.admin",
+					subjectKey: []interface{}{
+						map[string]interface{}{subjectNameKey: "test-1", subjectKindKey: "USER"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenEffectiveBindings(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
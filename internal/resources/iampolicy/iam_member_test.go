@@ -0,0 +1,221 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	organizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/organization"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+func TestIAMMemberID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description    string
+		scopedFullname *scopedFullname
+		role           string
+		subject        *iammodel.VmwareTanzuCoreV1alpha1PolicySubject
+		expected       string
+	}{
+		{
+			description: "organization scope",
+			scopedFullname: &scopedFullname{
+				scope:                organizationScope,
+				fullnameOrganization: &organizationmodel.VmwareTanzuManageV1alpha1OrganizationFullName{OrgID: "org-id"},
+			},
+			role: "organization.view",
+			subject: &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+				Name: "test-1",
+				Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer(),
+			},
+			expected: "organization:org-id/organization.view/GROUP/test-1",
+		},
+		{
+			description: "cluster group scope",
+			scopedFullname: &scopedFullname{
+				scope:                clusterGroupScope,
+				fullnameClusterGroup: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: "default"},
+			},
+			role: "clustergroup.edit",
+			subject: &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+				Name: "user@example.com",
+				Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindUSER.Pointer(),
+			},
+			expected: "cluster_group:default/clustergroup.edit/USER/user@example.com",
+		},
+		{
+			description: "cluster scope",
+			scopedFullname: &scopedFullname{
+				scope: clusterScope,
+				fullnameCluster: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+					ManagementClusterName: "attached",
+					ProvisionerName:       "attached",
+					Name:                  "test-cluster",
+				},
+			},
+			role: "cluster.view",
+			subject: &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+				Name: "test-1",
+				Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer(),
+			},
+			expected: "cluster:attached:attached:test-cluster/cluster.view/GROUP/test-1",
+		},
+		{
+			description: "workspace scope",
+			scopedFullname: &scopedFullname{
+				scope:             workspaceScope,
+				fullnameWorkspace: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "test-workspace"},
+			},
+			role: "workspace.edit",
+			subject: &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+				Name: "test-ns:test-sa",
+				Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindK8SSERVICEACCOUNT.Pointer(),
+			},
+			expected: "workspace:test-workspace/workspace.edit/K8S_SERVICEACCOUNT/test-ns:test-sa",
+		},
+		{
+			description: "namespace scope with delimiter in subject name",
+			scopedFullname: &scopedFullname{
+				scope: namespaceScope,
+				fullnameNamespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+					ManagementClusterName: "attached",
+					ProvisionerName:       "attached",
+					ClusterName:           "test-cluster",
+					Name:                  "test-namespace",
+				},
+			},
+			role: "namespace.view",
+			subject: &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+				Name: "team/dev",
+				Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer(),
+			},
+			expected: "namespace:attached:attached:test-cluster:test-namespace/namespace.view/GROUP/team/dev",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			id := constructIAMMemberID(test.scopedFullname, test.role, test.subject)
+			require.Equal(t, test.expected, id)
+
+			scopedFullnameData, role, subject, err := parseIAMMemberID(id)
+			require.NoError(t, err)
+			require.Equal(t, test.scopedFullname, scopedFullnameData)
+			require.Equal(t, test.role, role)
+			require.Equal(t, test.subject, subject)
+		})
+	}
+}
+
+func TestParseIAMMemberIDInvalid(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		id          string
+	}{
+		{
+			description: "missing subject name",
+			id:          "cluster_group:default/clustergroup.edit/USER",
+		},
+		{
+			description: "empty role",
+			id:          "cluster_group:default//USER/test-1",
+		},
+		{
+			description: "unknown scope type",
+			id:          "management_cluster:test/cluster.view/USER/test-1",
+		},
+		{
+			description: "missing cluster full name parts",
+			id:          "cluster:test-cluster/cluster.view/USER/test-1",
+		},
+		{
+			description: "empty scope name",
+			id:          "workspace:/workspace.edit/USER/test-1",
+		},
+		{
+			description: "invalid subject kind",
+			id:          "workspace:test-workspace/workspace.edit/ROBOT/test-1",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, _, _, err := parseIAMMemberID(test.id)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestHasRoleBinding(t *testing.T) {
+	t.Parallel()
+
+	policy := &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+		RoleBindings: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+			{
+				Role: "workspace.edit",
+				Subjects: []*iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+					{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		description string
+		policy      *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+		role        string
+		subject     *iammodel.VmwareTanzuCoreV1alpha1PolicySubject
+		expected    bool
+	}{
+		{
+			description: "nil policy",
+			policy:      nil,
+			role:        "workspace.edit",
+			subject:     &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()},
+			expected:    false,
+		},
+		{
+			description: "role binding exists",
+			policy:      policy,
+			role:        "workspace.edit",
+			subject:     &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()},
+			expected:    true,
+		},
+		{
+			description: "subject bound to another role",
+			policy:      policy,
+			role:        "workspace.view",
+			subject:     &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP.Pointer()},
+			expected:    false,
+		},
+		{
+			description: "subject with same name and another kind",
+			policy:      policy,
+			role:        "workspace.edit",
+			subject:     &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{Name: "test-1", Kind: iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindUSER.Pointer()},
+			expected:    false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, hasRoleBinding(test.policy, test.role, test.subject))
+		})
+	}
+}
@@ -0,0 +1,329 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	organizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/organization"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+const (
+	memberIDDelimiter      = "/"
+	memberPatchRetryPeriod = 5 * time.Second
+	memberPatchRetryCount  = 6
+)
+
+func ResourceIAMMember() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceIAMMemberRead,
+		CreateContext: resourceIAMMemberCreate,
+		DeleteContext: resourceIAMMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIAMMemberImporter,
+		},
+		Schema: iamMemberSchema,
+		CustomizeDiff: customdiff.All(
+			validateScope,
+		),
+	}
+}
+
+var iamMemberSchema = map[string]*schema.Schema{
+	scopeKey: scopeSchema,
+	roleKey: {
+		Type:         schema.TypeString,
+		Description:  "Role to be bound to the subject: max length for a role is 126 characters.",
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringLenBetween(1, 126),
+	},
+	subjectKey: {
+		Type:        schema.TypeList,
+		Description: "Subject to which the role is bound.",
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				subjectNameKey: {
+					Type:         schema.TypeString,
+					Description:  "Subject name: allow max characters for email - 320 characters.",
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 320),
+				},
+				subjectKindKey: {
+					Type:         schema.TypeString,
+					Description:  "Subject type, having one of the subject types: USER or GROUP or K8S_SERVICEACCOUNT",
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(subjectKinds, false),
+				},
+			},
+		},
+	},
+}
+
+func constructMemberBindingDelta(d *schema.ResourceData, op *iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDeltaOpType) *iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDelta {
+	delta := &iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDelta{
+		Op: op,
+	}
+
+	if v, ok := d.GetOk(roleKey); ok {
+		helper.SetPrimitiveValue(v, &delta.Role, roleKey)
+	}
+
+	if v, ok := d.GetOk(subjectKey); ok {
+		if data, _ := v.([]interface{}); len(data) != 0 && data[0] != nil {
+			delta.Subject = expandSubject(data[0])
+		}
+	}
+
+	return delta
+}
+
+// hasRoleBinding checks if the role is bound to the subject in the policy.
+func hasRoleBinding(policy *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy, role string, subject *iammodel.VmwareTanzuCoreV1alpha1PolicySubject) bool {
+	if policy == nil || subject == nil || subject.Kind == nil {
+		return false
+	}
+
+	for _, rb := range policy.RoleBindings {
+		if rb.Role != role {
+			continue
+		}
+
+		for _, sub := range rb.Subjects {
+			if sub.Name == subject.Name && sub.Kind != nil && *sub.Kind == *subject.Kind {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// patchIAMMember patches the scope policy with a single binding delta.
+// Patches from concurrent applies on the same scope may conflict, those are retried.
+func patchIAMMember(config authctx.TanzuContext, scopedFullname *scopedFullname, delta *iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDelta) error {
+	patch := func() (bool, error) {
+		_, err := patchScopeIAMPolicy(config, scopedFullname, []*iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDelta{delta})
+		if err != nil && clienterrors.IsAlreadyExistsError(err) {
+			return true, err
+		}
+
+		return false, err
+	}
+
+	_, err := helper.Retry(patch, memberPatchRetryPeriod, memberPatchRetryCount)
+
+	return err
+}
+
+func resourceIAMMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullname := constructScope(d)
+	if scopedFullname == nil {
+		return diag.Errorf("unable to create IAM member; Scope full name is empty")
+	}
+
+	delta := constructMemberBindingDelta(d, iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDeltaOpTypeADD.Pointer())
+	id := constructIAMMemberID(scopedFullname, delta.Role, delta.Subject)
+
+	if err := validateRolesExist(config, []string{delta.Role}); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to create IAM member"))
+	}
+
+	policy, err := getScopeIAMPolicy(config, scopedFullname)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to create IAM member"))
+	}
+
+	// The binding is owned by another configuration, or was added outside of terraform.
+	if hasRoleBinding(policy, delta.Role, delta.Subject) {
+		return diag.Errorf("unable to create IAM member; role binding already exists, import it with ID: %s", id)
+	}
+
+	if err := patchIAMMember(config, scopedFullname, delta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to create IAM member"))
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceIAMMemberRead(ctx, d, m)...)
+}
+
+func resourceIAMMemberRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullname := constructScope(d)
+	if scopedFullname == nil {
+		return diag.Errorf("unable to get IAM member; Scope full name is empty")
+	}
+
+	delta := constructMemberBindingDelta(d, nil)
+
+	policy, err := getScopeIAMPolicy(config, scopedFullname)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "unable to get IAM member"))
+	}
+
+	if !hasRoleBinding(policy, delta.Role, delta.Subject) {
+		_ = schema.RemoveFromState(d, m)
+	}
+
+	return diags
+}
+
+func resourceIAMMemberDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullname := constructScope(d)
+	if scopedFullname == nil {
+		return diag.Errorf("unable to delete IAM member; Scope full name is empty")
+	}
+
+	delta := constructMemberBindingDelta(d, iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDeltaOpTypeDELETE.Pointer())
+
+	if err := patchIAMMember(config, scopedFullname, delta); err != nil && !clienterrors.IsNotFoundError(err) {
+		return diag.FromErr(errors.Wrapf(err, "unable to delete IAM member"))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+func resourceIAMMemberImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullname, role, subject, err := parseIAMMemberID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(scopeKey, flattenScope(scopedFullname)); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(roleKey, role); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(subjectKey, []interface{}{flattenSubject(subject)}); err != nil {
+		return nil, err
+	}
+
+	if diags := resourceIAMMemberRead(ctx, d, m); diags.HasError() {
+		return nil, errors.Errorf("unable to import IAM member with ID: %s", d.Id())
+	}
+
+	if d.Id() == "" {
+		return nil, errors.Errorf("unable to import IAM member; role binding with ID: %s not found", constructIAMMemberID(scopedFullname, role, subject))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// constructIAMMemberID returns the ID of the IAM member: <scope>/<role>/<subject kind>/<subject name>.
+func constructIAMMemberID(scopedFullname *scopedFullname, role string, subject *iammodel.VmwareTanzuCoreV1alpha1PolicySubject) string {
+	var kind, name string
+
+	if subject != nil {
+		name = subject.Name
+
+		if subject.Kind != nil {
+			kind = string(*subject.Kind)
+		}
+	}
+
+	return strings.Join([]string{constructScopeID(scopedFullname), role, kind, name}, memberIDDelimiter)
+}
+
+// parseIAMMemberID parses the ID of the IAM member, the subject name is allowed to contain the delimiter.
+func parseIAMMemberID(id string) (*scopedFullname, string, *iammodel.VmwareTanzuCoreV1alpha1PolicySubject, error) {
+	idFormat := "<scope type>:<scope full name>/<role>/<subject kind>/<subject name>"
+
+	parts := strings.SplitN(id, memberIDDelimiter, 4)
+	if len(parts) != 4 || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, "", nil, fmt.Errorf("IAM member ID: %s is not valid: expected format %s", id, idFormat)
+	}
+
+	scopeParts := strings.Split(parts[0], scopeIDDelimiter)
+	scopedFullnameData := &scopedFullname{}
+
+	switch {
+	case scopeParts[0] == organizationKey && len(scopeParts) == 2:
+		scopedFullnameData.scope = organizationScope
+		scopedFullnameData.fullnameOrganization = &organizationmodel.VmwareTanzuManageV1alpha1OrganizationFullName{OrgID: scopeParts[1]}
+	case scopeParts[0] == clusterGroupKey && len(scopeParts) == 2:
+		scopedFullnameData.scope = clusterGroupScope
+		scopedFullnameData.fullnameClusterGroup = &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: scopeParts[1]}
+	case scopeParts[0] == clusterKey && len(scopeParts) == 4:
+		scopedFullnameData.scope = clusterScope
+		scopedFullnameData.fullnameCluster = &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+			ManagementClusterName: scopeParts[1],
+			ProvisionerName:       scopeParts[2],
+			Name:                  scopeParts[3],
+		}
+	case scopeParts[0] == workspaceKey && len(scopeParts) == 2:
+		scopedFullnameData.scope = workspaceScope
+		scopedFullnameData.fullnameWorkspace = &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: scopeParts[1]}
+	case scopeParts[0] == namespaceKey && len(scopeParts) == 5:
+		scopedFullnameData.scope = namespaceScope
+		scopedFullnameData.fullnameNamespace = &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+			ManagementClusterName: scopeParts[1],
+			ProvisionerName:       scopeParts[2],
+			ClusterName:           scopeParts[3],
+			Name:                  scopeParts[4],
+		}
+	default:
+		return nil, "", nil, fmt.Errorf("scope: %s of IAM member ID: %s is not valid: expected one of %s:<org_id>, %s:<name>, %s:<management_cluster_name>:<provisioner_name>:<name>, %s:<name> or %s:<management_cluster_name>:<provisioner_name>:<cluster_name>:<name>",
+			parts[0], id, organizationKey, clusterGroupKey, clusterKey, workspaceKey, namespaceKey)
+	}
+
+	for _, part := range scopeParts[1:] {
+		if part == "" {
+			return nil, "", nil, fmt.Errorf("scope: %s of IAM member ID: %s is not valid: full name parts must not be empty", parts[0], id)
+		}
+	}
+
+	if !isValidSubjectKind(parts[2]) {
+		return nil, "", nil, fmt.Errorf("subject kind: %s of IAM member ID: %s is not valid: expected one of %s", parts[2], id, strings.Join(subjectKinds, `, `))
+	}
+
+	subject := &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{
+		Name: parts[3],
+		Kind: iammodel.NewVmwareTanzuCoreV1alpha1PolicySubjectKind(iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKind(parts[2])),
+	}
+
+	return scopedFullnameData, parts[1], subject, nil
+}
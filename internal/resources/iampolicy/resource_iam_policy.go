@@ -46,12 +46,21 @@ func ResourceIAMPolicy() *schema.Resource {
 }
 
 var iamPolicySchema = map[string]*schema.Schema{
-	scopeKey:        scopeSchema,
-	common.MetaKey:  common.Meta,
-	roleBindingsKey: roleBinding,
+	scopeKey:         scopeSchema,
+	common.MetaKey:   common.Meta,
+	roleBindingsKey:  roleBinding,
+	authoritativeKey: authoritativeSchema,
 }
 
 func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := validateRolesExist(m.(authctx.TanzuContext), rolesOfBindings(constructRoleBindingList(d))); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to create IAM policy"))
+	}
+
+	if isAuthoritative(d) {
+		return resourceAuthoritativeIAMPolicyCreate(ctx, d, m)
+	}
+
 	config, _ := m.(authctx.TanzuContext)
 
 	var UID string
@@ -201,6 +210,7 @@ func retrieveRoleBindingListFromServer(config authctx.TanzuContext, scopedFullna
 }
 
 // resourceIAMPolicyRead returns the intersection between binding list in terraform state and TMC server.
+// In authoritative mode, all the role bindings on TMC server are returned so that unmanaged bindings show up as drift.
 func resourceIAMPolicyRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	config, _ := m.(authctx.TanzuContext)
 
@@ -241,6 +251,14 @@ func resourceIAMPolicyRead(_ context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
+	if isAuthoritative(d) {
+		if err := d.Set(roleBindingsKey, flattenRoleBindingList(mergeRoleBindingLists(rbStateList, rbServerList))); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
+	}
+
 	// nested iteration for preserving order of role binding lists.
 	for _, stateRB := range rbStateList {
 		for _, serverRB := range rbServerList {
@@ -324,6 +342,16 @@ func getRoleBindingListToUpdate(subjectIntersect *map[string]iammodel.VmwareTanz
 }
 
 func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if d.HasChange(roleBindingsKey) {
+		if err := validateRolesExist(m.(authctx.TanzuContext), rolesOfBindings(constructRoleBindingList(d))); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "unable to update IAM policy"))
+		}
+	}
+
+	if isAuthoritative(d) {
+		return resourceAuthoritativeIAMPolicyUpdate(ctx, d, m)
+	}
+
 	config, _ := m.(authctx.TanzuContext)
 
 	var (
@@ -342,6 +370,8 @@ func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	// The resource version is not checked: the patch only adds and deletes the bindings of this resource,
+	// while other non-authoritative policies on the same scope update the version of the shared policy object.
 	for _, policy := range policyList {
 		if policy.Meta.UID == d.State().ID {
 			constructRBOpForUpdate(policy.RoleBindings, &subjectIntersect, iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDeltaOpTypeOPTYPEUNSPECIFIED)
@@ -431,7 +461,11 @@ func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 	)
 }
 
-func resourceIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceIAMPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isAuthoritative(d) {
+		return resourceAuthoritativeIAMPolicyDelete(ctx, d, m)
+	}
+
 	config, _ := m.(authctx.TanzuContext)
 
 	var diags diag.Diagnostics
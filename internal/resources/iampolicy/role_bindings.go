@@ -17,6 +17,8 @@ import (
 	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
 )
 
+var subjectKinds = []string{"USER", "GROUP", "K8S_SERVICEACCOUNT"}
+
 var roleBinding = &schema.Schema{
 	Type:        schema.TypeList,
 	Description: "List of role bindings associated with the policy",
@@ -45,7 +47,7 @@ var roleBinding = &schema.Schema{
 							Type:         schema.TypeString,
 							Description:  "Subject type, having one of the subject types: USER or GROUP or K8S_SERVICEACCOUNT",
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"USER", "GROUP", "K8S_SERVICEACCOUNT"}, false),
+							ValidateFunc: validation.StringInSlice(subjectKinds, false),
 						},
 					},
 				},
@@ -119,6 +121,16 @@ func constructBindingDeltaList(d *schema.ResourceData, op *iammodel.VmwareTanzuC
 	return deltaList
 }
 
+func isValidSubjectKind(kind string) bool {
+	for _, each := range subjectKinds {
+		if each == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
 func expandSubject(data interface{}) (subject *iammodel.VmwareTanzuCoreV1alpha1PolicySubject) {
 	lookUpSubjects, _ := data.(map[string]interface{})
 	subject = &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{}
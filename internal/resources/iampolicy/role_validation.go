@@ -0,0 +1,80 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	iamrolemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_role"
+)
+
+// validateRolesExist fails when a role referenced by the role bindings is neither a built-in nor a custom role of the organization.
+// The check runs at apply time, so that custom roles created in the same run are already present.
+func validateRolesExist(config authctx.TanzuContext, roles []string) error {
+	missing, err := findMissingRoles(roles, func(role string) (bool, error) {
+		_, err := config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceGet(&iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{
+			Name: role,
+		})
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to validate roles")
+	}
+
+	if len(missing) != 0 {
+		return errors.Errorf("role(s) %s not found; create the custom role(s) first or check the role names", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func findMissingRoles(roles []string, roleExists func(role string) (bool, error)) ([]string, error) {
+	missing := make([]string, 0)
+
+	for _, role := range roles {
+		exists, err := roleExists(role)
+		if err != nil {
+			return nil, errors.Wrapf(err, "role: %s", role)
+		}
+
+		if !exists {
+			missing = append(missing, role)
+		}
+	}
+
+	return missing, nil
+}
+
+// rolesOfBindings returns the unique roles of the role bindings, in the order they are first bound.
+func rolesOfBindings(rbl []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding) []string {
+	roles := make([]string, 0, len(rbl))
+	visited := make(map[string]bool)
+
+	for _, rb := range rbl {
+		if rb == nil || rb.Role == "" || visited[rb.Role] {
+			continue
+		}
+
+		visited[rb.Role] = true
+
+		roles = append(roles, rb.Role)
+	}
+
+	return roles
+}
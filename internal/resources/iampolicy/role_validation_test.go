@@ -0,0 +1,103 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+)
+
+func TestRolesOfBindings(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding
+		expected    []string
+	}{
+		{
+			description: "check for nil role binding list",
+			input:       nil,
+			expected:    []string{},
+		},
+		{
+			description: "roles bound more than once are listed once",
+			input: []*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{
+				{Role: "cluster.admin"},
+				nil,
+				{Role: "custom.exec"},
+				{Role: ""},
+				{Role: "cluster.admin"},
+			},
+			expected: []string{"cluster.admin", "custom.exec"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := rolesOfBindings(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFindMissingRoles(t *testing.T) {
+	t.Parallel()
+
+	existingRoles := map[string]bool{"cluster.admin": true, "custom.exec": true}
+
+	cases := []struct {
+		description string
+		roles       []string
+		roleExists  func(role string) (bool, error)
+		expected    []string
+		expectedErr bool
+	}{
+		{
+			description: "all roles exist",
+			roles:       []string{"cluster.admin", "custom.exec"},
+			roleExists: func(role string) (bool, error) {
+				return existingRoles[role], nil
+			},
+			expected: []string{},
+		},
+		{
+			description: "missing roles are reported",
+			roles:       []string{"cluster.admin", "custom.view", "custom.edit"},
+			roleExists: func(role string) (bool, error) {
+				return existingRoles[role], nil
+			},
+			expected: []string{"custom.view", "custom.edit"},
+		},
+		{
+			description: "lookup error",
+			roles:       []string{"cluster.admin"},
+			roleExists: func(role string) (bool, error) {
+				return false, errors.New("internal server error")
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := findMissingRoles(test.roles, test.roleExists)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
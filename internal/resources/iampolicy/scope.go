@@ -144,6 +144,29 @@ func flattenScope(scopedFullname *scopedFullname) (data []interface{}) {
 	return []interface{}{flattenScopeData}
 }
 
+// constructScopeID returns the scope type followed by the scope full name, joined by colons.
+func constructScopeID(scopedFullname *scopedFullname) string {
+	var scopeParts []string
+
+	switch scopedFullname.scope {
+	case organizationScope:
+		scopeParts = []string{organizationKey, scopedFullname.fullnameOrganization.OrgID}
+	case clusterGroupScope:
+		scopeParts = []string{clusterGroupKey, scopedFullname.fullnameClusterGroup.Name}
+	case clusterScope:
+		fn := scopedFullname.fullnameCluster
+		scopeParts = []string{clusterKey, fn.ManagementClusterName, fn.ProvisionerName, fn.Name}
+	case workspaceScope:
+		scopeParts = []string{workspaceKey, scopedFullname.fullnameWorkspace.Name}
+	case namespaceScope:
+		fn := scopedFullname.fullnameNamespace
+		scopeParts = []string{namespaceKey, fn.ManagementClusterName, fn.ProvisionerName, fn.ClusterName, fn.Name}
+	case unknownScope:
+	}
+
+	return strings.Join(scopeParts, scopeIDDelimiter)
+}
+
 func validateScope(_ context.Context, diff *schema.ResourceDiff, i interface{}) error {
 	value, ok := diff.GetOk(scopeKey)
 	if !ok {
@@ -0,0 +1,190 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	clusteriammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy/cluster"
+	clustergroupiammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy/clustergroup"
+	namespaceiammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy/namespace"
+	organizationiammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy/organization"
+	workspaceiammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy/workspace"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// getScopeIAMPolicy returns the IAM policy defined directly on the scope, or nil when the scope has no policy of its own.
+// The GET API returns the policies inherited from the parents of the scope too, those are dropped.
+func getScopeIAMPolicy(config authctx.TanzuContext, scopedFullname *scopedFullname) (*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy, error) {
+	policyList, err := retrieveRoleBindingListFromServer(config, scopedFullname)
+	if err != nil {
+		return nil, err
+	}
+
+	return scopeIAMPolicy(scopedFullname, policyList), nil
+}
+
+// scopeIAMPolicy returns the policy of the list having the scope as parent reference.
+func scopeIAMPolicy(scopedFullname *scopedFullname, policyList []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy) *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy {
+	ridType, nameParts := scopeRIDParts(scopedFullname)
+	if ridType == "" {
+		return nil
+	}
+
+	prefix := fmt.Sprintf("rid:%s:", ridType)
+	suffix := fmt.Sprintf(":%s", strings.Join(nameParts, ":"))
+
+	for _, policy := range policyList {
+		if policy == nil || policy.Meta == nil {
+			continue
+		}
+
+		for _, reference := range policy.Meta.ParentReferences {
+			if reference != nil && strings.HasPrefix(reference.Rid, prefix) && strings.HasSuffix(reference.Rid, suffix) {
+				return policy
+			}
+		}
+	}
+
+	return nil
+}
+
+// scopeRIDParts returns the resource type of the scope in a resource identifier (rid), and the names ending the rid of the scope.
+func scopeRIDParts(scopedFullname *scopedFullname) (ridType string, nameParts []string) {
+	switch scopedFullname.scope {
+	case organizationScope:
+		return "o", []string{scopedFullname.fullnameOrganization.OrgID}
+	case clusterGroupScope:
+		return "cg", []string{scopedFullname.fullnameClusterGroup.Name}
+	case clusterScope:
+		fn := scopedFullname.fullnameCluster
+		return "c", []string{fn.ManagementClusterName, fn.ProvisionerName, fn.Name}
+	case workspaceScope:
+		return "ws", []string{scopedFullname.fullnameWorkspace.Name}
+	case namespaceScope:
+		fn := scopedFullname.fullnameNamespace
+		return "ns", []string{fn.ManagementClusterName, fn.ProvisionerName, fn.ClusterName, fn.Name}
+	case unknownScope:
+	}
+
+	return "", nil
+}
+
+// patchScopeIAMPolicy applies the binding deltas on the IAM policy of the scope, leaving other role bindings untouched.
+func patchScopeIAMPolicy(config authctx.TanzuContext, scopedFullname *scopedFullname, blData []*iammodel.VmwareTanzuCoreV1alpha1PolicyBindingDelta) (*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy, error) {
+	switch scopedFullname.scope {
+	case organizationScope:
+		resp, err := config.TMCConnection.OrganizationIAMResourceService.ManageV1alpha1OrganizationIAMPolicyPatch(&organizationiammodel.VmwareTanzuManageV1alpha1OrganizationPatchOrganizationIAMPolicyRequest{
+			FullName:         scopedFullname.fullnameOrganization,
+			BindingDeltaList: blData,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to patch IAM policy for organization")
+		}
+
+		return resp.Policy, nil
+	case clusterGroupScope:
+		resp, err := config.TMCConnection.ClusterGroupIAMResourceService.ManageV1alpha1ClusterGroupIAMPolicyPatch(&clustergroupiammodel.VmwareTanzuManageV1alpha1ClustergroupPatchClusterGroupIAMPolicyRequest{
+			FullName:         scopedFullname.fullnameClusterGroup,
+			BindingDeltaList: blData,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to patch IAM policy for cluster group")
+		}
+
+		return resp.Policy, nil
+	case clusterScope:
+		resp, err := config.TMCConnection.ClusterIAMResourceService.ManageV1alpha1ClusterIAMPolicyPatch(&clusteriammodel.VmwareTanzuManageV1alpha1ClusterPatchClusterIAMPolicyRequest{
+			FullName:         scopedFullname.fullnameCluster,
+			BindingDeltaList: blData,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to patch IAM policy for cluster")
+		}
+
+		return resp.Policy, nil
+	case workspaceScope:
+		resp, err := config.TMCConnection.WorkspaceIAMResourceService.ManageV1alpha1WorkspaceIAMPolicyPatch(&workspaceiammodel.VmwareTanzuManageV1alpha1WorkspacePatchWorkspaceIAMPolicyRequest{
+			FullName:         scopedFullname.fullnameWorkspace,
+			BindingDeltaList: blData,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to patch IAM policy for workspace")
+		}
+
+		return resp.Policy, nil
+	case namespaceScope:
+		resp, err := config.TMCConnection.NamespaceIAMResourceService.ManageV1alpha1ClusterNamespaceIAMPolicyPatch(&namespaceiammodel.VmwareTanzuManageV1alpha1ClusterNamespacePatchNamespaceIAMPolicyRequest{
+			FullName:         scopedFullname.fullnameNamespace,
+			BindingDeltaList: blData,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to patch IAM policy for namespace")
+		}
+
+		return resp.Policy, nil
+	case unknownScope:
+	}
+
+	return nil, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scopesAllowed[:], `, `))
+}
+
+// updateScopeIAMPolicy replaces the complete IAM policy of the scope.
+func updateScopeIAMPolicy(config authctx.TanzuContext, scopedFullname *scopedFullname, policy *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy) (*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy, error) {
+	switch scopedFullname.scope {
+	case organizationScope:
+		resp, err := config.TMCConnection.OrganizationIAMResourceService.ManageV1alpha1OrganizationIAMPolicyUpdate(scopedFullname.fullnameOrganization, policy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to update IAM policy for organization")
+		}
+
+		return resp.Policy, nil
+	case clusterGroupScope:
+		resp, err := config.TMCConnection.ClusterGroupIAMResourceService.ManageV1alpha1ClusterGroupIAMPolicyUpdate(scopedFullname.fullnameClusterGroup, policy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to update IAM policy for cluster group")
+		}
+
+		return resp.Policy, nil
+	case clusterScope:
+		resp, err := config.TMCConnection.ClusterIAMResourceService.ManageV1alpha1ClusterIAMPolicyUpdate(scopedFullname.fullnameCluster, policy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to update IAM policy for cluster")
+		}
+
+		return resp.Policy, nil
+	case workspaceScope:
+		resp, err := config.TMCConnection.WorkspaceIAMResourceService.ManageV1alpha1WorkspaceIAMPolicyUpdate(scopedFullname.fullnameWorkspace, policy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to update IAM policy for workspace")
+		}
+
+		return resp.Policy, nil
+	case namespaceScope:
+		resp, err := config.TMCConnection.NamespaceIAMResourceService.ManageV1alpha1ClusterNamespaceIAMPolicyUpdate(scopedFullname.fullnameNamespace, policy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to update IAM policy for namespace")
+		}
+
+		return resp.Policy, nil
+	case unknownScope:
+	}
+
+	return nil, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scopesAllowed[:], `, `))
+}
+
+func policyMeta(policy *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy) *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta {
+	if policy == nil {
+		return nil
+	}
+
+	return policy.Meta
+}
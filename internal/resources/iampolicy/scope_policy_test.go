@@ -0,0 +1,88 @@
+/*
+Copyright © 2022 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+func TestScopeIAMPolicy(t *testing.T) {
+	t.Parallel()
+
+	policyOf := func(rid string) *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy {
+		return &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+			Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				UID:              rid,
+				ParentReferences: []*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference{{Rid: rid}},
+			},
+		}
+	}
+
+	namespaceFullname := &scopedFullname{
+		scope: namespaceScope,
+		fullnameNamespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+			ManagementClusterName: "attached",
+			ProvisionerName:       "attached",
+			ClusterName:           "cluster-1",
+			Name:                  "ns-1",
+		},
+	}
+	workspaceFullname := &scopedFullname{
+		scope:             workspaceScope,
+		fullnameWorkspace: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "ws-1"},
+	}
+	inherited := []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+		policyOf("rid:o:org-1"),
+		policyOf("rid:ws:org-1:ws-1"),
+		policyOf("rid:c:org-1:attached:attached:cluster-1"),
+	}
+
+	cases := []struct {
+		description string
+		scope       *scopedFullname
+		policyList  []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+		expected    *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+	}{
+		{
+			description: "policy of the scope among inherited policies",
+			scope:       namespaceFullname,
+			policyList:  append([]*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{policyOf("rid:ns:org-1:attached:attached:cluster-1:ns-1")}, inherited...),
+			expected:    policyOf("rid:ns:org-1:attached:attached:cluster-1:ns-1"),
+		},
+		{
+			description: "only inherited policies",
+			scope:       namespaceFullname,
+			policyList:  inherited,
+			expected:    nil,
+		},
+		{
+			description: "policy of the workspace among inherited policies",
+			scope:       workspaceFullname,
+			policyList:  inherited,
+			expected:    policyOf("rid:ws:org-1:ws-1"),
+		},
+		{
+			description: "no policies",
+			scope:       workspaceFullname,
+			policyList:  nil,
+			expected:    nil,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, scopeIAMPolicy(test.scope, test.policyList))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrole
+
+const (
+	ResourceName            = "tanzu-mission-control_custom_role"
+	ListDataSourceName      = "tanzu-mission-control_iam_roles"
+	NameKey                 = "name"
+	queryKey                = "query"
+	resourceTypeKey         = "resource_type"
+	includeDeprecatedKey    = "include_deprecated"
+	rolesKey                = "roles"
+	totalCountKey           = "total_count"
+	descriptionKey          = "description"
+	uidKey                  = "uid"
+	isInbuiltKey            = "is_inbuilt"
+	isDeprecatedKey         = "is_deprecated"
+	resourcesKey            = "resources"
+	tanzuPermissionsKey     = "tanzu_permissions"
+	rulesKey                = "rules"
+	apiGroupsKey            = "api_groups"
+	verbsKey                = "verbs"
+	resourceNamesKey        = "resource_names"
+	nonResourceURLsKey      = "non_resource_urls"
+	aggregationRuleKey      = "aggregation_rule"
+	clusterRoleSelectorsKey = "cluster_role_selectors"
+	matchLabelsKey          = "match_labels"
+	matchExpressionsKey     = "match_expressions"
+	keyKey                  = "key"
+	operatorKey             = "operator"
+	specKey                 = "spec"
+	valuesKey               = "values"
+)
@@ -0,0 +1,273 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrole
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	iamrolemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_role"
+)
+
+func DataSourceIAMRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIAMRolesRead,
+		Schema:      iamRolesSchema,
+		Description: "Tanzu Mission Control IAM Roles Data Source",
+	}
+}
+
+var iamRolesSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the roles to search for; supports globbing.",
+		Optional:    true,
+		Default:     "*",
+	},
+	queryKey: {
+		Type:        schema.TypeString,
+		Description: "TQL query to filter the roles.",
+		Optional:    true,
+	},
+	resourceTypeKey: {
+		Type:        schema.TypeString,
+		Description: "Only list the roles which can be bound on this resource type, for example: ORGANIZATION, CLUSTER_GROUP, CLUSTER, WORKSPACE or NAMESPACE.",
+		Optional:    true,
+	},
+	includeDeprecatedKey: {
+		Type:        schema.TypeBool,
+		Description: "List the deprecated roles too.",
+		Optional:    true,
+		Default:     false,
+	},
+	rolesKey: {
+		Type:        schema.TypeList,
+		Description: "List of roles matching the search criteria.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the role",
+					Computed:    true,
+				},
+				uidKey: {
+					Type:        schema.TypeString,
+					Description: "UID of the role",
+					Computed:    true,
+				},
+				descriptionKey: {
+					Type:        schema.TypeString,
+					Description: "Description of the role",
+					Computed:    true,
+				},
+				isInbuiltKey: {
+					Type:        schema.TypeBool,
+					Description: "Flag representing whether the role is a built-in role",
+					Computed:    true,
+				},
+				isDeprecatedKey: {
+					Type:        schema.TypeBool,
+					Description: "Flag representing whether the role is deprecated",
+					Computed:    true,
+				},
+				resourcesKey: {
+					Type:        schema.TypeList,
+					Description: "Resource types on which the role can be bound",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				tanzuPermissionsKey: {
+					Type:        schema.TypeList,
+					Description: "Tanzu Mission Control permissions granted by the role",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				rulesKey: {
+					Type:        schema.TypeList,
+					Description: "Kubernetes rules granted by the role",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							apiGroupsKey:       computedStringList("API groups of the rule resources"),
+							resourcesKey:       computedStringList("Resources the rule applies to"),
+							verbsKey:           computedStringList("Verbs allowed by the rule"),
+							resourceNamesKey:   computedStringList("Names of the resources the rule applies to"),
+							nonResourceURLsKey: computedStringList("Non-resource URLs of the rule"),
+						},
+					},
+				},
+				aggregationRuleKey: {
+					Type:        schema.TypeList,
+					Description: "Aggregation rule to combine the rules of other roles",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							clusterRoleSelectorsKey: {
+								Type:        schema.TypeList,
+								Description: "Label selectors of the roles to be aggregated",
+								Computed:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										matchLabelsKey: {
+											Type:        schema.TypeMap,
+											Description: "Map of {key,value} pairs to match",
+											Computed:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+										},
+										matchExpressionsKey: {
+											Type:        schema.TypeList,
+											Description: "List of label selector requirements",
+											Computed:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													keyKey: {
+														Type:        schema.TypeString,
+														Description: "Label key that the selector applies to",
+														Computed:    true,
+													},
+													operatorKey: {
+														Type:        schema.TypeString,
+														Description: "Relationship of the key to the values",
+														Computed:    true,
+													},
+													valuesKey: computedStringList("Values of the label"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	totalCountKey: {
+		Type:        schema.TypeInt,
+		Description: "Total count of roles matching the search criteria.",
+		Computed:    true,
+	},
+}
+
+func computedStringList(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func dataSourceIAMRolesRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	request := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleListRolesRequest{
+		SearchScope: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSearchScope{},
+	}
+
+	request.SearchScope.Name, _ = d.Get(NameKey).(string)
+	request.Query, _ = d.Get(queryKey).(string)
+	resourceType, _ := d.Get(resourceTypeKey).(string)
+	includeDeprecated, _ := d.Get(includeDeprecatedKey).(bool)
+
+	resp, err := config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceList(request)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return diag.FromErr(errors.Wrapf(err, "Unable to list Tanzu Mission Control IAM roles, name : %s", request.SearchScope.Name))
+	}
+
+	var roles []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole
+
+	if resp != nil {
+		roles = filterRoles(resp.Roles, resourceType, includeDeprecated)
+	}
+
+	if err := d.Set(rolesKey, flattenRoles(roles)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(totalCountKey, len(roles)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idKeys := []string{request.SearchScope.Name, request.Query, resourceType, fmt.Sprintf("%t", includeDeprecated)}
+	d.SetId(fmt.Sprintf("iam_roles/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+// filterRoles returns the roles which can be bound on the resource type, when set, skipping deprecated roles unless requested.
+func filterRoles(roles []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole, resourceType string, includeDeprecated bool) []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole {
+	filtered := make([]*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole, 0, len(roles))
+
+	for _, role := range roles {
+		if role == nil {
+			continue
+		}
+
+		if role.Spec != nil && role.Spec.IsDeprecated && !includeDeprecated {
+			continue
+		}
+
+		if resourceType != "" && (role.Spec == nil || !containsString(role.Spec.Resources, resourceType)) {
+			continue
+		}
+
+		filtered = append(filtered, role)
+	}
+
+	return filtered
+}
+
+func containsString(values []string, value string) bool {
+	for _, each := range values {
+		if strings.EqualFold(each, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenRoles(roles []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole) (data []interface{}) {
+	for _, role := range roles {
+		if role == nil {
+			continue
+		}
+
+		flattenData := make(map[string]interface{})
+
+		if role.FullName != nil {
+			flattenData[NameKey] = role.FullName.Name
+		}
+
+		if role.Meta != nil {
+			flattenData[uidKey] = role.Meta.UID
+			flattenData[descriptionKey] = role.Meta.Description
+		}
+
+		if spec := role.Spec; spec != nil {
+			flattenData[isInbuiltKey] = spec.IsInbuilt
+			flattenData[isDeprecatedKey] = spec.IsDeprecated
+			flattenData[resourcesKey] = spec.Resources
+			flattenData[tanzuPermissionsKey] = spec.TanzuPermissions
+			flattenData[rulesKey] = flattenRules(spec.Rules)
+			flattenData[aggregationRuleKey] = flattenAggregationRule(spec.AggregationRule)
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data
+}
@@ -0,0 +1,179 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrole
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iamrolemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_role"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+func TestFlattenRoles(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil data in role list",
+		},
+		{
+			description: "check for nil role entry in the list",
+			input:       []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{nil},
+		},
+		{
+			description: "normal scenario with role list",
+			input: []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{
+				{
+					FullName: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{
+						Name: "cluster.admin",
+					},
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+						UID:         "role:01",
+						Description: "Admin access to clusters",
+					},
+					Spec: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{
+						IsInbuilt:        true,
+						Resources:        []string{"CLUSTER"},
+						TanzuPermissions: []string{"cluster.admin.get"},
+						Rules: []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleKubernetesRule{
+							{
+								APIGroups: []string{"*"},
+								Resources: []string{"*"},
+								Verbs:     []string{"*"},
+							},
+						},
+						AggregationRule: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleAggregationRule{
+							ClusterRoleSelectors: []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleLabelSelector{
+								{
+									MatchLabels: map[string]string{"aggregate": "true"},
+									MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+										{
+											Key:      "tier",
+											Operator: "In",
+											Values:   []string{"admin"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					FullName: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{
+						Name: "custom.view",
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NameKey:             "cluster.admin",
+					uidKey:              "role:01",
+					descriptionKey:      "Admin access to clusters",
+					isInbuiltKey:        true,
+					isDeprecatedKey:     false,
+					resourcesKey:        []string{"CLUSTER"},
+					tanzuPermissionsKey: []string{"cluster.admin.get"},
+					rulesKey: []interface{}{
+						map[string]interface{}{
+							apiGroupsKey:       []string{"*"},
+							resourcesKey:       []string{"*"},
+							verbsKey:           []string{"*"},
+							resourceNamesKey:   []string(nil),
+							nonResourceURLsKey: []string(nil),
+						},
+					},
+					aggregationRuleKey: []interface{}{
+						map[string]interface{}{
+							clusterRoleSelectorsKey: []interface{}{
+								map[string]interface{}{
+									matchLabelsKey: map[string]string{"aggregate": "true"},
+									matchExpressionsKey: []interface{}{
+										map[string]interface{}{
+											keyKey:      "tier",
+											operatorKey: "In",
+											valuesKey:   []string{"admin"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				map[string]interface{}{
+					NameKey: "custom.view",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenRoles(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFilterRoles(t *testing.T) {
+	t.Parallel()
+
+	clusterAdmin := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{
+		FullName: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{Name: "cluster.admin"},
+		Spec:     &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{Resources: []string{"CLUSTER", "CLUSTER_GROUP"}},
+	}
+	workspaceEdit := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{
+		FullName: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{Name: "workspace.edit"},
+		Spec:     &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{Resources: []string{"WORKSPACE"}},
+	}
+	deprecated := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{
+		FullName: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{Name: "cluster.old"},
+		Spec:     &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{Resources: []string{"CLUSTER"}, IsDeprecated: true},
+	}
+	roles := []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{clusterAdmin, nil, workspaceEdit, deprecated}
+
+	cases := []struct {
+		description       string
+		resourceType      string
+		includeDeprecated bool
+		expected          []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole
+	}{
+		{
+			description: "no filter skips deprecated roles",
+			expected:    []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{clusterAdmin, workspaceEdit},
+		},
+		{
+			description:       "no filter with deprecated roles",
+			includeDeprecated: true,
+			expected:          []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{clusterAdmin, workspaceEdit, deprecated},
+		},
+		{
+			description:  "filter by resource type",
+			resourceType: "cluster",
+			expected:     []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{clusterAdmin},
+		},
+		{
+			description:  "filter by resource type without match",
+			resourceType: "NAMESPACE",
+			expected:     []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := filterRoles(roles, test.resourceType, test.includeDeprecated)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrole
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	iamrolemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_role"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func ResourceCustomRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomRoleCreate,
+		ReadContext:   resourceCustomRoleRead,
+		UpdateContext: resourceCustomRoleInPlaceUpdate,
+		DeleteContext: resourceCustomRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCustomRoleImporter,
+		},
+		Schema:        customRoleSchema,
+		CustomizeDiff: validateRules,
+		Description:   "Tanzu Mission Control Custom Role Resource",
+	}
+}
+
+var customRoleSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the custom role",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	specKey:        specSchema,
+}
+
+// validateRules enforces the kubernetes requirement that a rule applies either to resources or to non-resource URLs.
+func validateRules(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown(specKey) {
+		return nil
+	}
+
+	rules, _ := diff.Get(fmt.Sprintf("%s.0.%s", specKey, rulesKey)).([]interface{})
+
+	for i, raw := range rules {
+		ruleData, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resources, _ := ruleData[resourcesKey].([]interface{})
+		nonResourceURLs, _ := ruleData[nonResourceURLsKey].([]interface{})
+
+		if len(resources) == 0 && len(nonResourceURLs) == 0 {
+			return fmt.Errorf("rule %d of the custom role must define either %s or %s", i, resourcesKey, nonResourceURLsKey)
+		}
+	}
+
+	return nil
+}
+
+func constructFullName(d *schema.ResourceData) *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName {
+	name, _ := d.Get(NameKey).(string)
+
+	return &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{
+		Name: name,
+	}
+}
+
+func resourceCustomRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	specData, _ := d.Get(specKey).([]interface{})
+
+	request := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleRequest{
+		Role: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     constructSpec(specData),
+		},
+	}
+
+	response, err := config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceCreate(request)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control custom role entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.Role.Meta.UID)
+
+	return resourceCustomRoleRead(ctx, d, m)
+}
+
+func resourceCustomRoleRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	response, err := config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control custom role entry, name : %s", fn.Name))
+	}
+
+	if err := setResourceData(d, response.Role); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceCustomRoleInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	if !common.HasMetaChanged(d) && !d.HasChange(specKey) {
+		return diags
+	}
+
+	getResp, err := config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceGet(fn)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control custom role entry, name : %s", fn.Name))
+	}
+
+	if err := common.CheckResourceVersion(d, getResp.Role.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control custom role entry, name : %s", fn.Name))
+	}
+
+	if common.HasMetaChanged(d) {
+		meta := common.ConstructMeta(d)
+
+		meta.Labels = common.WithSystemManagedKeys(meta.Labels, getResp.Role.Meta.Labels)
+
+		getResp.Role.Meta.Labels = meta.Labels
+		getResp.Role.Meta.Description = meta.Description
+	}
+
+	if d.HasChange(specKey) {
+		specData, _ := d.Get(specKey).([]interface{})
+		getResp.Role.Spec = constructSpec(specData)
+	}
+
+	_, err = config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceUpdate(&iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRoleRequest{Role: getResp.Role})
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control custom role entry, name : %s", fn.Name))
+	}
+
+	return resourceCustomRoleRead(ctx, d, m)
+}
+
+func resourceCustomRoleDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	err := config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control custom role entry, name : %s", fn.Name))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+func resourceCustomRoleImporter(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	config, ok := m.(authctx.TanzuContext)
+	if !ok {
+		return nil, errors.New("error while retrieving Tanzu auth config")
+	}
+
+	fn := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleFullName{
+		Name: d.Id(),
+	}
+
+	response, err := config.TMCConnection.IAMRoleResourceService.ManageV1alpha1IAMRoleResourceServiceGet(fn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to import Tanzu Mission Control custom role entry, name : %s", fn.Name)
+	}
+
+	if response.Role != nil && response.Role.Spec != nil && response.Role.Spec.IsInbuilt {
+		return nil, errors.Errorf("Unable to import Tanzu Mission Control role %s: built-in roles cannot be managed as custom roles", fn.Name)
+	}
+
+	if err := d.Set(NameKey, fn.Name); err != nil {
+		return nil, err
+	}
+
+	if err := setResourceData(d, response.Role); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setResourceData(d *schema.ResourceData, role *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleRole) error {
+	if role == nil {
+		return errors.New("custom role response is empty")
+	}
+
+	d.SetId(role.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(role.Meta)); err != nil {
+		return err
+	}
+
+	return d.Set(specKey, flattenSpec(role.Spec))
+}
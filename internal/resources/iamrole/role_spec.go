@@ -0,0 +1,314 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrole
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	iamrolemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_role"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// resourceTypes are the resource types on which a custom role can be bound.
+var resourceTypes = []string{"ORGANIZATION", "CLUSTER_GROUP", "CLUSTER", "WORKSPACE", "NAMESPACE"}
+
+var specSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the custom role",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourcesKey: {
+				Type:        schema.TypeList,
+				Description: "Resource types on which the role can be bound, having one of the resource types: ORGANIZATION, CLUSTER_GROUP, CLUSTER, WORKSPACE or NAMESPACE",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(resourceTypes, false),
+				},
+			},
+			isDeprecatedKey: {
+				Type:        schema.TypeBool,
+				Description: "Flag representing whether the role is deprecated",
+				Optional:    true,
+				Default:     false,
+			},
+			tanzuPermissionsKey: {
+				Type:        schema.TypeList,
+				Description: "Tanzu Mission Control permissions granted by the role",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			rulesKey: {
+				Type:        schema.TypeList,
+				Description: "Kubernetes rules granted by the role",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						apiGroupsKey: {
+							Type:        schema.TypeList,
+							Description: "API groups of the rule resources, an empty string represents the core group",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						resourcesKey: {
+							Type:        schema.TypeList,
+							Description: "Resources the rule applies to",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						verbsKey: {
+							Type:        schema.TypeList,
+							Description: "Verbs allowed by the rule",
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						resourceNamesKey: {
+							Type:        schema.TypeList,
+							Description: "Names of the resources the rule applies to",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						nonResourceURLsKey: {
+							Type:        schema.TypeList,
+							Description: "Non-resource URLs of the rule",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			aggregationRuleKey: {
+				Type:        schema.TypeList,
+				Description: "Aggregation rule to combine the rules of the roles matching the selectors",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						clusterRoleSelectorsKey: {
+							Type:        schema.TypeList,
+							Description: "Label selectors of the roles to be aggregated",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									matchLabelsKey: {
+										Type:        schema.TypeMap,
+										Description: "Map of {key,value} pairs to match",
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									matchExpressionsKey: {
+										Type:        schema.TypeList,
+										Description: "List of label selector requirements, the requirements are ANDed",
+										Optional:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												keyKey: {
+													Type:        schema.TypeString,
+													Description: "Label key that the selector applies to",
+													Required:    true,
+												},
+												operatorKey: {
+													Type:         schema.TypeString,
+													Description:  "Relationship of the key to the values, having one of the operators: In, NotIn, Exists or DoesNotExist",
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"In", "NotIn", "Exists", "DoesNotExist"}, false),
+												},
+												valuesKey: {
+													Type:        schema.TypeList,
+													Description: "Values of the label, must be empty for the Exists and DoesNotExist operators",
+													Optional:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func constructSpec(data []interface{}) (spec *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec) {
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData, _ := data[0].(map[string]interface{})
+
+	spec = &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{
+		Rules: make([]*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleKubernetesRule, 0),
+	}
+
+	spec.Resources = constructStringList(specData[resourcesKey])
+	spec.IsDeprecated, _ = specData[isDeprecatedKey].(bool)
+	spec.TanzuPermissions = constructStringList(specData[tanzuPermissionsKey])
+
+	if v, ok := specData[rulesKey].([]interface{}); ok {
+		for _, raw := range v {
+			ruleData, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			spec.Rules = append(spec.Rules, &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleKubernetesRule{
+				APIGroups:       constructStringList(ruleData[apiGroupsKey]),
+				Resources:       constructStringList(ruleData[resourcesKey]),
+				Verbs:           constructStringList(ruleData[verbsKey]),
+				ResourceNames:   constructStringList(ruleData[resourceNamesKey]),
+				NonResourceUrls: constructStringList(ruleData[nonResourceURLsKey]),
+			})
+		}
+	}
+
+	if v, ok := specData[aggregationRuleKey].([]interface{}); ok && len(v) != 0 && v[0] != nil {
+		spec.AggregationRule = constructAggregationRule(v[0].(map[string]interface{}))
+	}
+
+	return spec
+}
+
+func constructAggregationRule(data map[string]interface{}) *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleAggregationRule {
+	aggregationRule := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleAggregationRule{
+		ClusterRoleSelectors: make([]*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleLabelSelector, 0),
+	}
+
+	selectors, _ := data[clusterRoleSelectorsKey].([]interface{})
+
+	for _, raw := range selectors {
+		selectorData, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		selector := &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleLabelSelector{
+			MatchExpressions: make([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement, 0),
+		}
+
+		if labels, ok := selectorData[matchLabelsKey].(map[string]interface{}); ok && len(labels) != 0 {
+			selector.MatchLabels = common.GetTypeStringMapData(labels)
+		}
+
+		expressions, _ := selectorData[matchExpressionsKey].([]interface{})
+
+		for _, rawExpression := range expressions {
+			expressionData, ok := rawExpression.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			expression := &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+				Values: constructStringList(expressionData[valuesKey]),
+			}
+			expression.Key, _ = expressionData[keyKey].(string)
+			expression.Operator, _ = expressionData[operatorKey].(string)
+
+			selector.MatchExpressions = append(selector.MatchExpressions, expression)
+		}
+
+		aggregationRule.ClusterRoleSelectors = append(aggregationRule.ClusterRoleSelectors, selector)
+	}
+
+	return aggregationRule
+}
+
+func constructStringList(data interface{}) []string {
+	values := make([]string, 0)
+
+	list, _ := data.([]interface{})
+
+	for _, raw := range list {
+		value, _ := raw.(string)
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func flattenSpec(spec *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[resourcesKey] = spec.Resources
+	flattenSpecData[isDeprecatedKey] = spec.IsDeprecated
+	flattenSpecData[tanzuPermissionsKey] = spec.TanzuPermissions
+	flattenSpecData[rulesKey] = flattenRules(spec.Rules)
+	flattenSpecData[aggregationRuleKey] = flattenAggregationRule(spec.AggregationRule)
+
+	return []interface{}{flattenSpecData}
+}
+
+func flattenRules(rules []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleKubernetesRule) (data []interface{}) {
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			apiGroupsKey:       rule.APIGroups,
+			resourcesKey:       rule.Resources,
+			verbsKey:           rule.Verbs,
+			resourceNamesKey:   rule.ResourceNames,
+			nonResourceURLsKey: rule.NonResourceUrls,
+		})
+	}
+
+	return data
+}
+
+func flattenAggregationRule(aggregationRule *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleAggregationRule) (data []interface{}) {
+	if aggregationRule == nil {
+		return data
+	}
+
+	selectors := make([]interface{}, 0, len(aggregationRule.ClusterRoleSelectors))
+
+	for _, selector := range aggregationRule.ClusterRoleSelectors {
+		if selector == nil {
+			continue
+		}
+
+		expressions := make([]interface{}, 0, len(selector.MatchExpressions))
+
+		for _, expression := range selector.MatchExpressions {
+			if expression == nil {
+				continue
+			}
+
+			expressions = append(expressions, map[string]interface{}{
+				keyKey:      expression.Key,
+				operatorKey: expression.Operator,
+				valuesKey:   expression.Values,
+			})
+		}
+
+		selectors = append(selectors, map[string]interface{}{
+			matchLabelsKey:      selector.MatchLabels,
+			matchExpressionsKey: expressions,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			clusterRoleSelectorsKey: selectors,
+		},
+	}
+}
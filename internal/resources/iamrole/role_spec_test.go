@@ -0,0 +1,170 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iamrole
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iamrolemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_role"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+func TestConstructSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []interface{}
+		expected    *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec
+	}{
+		{
+			description: "check for nil spec data",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "spec with rules",
+			input: []interface{}{
+				map[string]interface{}{
+					resourcesKey:        []interface{}{"CLUSTER", "NAMESPACE"},
+					isDeprecatedKey:     false,
+					tanzuPermissionsKey: []interface{}{},
+					rulesKey: []interface{}{
+						map[string]interface{}{
+							apiGroupsKey:       []interface{}{""},
+							resourcesKey:       []interface{}{"pods", "pods/exec"},
+							verbsKey:           []interface{}{"get", "list", "create"},
+							resourceNamesKey:   []interface{}{},
+							nonResourceURLsKey: []interface{}{},
+						},
+					},
+					aggregationRuleKey: []interface{}{},
+				},
+			},
+			expected: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{
+				Resources:        []string{"CLUSTER", "NAMESPACE"},
+				TanzuPermissions: []string{},
+				Rules: []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleKubernetesRule{
+					{
+						APIGroups:       []string{""},
+						Resources:       []string{"pods", "pods/exec"},
+						Verbs:           []string{"get", "list", "create"},
+						ResourceNames:   []string{},
+						NonResourceUrls: []string{},
+					},
+				},
+			},
+		},
+		{
+			description: "spec with aggregation rule",
+			input: []interface{}{
+				map[string]interface{}{
+					resourcesKey:    []interface{}{"CLUSTER"},
+					isDeprecatedKey: true,
+					aggregationRuleKey: []interface{}{
+						map[string]interface{}{
+							clusterRoleSelectorsKey: []interface{}{
+								map[string]interface{}{
+									matchLabelsKey: map[string]interface{}{"aggregate": "true"},
+									matchExpressionsKey: []interface{}{
+										map[string]interface{}{
+											keyKey:      "tier",
+											operatorKey: "In",
+											valuesKey:   []interface{}{"view"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{
+				Resources:        []string{"CLUSTER"},
+				IsDeprecated:     true,
+				TanzuPermissions: []string{},
+				Rules:            []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleKubernetesRule{},
+				AggregationRule: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleAggregationRule{
+					ClusterRoleSelectors: []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleLabelSelector{
+						{
+							MatchLabels: map[string]string{"aggregate": "true"},
+							MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+								{
+									Key:      "tier",
+									Operator: "In",
+									Values:   []string{"view"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := constructSpec(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with rules",
+			input: &iamrolemodel.VmwareTanzuManageV1alpha1IamRoleSpec{
+				Resources: []string{"CLUSTER"},
+				Rules: []*iamrolemodel.VmwareTanzuManageV1alpha1IamRoleKubernetesRule{
+					{
+						Resources: []string{"pods/exec"},
+						Verbs:     []string{"create"},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					resourcesKey:        []string{"CLUSTER"},
+					isDeprecatedKey:     false,
+					tanzuPermissionsKey: []string(nil),
+					rulesKey: []interface{}{
+						map[string]interface{}{
+							apiGroupsKey:       []string(nil),
+							resourcesKey:       []string{"pods/exec"},
+							verbsKey:           []string{"create"},
+							resourceNamesKey:   []string(nil),
+							nonResourceURLsKey: []string(nil),
+						},
+					},
+					aggregationRuleKey: []interface{}(nil),
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenSpec(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
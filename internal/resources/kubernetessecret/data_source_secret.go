@@ -20,6 +20,8 @@ import (
 	secretexportclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/cluster/secretexport"
 	secretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/clustergroup"
 	secretexportclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/clustergroup/secretexport"
+	secretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
+	secretexportworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace/secretexport"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -36,6 +38,7 @@ type dataFromServer struct {
 	atomicSpec              *secretclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceSecretSpec
 	clusterScopeStatus      *secretclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceSecretStatus
 	clusterGroupScopeStatus *secretclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceSecretStatus
+	workspaceScopeStatus    *secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretStatus
 }
 
 func DataSourceSecret() *schema.Resource {
@@ -129,6 +132,12 @@ func dataSourceSecretRead(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec, password)
 		flattenedStatus = status.FlattenStatusForClusterGroupScope(secretDataFromServer.clusterGroupScopeStatus)
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := &secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec{
+			AtomicSpec: secretDataFromServer.atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForWorkspaceScope(workspaceScopeSpec, password)
+		flattenedStatus = status.FlattenStatusForWorkspaceScope(secretDataFromServer.workspaceScopeStatus)
 	}
 
 	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
@@ -205,6 +214,36 @@ func retrieveSecretDataFromServer(config authctx.TanzuContext, scopedFullnameDat
 				},
 			)
 
+			secretDataFromServer.secretExportRespNil = (secretExportResp == nil)
+			secretDataFromServer.secretExportErr = secretExporterr
+		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			resp, err := config.TMCConnection.WorkspaceSecretResourceService.SecretResourceServiceGet(scopedFullnameData.FullnameWorkspace)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return secretDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace secret entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+				}
+
+				return secretDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace secret entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+			}
+
+			scopedFullnameData.FullnameWorkspace = resp.Secret.FullName
+			secretDataFromServer.UID = resp.Secret.Meta.UID
+			secretDataFromServer.meta = resp.Secret.Meta
+			secretDataFromServer.atomicSpec = resp.Secret.Spec.AtomicSpec
+			secretDataFromServer.workspaceScopeStatus = resp.Secret.Status
+
+			secretExportResp, secretExporterr := config.TMCConnection.WorkspaceSecretExportResourceService.SecretExportResourceServiceGet(
+				&secretexportworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName{
+					Name:          scopedFullnameData.FullnameWorkspace.Name,
+					WorkspaceName: scopedFullnameData.FullnameWorkspace.WorkspaceName,
+					NamespaceName: scopedFullnameData.FullnameWorkspace.NamespaceName,
+					OrgID:         scopedFullnameData.FullnameWorkspace.OrgID,
+				},
+			)
+
 			secretDataFromServer.secretExportRespNil = (secretExportResp == nil)
 			secretDataFromServer.secretExportErr = secretExporterr
 		}
@@ -24,6 +24,8 @@ import (
 	secretexportclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/cluster/secretexport"
 	clustergroupsecretmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/clustergroup"
 	secretexportclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/clustergroup/secretexport"
+	workspacesecretmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
+	secretexportworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace/secretexport"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -164,6 +166,23 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group secret entry, name : %s", secretName))
 			}
 
+			UID = secretResponse.Secret.Meta.UID
+		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			secretReq := &workspacesecretmodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest{
+				Secret: &workspacesecretmodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForWorkspaceScope(d),
+				},
+			}
+
+			secretResponse, err := config.TMCConnection.WorkspaceSecretResourceService.SecretResourceServiceCreate(secretReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace secret entry, name : %s", secretName))
+			}
+
 			UID = secretResponse.Secret.Meta.UID
 		}
 	case commonscope.UnknownScope:
@@ -242,6 +261,25 @@ func resourceSecretDelete(_ context.Context, d *schema.ResourceData, m interface
 				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group secret entry, name : %s", secretName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			err := config.TMCConnection.WorkspaceSecretExportResourceService.SecretExportResourceServiceDelete(
+				&secretexportworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName{
+					Name:          scopedFullnameData.FullnameWorkspace.Name,
+					WorkspaceName: scopedFullnameData.FullnameWorkspace.WorkspaceName,
+					NamespaceName: scopedFullnameData.FullnameWorkspace.NamespaceName,
+					OrgID:         scopedFullnameData.FullnameWorkspace.OrgID,
+				},
+			)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace secret export entry, name : %s", secretName))
+			}
+
+			err = config.TMCConnection.WorkspaceSecretResourceService.SecretResourceServiceDelete(scopedFullnameData.FullnameWorkspace)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace secret entry, name : %s", secretName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -275,6 +313,10 @@ func resourceSecretInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, secretDataFromServer.meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange(helper.GetFirstElementOf(spec.SpecKey, spec.DockerConfigjsonKey, spec.ImageRegistryURLKey)) {
 		return diag.Errorf("updating %v is not possible", spec.ImageRegistryURLKey)
 	}
@@ -313,6 +355,23 @@ func resourceSecretInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 					return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group secret entry, name : %s", secretName))
 				}
 			}
+		case commonscope.WorkspaceScope:
+			if scopedFullnameData.FullnameWorkspace != nil {
+				secretReq := &workspacesecretmodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretRequest{
+					Secret: &workspacesecretmodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSecret{
+						FullName: scopedFullnameData.FullnameWorkspace,
+						Meta:     secretDataFromServer.meta,
+						Spec: &workspacesecretmodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec{
+							AtomicSpec: secretDataFromServer.atomicSpec,
+						},
+					},
+				}
+
+				_, err = config.TMCConnection.WorkspaceSecretResourceService.SecretResourceServiceUpdate(secretReq)
+				if err != nil {
+					return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace secret entry, name : %s", secretName))
+				}
+			}
 		case commonscope.UnknownScope:
 			return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 		}
@@ -351,6 +410,9 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *clustersecretmodel.V
 	case commonscope.ClusterGroupScope:
 		clusterGroupScopeSpec := spec.ConstructSpecForClusterGroupScope(d)
 		secretSpec = clusterGroupScopeSpec.AtomicSpec
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := spec.ConstructSpecForWorkspaceScope(d)
+		secretSpec = workspaceScopeSpec.AtomicSpec
 	}
 
 	atomicSpec.SecretType = secretSpec.SecretType
@@ -368,9 +430,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
@@ -423,6 +483,25 @@ func createDeleteSecretExport(createKey bool, scopedFullnameData *scope.ScopedFu
 					return errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group secret export entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
 				}
 			}
+		case commonscope.WorkspaceScope:
+			if scopedFullnameData.FullnameWorkspace != nil {
+				secretReq := &secretexportworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportRequest{
+					SecretExport: &secretexportworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportSecretExport{
+						FullName: &secretexportworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName{
+							Name:          scopedFullnameData.FullnameWorkspace.Name,
+							WorkspaceName: scopedFullnameData.FullnameWorkspace.WorkspaceName,
+							NamespaceName: scopedFullnameData.FullnameWorkspace.NamespaceName,
+							OrgID:         scopedFullnameData.FullnameWorkspace.OrgID,
+						},
+						Meta: common.ConstructMeta(d),
+					},
+				}
+
+				_, err := config.TMCConnection.WorkspaceSecretExportResourceService.SecretExportResourceServiceCreate(secretReq)
+				if err != nil {
+					return errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace secret export entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+				}
+			}
 		case commonscope.UnknownScope:
 			return errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 		}
@@ -458,6 +537,20 @@ func createDeleteSecretExport(createKey bool, scopedFullnameData *scope.ScopedFu
 					return errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group secret export entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
 				}
 			}
+		case commonscope.WorkspaceScope:
+			if scopedFullnameData.FullnameWorkspace != nil {
+				err := config.TMCConnection.WorkspaceSecretExportResourceService.SecretExportResourceServiceDelete(
+					&secretexportworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretexportFullName{
+						Name:          scopedFullnameData.FullnameWorkspace.Name,
+						WorkspaceName: scopedFullnameData.FullnameWorkspace.WorkspaceName,
+						NamespaceName: scopedFullnameData.FullnameWorkspace.NamespaceName,
+						OrgID:         scopedFullnameData.FullnameWorkspace.OrgID,
+					},
+				)
+				if err != nil && !clienterrors.IsNotFoundError(err) {
+					return errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace secret export entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+				}
+			}
 		case commonscope.UnknownScope:
 			return errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 		}
@@ -12,6 +12,7 @@ import (
 
 	secretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/cluster"
 	secretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/clustergroup"
+	secretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
@@ -80,6 +81,28 @@ func TestFlattenScope(t *testing.T) {
 			expectedName:      "n",
 			expectedNamespace: "nn",
 		},
+		{
+			description: "normal scenario with complete workspace scope",
+			input: &ScopedFullname{
+				Scope: commonscope.WorkspaceScope,
+				FullnameWorkspace: &secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName{
+					Name:          "n",
+					NamespaceName: "nn",
+					WorkspaceName: "c",
+				},
+			},
+			expectedData: []interface{}{
+				map[string]interface{}{
+					commonscope.WorkspaceKey: []interface{}{
+						map[string]interface{}{
+							commonscope.NameKey: "c",
+						},
+					},
+				},
+			},
+			expectedName:      "n",
+			expectedNamespace: "nn",
+		},
 	}
 
 	for _, each := range cases {
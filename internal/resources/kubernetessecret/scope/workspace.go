@@ -0,0 +1,43 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	secretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func ConstructWorkspaceSecretFullname(data []interface{}, name, namespace string) (fullname *secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName) {
+	if len(data) == 0 || data[0] == nil {
+		return fullname
+	}
+
+	fullNameData, _ := data[0].(map[string]interface{})
+
+	fullname = &secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName{}
+
+	if nameValue, ok := fullNameData[commonscope.NameKey]; ok {
+		helper.SetPrimitiveValue(nameValue, &fullname.WorkspaceName, commonscope.NameKey)
+	}
+
+	fullname.Name = name
+	fullname.NamespaceName = namespace
+
+	return fullname
+}
+
+func FlattenWorkspaceSecretFullname(fullname *secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName) (data []interface{}) {
+	if fullname == nil {
+		return data
+	}
+
+	flattenFullname := make(map[string]interface{})
+
+	flattenFullname[commonscope.NameKey] = fullname.WorkspaceName
+
+	return []interface{}{flattenFullname}
+}
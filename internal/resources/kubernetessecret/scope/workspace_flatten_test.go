@@ -0,0 +1,50 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	secretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func TestFlattenWorkspaceSecretFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil workspace cluster secret full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace cluster secret full name",
+			input: &secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretFullName{
+				WorkspaceName: "c",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					commonscope.NameKey: "c",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenWorkspaceSecretFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
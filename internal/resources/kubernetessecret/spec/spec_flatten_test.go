@@ -13,6 +13,7 @@ import (
 
 	secretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/cluster"
 	secretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/clustergroup"
+	secretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
 )
 
 func TestFlattenClusterScopeSpec(t *testing.T) {
@@ -104,3 +105,49 @@ func TestFlattenClusterGroupScopeSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenWorkspaceScopeSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster secret full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with all values under spec",
+			input: &secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec{
+				AtomicSpec: &secretclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceSecretSpec{
+					SecretType: secretclustermodel.NewVmwareTanzuManageV1alpha1ClusterNamespaceSecretType(secretclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceSecretTypeSECRETTYPEDOCKERCONFIGJSON),
+					Data: map[string]strfmt.Base64{
+						".dockerconfigjson": []byte(`{"auths":{"someurl":{"auth":"","password":"","username":"someuname"}}}`),
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					DockerConfigjsonKey: []interface{}{
+						map[string]interface{}{
+							UsernameKey:         "someuname",
+							ImageRegistryURLKey: "someurl",
+							PasswordKey:         "somepassword",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecForWorkspaceScope(test.input, "somepassword")
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
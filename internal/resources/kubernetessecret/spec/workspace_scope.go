@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	secertworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
+)
+
+func ConstructSpecForWorkspaceScope(d *schema.ResourceData) (spec *secertworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	spec = &secertworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec{}
+	spec.AtomicSpec = ConstructSpecForClusterScope(d)
+
+	return spec
+}
+
+func FlattenSpecForWorkspaceScope(spec *secertworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretSpec, password string) (data []interface{}) {
+	if spec == nil || spec.AtomicSpec == nil {
+		return data
+	}
+
+	return FlattenSpecForClusterScope(spec.AtomicSpec, password)
+}
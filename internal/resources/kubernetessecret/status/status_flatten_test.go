@@ -13,6 +13,7 @@ import (
 
 	secretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/cluster"
 	secretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/clustergroup"
+	secretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubernetessecret/workspace"
 	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 )
 
@@ -94,3 +95,41 @@ func TestFlattenStatusForClusterGroupScope(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenStatusForWorkspaceScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretStatus
+		expected    interface{}
+	}{
+		{
+			description: "check for nil workspace secret status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace secret status",
+			input: &secretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceSecretStatus{
+				Phase: statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer(),
+				Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{
+					Overridden:       0,
+					Applied:          3,
+					AvailableTargets: 3,
+				},
+			},
+			expected: map[string]interface{}{
+				phaseKey: fmt.Sprint(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenStatusForWorkspaceScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -61,6 +61,10 @@ func enableContinuousDelivery(config *authctx.TanzuContext, scopedFullnameData *
 				return err
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			return commonscope.EnableWorkspaceContinuousDelivery(config, scopedFullnameData.FullnameWorkspace.WorkspaceName, meta)
+		}
 	case commonscope.UnknownScope:
 		return fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
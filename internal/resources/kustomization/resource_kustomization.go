@@ -18,6 +18,7 @@ import (
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	kustomizationclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/cluster"
 	kustomizationclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/clustergroup"
+	kustomizationworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/workspace"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -32,6 +33,7 @@ type dataFromServer struct {
 	atomicSpec              *kustomizationclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdKustomizationSpec
 	clusterScopeStatus      *kustomizationclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdKustomizationStatus
 	clusterGroupScopeStatus *kustomizationclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdKustomizationStatus
+	workspaceScopeStatus    *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationStatus
 }
 
 func ResourceKustomization() *schema.Resource {
@@ -41,7 +43,7 @@ func ResourceKustomization() *schema.Resource {
 		ReadContext:   resourceKustomizationRead,
 		UpdateContext: resourceKustomizationInPlaceUpdate,
 		DeleteContext: resourceKustomizationDelete,
-		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey, commonscope.WorkspaceKey})),
 	}
 }
 
@@ -115,6 +117,12 @@ func resourceKustomizationRead(ctx context.Context, d *schema.ResourceData, m in
 		}
 		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
 		flattenedStatus = status.FlattenStatusForClusterGroupScope(kustomizationDataFromServer.clusterGroupScopeStatus)
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationSpec{
+			AtomicSpec: kustomizationDataFromServer.atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForWorkspaceScope(workspaceScopeSpec)
+		flattenedStatus = status.FlattenStatusForWorkspaceScope(kustomizationDataFromServer.workspaceScopeStatus)
 	}
 
 	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
@@ -170,6 +178,24 @@ func retrieveKustomizationDataFromServer(config authctx.TanzuContext, scopedFull
 			kustomizationDataFromServer.atomicSpec = resp.Kustomization.Spec.AtomicSpec
 			kustomizationDataFromServer.clusterGroupScopeStatus = resp.Kustomization.Status
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			resp, err := config.TMCConnection.WorkspaceKustomizationResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceGet(scopedFullnameData.FullnameWorkspace)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return kustomizationDataFromServer, err
+				}
+
+				return kustomizationDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace kustomization entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+			}
+
+			scopedFullnameData.FullnameWorkspace = resp.Kustomization.FullName
+			kustomizationDataFromServer.UID = resp.Kustomization.Meta.UID
+			kustomizationDataFromServer.meta = resp.Kustomization.Meta
+			kustomizationDataFromServer.atomicSpec = resp.Kustomization.Spec.AtomicSpec
+			kustomizationDataFromServer.workspaceScopeStatus = resp.Kustomization.Status
+		}
 	case commonscope.UnknownScope:
 		return kustomizationDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -253,6 +279,23 @@ func resourceKustomizationCreate(ctx context.Context, d *schema.ResourceData, m
 				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
 			}
 
+			UID = kustomizationResponse.Kustomization.Meta.UID
+		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			kustomizationReq := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest{
+				Kustomization: &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomization{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForWorkspaceScope(d),
+				},
+			}
+
+			kustomizationResponse, err := config.TMCConnection.WorkspaceKustomizationResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceCreate(kustomizationReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace kustomization entry, name : %s", kustomizationName))
+			}
+
 			UID = kustomizationResponse.Kustomization.Meta.UID
 		}
 	case commonscope.UnknownScope:
@@ -299,6 +342,13 @@ func resourceKustomizationDelete(ctx context.Context, d *schema.ResourceData, m
 				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			err := config.TMCConnection.WorkspaceKustomizationResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceDelete(scopedFullnameData.FullnameWorkspace)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace kustomization entry, name : %s", kustomizationName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -335,6 +385,10 @@ func resourceKustomizationInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, kustomizationDataFromServer.meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var updateAvailable bool
 
 	if updateCheckForMeta(d, kustomizationDataFromServer.meta) {
@@ -383,6 +437,23 @@ func resourceKustomizationInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			kustomizationReq := &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomizationRequest{
+				Kustomization: &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationKustomization{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     kustomizationDataFromServer.meta,
+					Spec: &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationSpec{
+						AtomicSpec: kustomizationDataFromServer.atomicSpec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.WorkspaceKustomizationResourceService.VmwareTanzuManageV1alpha1WorkspaceFluxcdKustomizationResourceServiceUpdate(kustomizationReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace kustomization entry, name : %s", kustomizationName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
@@ -399,9 +470,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
@@ -424,6 +493,9 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *kustomizationcluster
 	case commonscope.ClusterGroupScope:
 		clusterGroupScopeSpec := spec.ConstructSpecForClusterGroupScope(d)
 		kustomizationSpec = clusterGroupScopeSpec.AtomicSpec
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := spec.ConstructSpecForWorkspaceScope(d)
+		kustomizationSpec = workspaceScopeSpec.AtomicSpec
 	}
 
 	atomicSpec.Source = kustomizationSpec.Source
@@ -14,6 +14,7 @@ import (
 
 	kustomizationclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/cluster"
 	kustomizationclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/clustergroup"
+	kustomizationworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/workspace"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
@@ -22,10 +23,11 @@ type ScopedFullname struct {
 	Scope                commonscope.Scope
 	FullnameCluster      *kustomizationclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdKustomizationFullName
 	FullnameClusterGroup *kustomizationclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdKustomizationFullName
+	FullnameWorkspace    *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationFullName
 }
 
 var (
-	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey, commonscope.WorkspaceKey}
 	ScopeSchema   = commonscope.GetScopeSchema(
 		commonscope.WithDescription(fmt.Sprintf("Scope for the kustomization, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
 		commonscope.WithScopes(ScopesAllowed[:]))
@@ -64,6 +66,15 @@ func ConstructScope(d *schema.ResourceData, name, namespace string) (scopedFulln
 		}
 	}
 
+	if workspaceData, ok := scopeData[commonscope.WorkspaceKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.WorkspaceKey) {
+		if workspaceValue, ok := workspaceData.([]interface{}); ok && len(workspaceValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:             commonscope.WorkspaceScope,
+				FullnameWorkspace: ConstructWorkspaceKustomizationFullname(workspaceValue, name, namespace),
+			}
+		}
+	}
+
 	return scopedFullnameData
 }
 
@@ -87,6 +98,12 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name, nam
 			namespace = scopedFullname.FullnameClusterGroup.NamespaceName
 			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupKustomizationFullname(scopedFullname.FullnameClusterGroup)
 		}
+	case commonscope.WorkspaceScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.WorkspaceKey) {
+			name = scopedFullname.FullnameWorkspace.Name
+			namespace = scopedFullname.FullnameWorkspace.NamespaceName
+			flattenScopeData[commonscope.WorkspaceKey] = FlattenWorkspaceKustomizationFullname(scopedFullname.FullnameWorkspace)
+		}
 	case commonscope.UnknownScope:
 		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
 	}
@@ -12,6 +12,7 @@ import (
 
 	kustomizationclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/cluster"
 	kustomizationclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/clustergroup"
+	kustomizationworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/workspace"
 )
 
 func TestFlattenSpecForClusterScope(t *testing.T) {
@@ -117,3 +118,56 @@ func TestFlattenSpecForClusterGroupScope(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenSpecForWorkspaceScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil workspace kustomization spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace kustomization spec",
+			input: &kustomizationworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceNamespaceFluxcdKustomizationSpec{
+				AtomicSpec: &kustomizationclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdKustomizationSpec{
+					Interval: "5m",
+					Path:     "/",
+					Prune:    true,
+					Source: &kustomizationclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdKustomizationRepositoryReference{
+						Name:      "tmc-cd",
+						Namespace: "default",
+					},
+					TargetNamespace: "default",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					intervalKey: "5m",
+					pathKey:     "/",
+					pruneKey:    true,
+					sourceKey: []interface{}{
+						map[string]interface{}{
+							nameKey:      "tmc-cd",
+							namespaceKey: "default",
+						},
+					},
+					targetNamespaceKey: "default",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecForWorkspaceScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -8,6 +8,8 @@ package managementcluster
 const (
 	ResourceName = "tanzu-mission-control_management_cluster"
 
+	ListDataSourceName = "tanzu-mission-control_management_clusters"
+
 	NameKey  = "name"
 	OrgIDKey = "org_id"
 
@@ -17,6 +19,10 @@ const (
 	registerClusterKubeConfigPathForTKGmKey = "tkgm_kubeconfig_file"
 	registerClusterDescriptionForTKGmKey    = "tkgm_description"
 	registerClusterKubeConfigRawForTKGmKey  = "tkgm_kubeconfig_raw"
+	registerClusterKubeConfigPathForTKGsKey = "supervisor_kubeconfig_file"
+	registerClusterKubeConfigRawForTKGsKey  = "supervisor_kubeconfig_raw"
+	registerClusterTMCNamespaceForTKGsKey   = "supervisor_tmc_namespace"
+	manifestStatusKey                       = "manifest_status"
 	StatusKey                               = "status"
 	waitKey                                 = "ready_wait_timeout"
 	clusterGroupKey                         = "cluster_group"
@@ -25,4 +31,20 @@ const (
 	managedWorkloadClusterImageRegistryKey  = "managed_workload_cluster_image_registry"
 	managementClusterProxyNameKey           = "management_proxy_name"
 	managedWorkloadClusterProxyNameKey      = "managed_workload_cluster_proxy_name"
+	forceKey                                = "force"
+	cleanupKey                              = "cleanup"
+	queryKey                                = "query"
+	managementClustersKey                   = "management_clusters"
+	totalCountKey                           = "total_count"
+	uidKey                                  = "uid"
+	phaseKey                                = "phase"
+	healthKey                               = "health"
+	k8sVersionKey                           = "k8s_version"
+
+	tkgmProviderType = "VMWARE_TANZU_KUBERNETES_GRID"
+	tkgsProviderType = "VMWARE_TANZU_KUBERNETES_GRID_SERVICE"
+
+	manifestStatusApplied = "APPLIED"
+	manifestStatusPending = "PENDING"
+	manifestStatusFailed  = "FAILED"
 )
@@ -77,7 +77,7 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	timeoutData := d.Get(waitKey).(string)
 
-	if helper.IsDataRead(ctx) {
+	if helper.IsDataRead(ctx) || helper.IsRefreshState(ctx) {
 		timeoutData = helper.DoNotRetry
 	}
 
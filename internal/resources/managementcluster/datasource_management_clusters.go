@@ -0,0 +1,173 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	managementclusterregistrationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+)
+
+func DataSourceManagementClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceManagementClustersRead,
+		Schema:      managementClustersSchema,
+		Description: "Tanzu Mission Control Management Clusters Data Source",
+	}
+}
+
+var managementClustersSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management clusters to search for; supports globbing.",
+		Optional:    true,
+		Default:     "*",
+	},
+	OrgIDKey: {
+		Type:        schema.TypeString,
+		Description: "ID of Organization.",
+		Optional:    true,
+	},
+	queryKey: {
+		Type:        schema.TypeString,
+		Description: "TQL query to filter the management clusters.",
+		Optional:    true,
+	},
+	managementClustersKey: {
+		Type:        schema.TypeList,
+		Description: "List of management clusters matching the search criteria.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the management cluster",
+					Computed:    true,
+				},
+				OrgIDKey: {
+					Type:        schema.TypeString,
+					Description: "ID of Organization.",
+					Computed:    true,
+				},
+				uidKey: {
+					Type:        schema.TypeString,
+					Description: "UID of the management cluster",
+					Computed:    true,
+				},
+				clusterGroupKey: {
+					Type:        schema.TypeString,
+					Description: "Cluster group name used by default for workload clusters",
+					Computed:    true,
+				},
+				kubernetesProviderTypeKey: {
+					Type:        schema.TypeString,
+					Description: "Kubernetes provider type",
+					Computed:    true,
+				},
+				phaseKey: {
+					Type:        schema.TypeString,
+					Description: "Phase of the management cluster",
+					Computed:    true,
+				},
+				healthKey: {
+					Type:        schema.TypeString,
+					Description: "Health of the management cluster",
+					Computed:    true,
+				},
+				k8sVersionKey: {
+					Type:        schema.TypeString,
+					Description: "Kubernetes server version of the management cluster",
+					Computed:    true,
+				},
+			},
+		},
+	},
+	totalCountKey: {
+		Type:        schema.TypeInt,
+		Description: "Total count of management clusters matching the search criteria.",
+		Computed:    true,
+	},
+}
+
+func dataSourceManagementClustersRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	request := &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequest{
+		SearchScope: &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterSearchScope{},
+	}
+
+	request.SearchScope.Name, _ = d.Get(NameKey).(string)
+	request.OrgID, _ = d.Get(OrgIDKey).(string)
+	request.Query, _ = d.Get(queryKey).(string)
+
+	managementClusters, err := ListManagementClusters(config, request)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to list Tanzu Mission Control management clusters, name : %s", request.SearchScope.Name))
+	}
+
+	if err := d.Set(managementClustersKey, flattenManagementClusters(managementClusters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(totalCountKey, len(managementClusters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idKeys := []string{request.OrgID, request.SearchScope.Name, request.Query}
+	d.SetId(fmt.Sprintf("management_clusters/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+func flattenManagementClusters(managementClusters []*managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) (data []interface{}) {
+	for _, managementCluster := range managementClusters {
+		if managementCluster == nil {
+			continue
+		}
+
+		flattenData := make(map[string]interface{})
+
+		if managementCluster.FullName != nil {
+			flattenData[NameKey] = managementCluster.FullName.Name
+			flattenData[OrgIDKey] = managementCluster.FullName.OrgID
+		}
+
+		if managementCluster.Meta != nil {
+			flattenData[uidKey] = managementCluster.Meta.UID
+		}
+
+		if spec := managementCluster.Spec; spec != nil {
+			flattenData[clusterGroupKey] = spec.DefaultClusterGroup
+
+			if spec.KubernetesProviderType != nil {
+				flattenData[kubernetesProviderTypeKey] = string(*spec.KubernetesProviderType)
+			}
+		}
+
+		if status := managementCluster.Status; status != nil {
+			if status.Phase != nil {
+				flattenData[phaseKey] = string(*status.Phase)
+			}
+
+			if status.Health != nil {
+				flattenData[healthKey] = string(*status.Health)
+			}
+
+			flattenData[k8sVersionKey] = status.KubeServerVersion
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data
+}
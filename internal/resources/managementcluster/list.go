@@ -0,0 +1,49 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// nolint: dupl
+package managementcluster
+
+import (
+	"strconv"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	managementclusterregistrationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// ListManagementClusters returns all the management clusters matching the search scope and query of the request, across all pages.
+func ListManagementClusters(config authctx.TanzuContext, request *managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequest) ([]*managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster, error) {
+	managementClusters := make([]*managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster, 0)
+
+	pageRequest := *request
+	pageRequest.SortBy = "fullName.name"
+	pageRequest.IncludeTotalCount = true
+
+	err := helper.ListAllPages(helper.DefaultPageSize, func(offset, size int) (int, int, error) {
+		pageRequest.Pagination = &optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{
+			Offset: strconv.Itoa(offset),
+			Size:   strconv.Itoa(size),
+		}
+
+		resp, err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterResourceServiceList(&pageRequest)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return 0, 0, nil
+			}
+
+			return 0, 0, err
+		}
+
+		managementClusters = append(managementClusters, resp.ManagementClusters...)
+		totalCount, _ := strconv.Atoi(resp.TotalCount)
+
+		return len(resp.ManagementClusters), totalCount, nil
+	})
+
+	return managementClusters, err
+}
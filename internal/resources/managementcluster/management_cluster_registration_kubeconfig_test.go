@@ -0,0 +1,157 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestValidateKubeConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       interface{}
+		expectErr   bool
+	}{
+		{
+			description: "check for nil registration data",
+			expectErr:   true,
+		},
+		{
+			description: "check for empty kubeconfig values",
+			input: []interface{}{
+				map[string]interface{}{
+					registerClusterKubeConfigPathForTKGmKey: "",
+					registerClusterKubeConfigRawForTKGsKey:  "",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			description: "TKGm kubeconfig file path",
+			input: []interface{}{
+				map[string]interface{}{
+					registerClusterKubeConfigPathForTKGmKey: "/path/to/kubeconfig",
+				},
+			},
+		},
+		{
+			description: "supervisor raw kubeconfig",
+			input: []interface{}{
+				map[string]interface{}{
+					registerClusterKubeConfigRawForTKGsKey: "raw-kubeconfig",
+					registerClusterTMCNamespaceForTKGsKey:  "svc-tmc-c1",
+				},
+			},
+		},
+		{
+			description: "both TKGm and supervisor kubeconfig provided",
+			input: []interface{}{
+				map[string]interface{}{
+					registerClusterKubeConfigPathForTKGmKey: "/path/to/kubeconfig",
+					registerClusterKubeConfigPathForTKGsKey: "/path/to/supervisor/kubeconfig",
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateKubeConfig(test.input)
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetAgentInstallSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description      string
+		operation        string
+		registrationLink string
+		expected         map[string]interface{}
+	}{
+		{
+			description:      "install operation with registration link",
+			operation:        agentInstallOperationInstall,
+			registrationLink: "https://org.tmc.cloud.vmware.com/installer?id=abc",
+			expected: map[string]interface{}{
+				"operation":        agentInstallOperationInstall,
+				"registrationLink": "https://org.tmc.cloud.vmware.com/installer?id=abc",
+			},
+		},
+		{
+			description: "uninstall operation without registration link",
+			operation:   agentInstallOperationUninstall,
+			expected: map[string]interface{}{
+				"operation": agentInstallOperationUninstall,
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			agentInstall := &unstructured.Unstructured{Object: map[string]interface{}{}}
+
+			require.NoError(t, setAgentInstallSpec(agentInstall, test.operation, test.registrationLink))
+
+			actual, _, err := unstructured.NestedMap(agentInstall.Object, "spec")
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestManifestStatusFromPhase(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		phase       string
+		expected    string
+	}{
+		{
+			description: "ready management cluster",
+			phase:       "READY",
+			expected:    manifestStatusApplied,
+		},
+		{
+			description: "management cluster in error",
+			phase:       "ERROR",
+			expected:    manifestStatusFailed,
+		},
+		{
+			description: "pending management cluster",
+			phase:       "PENDING",
+			expected:    manifestStatusPending,
+		},
+		{
+			description: "unknown phase",
+			expected:    manifestStatusPending,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, manifestStatusFromPhase(test.phase))
+		})
+	}
+}
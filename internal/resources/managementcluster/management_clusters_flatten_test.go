@@ -0,0 +1,88 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	managementclusterregistrationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestFlattenManagementClusters(t *testing.T) {
+	t.Parallel()
+
+	health := clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY
+
+	cases := []struct {
+		description string
+		input       []*managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil data in management cluster list",
+		},
+		{
+			description: "check for nil management cluster entry in the list",
+			input:       []*managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster{nil},
+		},
+		{
+			description: "normal scenario with management cluster list",
+			input: []*managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster{
+				{
+					FullName: &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterFullName{
+						Name:  "mc-1",
+						OrgID: "org-id",
+					},
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+						UID: "mc:01",
+					},
+					Spec: &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterSpec{
+						DefaultClusterGroup:    "default",
+						KubernetesProviderType: clustermodel.NewVmwareTanzuManageV1alpha1CommonClusterKubernetesProviderType("VMWARE_TANZU_KUBERNETES_GRID"),
+					},
+					Status: &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterStatus{
+						Phase:             managementclusterregistrationmodel.NewVmwareTanzuManageV1alpha1ManagementclusterPhase(managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterPhaseREADY),
+						Health:            &health,
+						KubeServerVersion: "v1.26.5+vmware.2",
+					},
+				},
+				{
+					FullName: &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterFullName{
+						Name: "mc-2",
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NameKey:                   "mc-1",
+					OrgIDKey:                  "org-id",
+					uidKey:                    "mc:01",
+					clusterGroupKey:           "default",
+					kubernetesProviderTypeKey: "VMWARE_TANZU_KUBERNETES_GRID",
+					phaseKey:                  "READY",
+					healthKey:                 "HEALTHY",
+					k8sVersionKey:             "v1.26.5+vmware.2",
+				},
+				map[string]interface{}{
+					NameKey:  "mc-2",
+					OrgIDKey: "",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenManagementClusters(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
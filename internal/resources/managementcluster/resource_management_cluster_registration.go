@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,19 +30,17 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
-type (
-	contextMethodKey struct{}
-)
-
 func ResourceManagementClusterRegistration() *schema.Resource {
 	return &schema.Resource{
-		Schema: managementClusterRegistrationSchema,
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			return dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.DataRead), d, m)
-		},
+		Schema:        managementClusterRegistrationSchema,
+		ReadContext:   resourceClusterRead,
 		CreateContext: resourceClusterCreate,
 		UpdateContext: resourceClusterInPlaceUpdate,
 		DeleteContext: resourceClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterImporter,
+		},
+		CustomizeDiff: validateDeleteOptions,
 		Description:   "Tanzu Mission Control Management Cluster Registration Resource",
 	}
 }
@@ -77,7 +76,7 @@ var managementClusterRegistrationSchema = map[string]*schema.Schema{
 					Description: "Kubernetes provider type",
 					Required:    true,
 					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
-						"VMWARE_TANZU_KUBERNETES_GRID_SERVICE", "VMWARE_TANZU_KUBERNETES_GRID",
+						tkgsProviderType, tkgmProviderType,
 					}, false)),
 				},
 				imageRegistryKey: {
@@ -129,6 +128,26 @@ var managementClusterRegistrationSchema = map[string]*schema.Schema{
 					Optional:     true,
 					ValidateFunc: validation.StringIsNotWhiteSpace,
 				},
+				registerClusterKubeConfigPathForTKGsKey: {
+					Type:        schema.TypeString,
+					Description: "Register management cluster KUBECONFIG path for only vSphere with Tanzu Supervisor",
+					ForceNew:    true,
+					Optional:    true,
+				},
+				registerClusterKubeConfigRawForTKGsKey: {
+					Type:        schema.TypeString,
+					Description: "Register management cluster KUBECONFIG for only vSphere with Tanzu Supervisor",
+					Optional:    true,
+					ForceNew:    true,
+					Sensitive:   true,
+				},
+				registerClusterTMCNamespaceForTKGsKey: {
+					Type:        schema.TypeString,
+					Description: "Tanzu Mission Control namespace on the vSphere with Tanzu Supervisor, discovered by the svc-tmc- prefix when not provided",
+					Optional:    true,
+					Computed:    true,
+					ForceNew:    true,
+				},
 			},
 		},
 	},
@@ -144,6 +163,23 @@ var managementClusterRegistrationSchema = map[string]*schema.Schema{
 		Default:     defaultWaitTimeout.String(),
 		Optional:    true,
 	},
+	manifestStatusKey: {
+		Type:        schema.TypeString,
+		Description: "Status of the registration manifest applied on the management cluster with the provided kubeconfig, refreshed on every read: the status of the AgentInstall object for a Supervisor cluster, otherwise derived from the phase of the management cluster",
+		Computed:    true,
+	},
+	forceKey: {
+		Type:        schema.TypeBool,
+		Description: "Force the deregistration of the management cluster on delete, even if it is not reachable by Tanzu Mission Control.",
+		Default:     false,
+		Optional:    true,
+	},
+	cleanupKey: {
+		Type:        schema.TypeBool,
+		Description: "Remove the Tanzu Mission Control agent from the management cluster on delete, using the kubeconfig provided in register_management_cluster.",
+		Default:     false,
+		Optional:    true,
+	},
 }
 
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -162,77 +198,185 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	d.SetId(createResponse.ManagementCluster.Meta.UID)
 
-	if v, ok := d.GetOk(registerClusterKey); ok {
-		var (
-			kubeClient *k8sClient.Client
-			err        error
-			kubeConfig interface{}
-			manifests  string
-		)
-
-		err = validateKubeConfig(v)
+	if _, ok := d.GetOk(registerClusterKey); ok {
+		kubeClient, err := getK8sClientFromRegistration(d)
 		if err != nil {
+			log.Println("[ERROR] error while creating kubernetes client: ", err.Error())
 			return diag.FromErr(err)
 		}
 
-		spec := constructSpec(d)
-		if *spec.KubernetesProviderType != "VMWARE_TANZU_KUBERNETES_GRID" {
-			return diag.Errorf("kubernetes_provider_type must have value VMWARE_TANZU_KUBERNETES_GRID so registration with kubeconfig would be possible")
+		if isSupervisorRegistration(d) {
+			diags = append(diags, applySupervisorRegistration(kubeClient, d, createResponse.ManagementCluster)...)
+		} else {
+			diags = append(diags, applyTKGmRegistration(config, kubeClient, d, createResponse.ManagementCluster)...)
 		}
 
-		isKubeConfigPresent := func(typeKey string) bool {
-			if value, ok := d.GetOk(helper.GetFirstElementOf(registerClusterKey, typeKey)); ok {
-				if value != nil {
-					kubeConfig = value
-					return true
-				}
-			}
-
-			return false
+		if diags.HasError() {
+			return diags
 		}
+	}
 
-		switch {
-		case isKubeConfigPresent(registerClusterKubeConfigPathForTKGmKey):
-			kubeConfigFile, _ := kubeConfig.(string)
-			kubeClient, err = getK8sClientFromFilePath(kubeConfigFile)
+	diags = append(diags, dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.CreateState), d, m)...)
 
-		case isKubeConfigPresent(registerClusterKubeConfigRawForTKGmKey):
-			rawKubeConfig, _ := kubeConfig.(string)
-			kubeClient, err = getK8sClientFromRawInput(rawKubeConfig)
-		}
+	if diags.HasError() {
+		return diags
+	}
 
-		if err != nil {
-			log.Println("[ERROR] error while creating kubernetes client: ", err.Error())
+	return append(diags, readManifestStatus(d)...)
+}
+
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	return append(diags, readManifestStatus(d)...)
+}
+
+// readManifestStatus refreshes the status of the registration manifest applied with the kubeconfig of register_management_cluster.
+// For a Supervisor cluster it is the status of the AgentInstall object, otherwise it is derived from the phase of the management cluster
+// reported by Tanzu Mission Control, as the manifest (VmwareTanzuManageV1alpha1ManagementclusterManagementClusterGetManifestResponse) carries no status.
+func readManifestStatus(d *schema.ResourceData) (diags diag.Diagnostics) {
+	if _, ok := d.GetOk(registerClusterKey); !ok {
+		return diags
+	}
+
+	if !isSupervisorRegistration(d) {
+		status, _ := d.Get(StatusKey).(map[string]interface{})
+		phase, _ := status[phaseKey].(string)
+
+		if err := d.Set(manifestStatusKey, manifestStatusFromPhase(phase)); err != nil {
 			return diag.FromErr(err)
 		}
 
-		if createResponse.ManagementCluster.Spec.ImageRegistry != "" || createResponse.ManagementCluster.Spec.ProxyName != "" {
-			clusterManifest, err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterManifestHelperGetManifest(constructFullname(d))
-			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to get manifest (%s), err : %s", clusterManifest.Manifest, err))
-			}
+		return diags
+	}
 
-			manifests = clusterManifest.Manifest
-		} else {
-			deploymentManifest, err := manifest.GetK8sManifest(createResponse.ManagementCluster.Status.RegistrationURL)
-			if err != nil {
-				return append(diags, diag.FromErr(err)...)
-			}
+	agentInstallStatus, err := getSupervisorAgentInstallStatus(d)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to refresh the registration manifest status",
+			Detail:   err.Error(),
+		})
+	}
 
-			manifests = string(deploymentManifest)
-		}
+	if err := d.Set(manifestStatusKey, agentInstallStatus); err != nil {
+		return diag.FromErr(err)
+	}
 
-		log.Printf("[INFO] Applying %s manifest objects on to kubernetes cluster", constructFullname(d).ToString())
+	return diags
+}
 
-		err = manifest.Create(kubeClient, manifests, true)
-		if err != nil {
-			return append(diags, diag.FromErr(err)...)
-		}
+func getSupervisorAgentInstallStatus(d *schema.ResourceData) (string, error) {
+	kubeClient, err := getK8sClientFromRegistration(d)
+	if err != nil {
+		return "", err
+	}
+
+	namespace, err := getRegistrationTMCNamespace(kubeClient, d)
+	if err != nil {
+		return "", err
+	}
+
+	return getAgentInstallStatus(kubeClient, namespace)
+}
+
+// manifestStatusFromPhase maps the phase of the management cluster to the status of the registration manifest.
+func manifestStatusFromPhase(phase string) string {
+	switch managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterPhase(strings.ToUpper(phase)) {
+	case managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterPhaseREADY:
+		return manifestStatusApplied
+	case managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterPhaseERROR:
+		return manifestStatusFailed
+	default:
+		return manifestStatusPending
+	}
+}
+
+func applyTKGmRegistration(config authctx.TanzuContext, kubeClient *k8sClient.Client, d *schema.ResourceData, managementCluster *managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) diag.Diagnostics {
+	spec := constructSpec(d)
+	if *spec.KubernetesProviderType != tkgmProviderType {
+		return diag.Errorf("kubernetes_provider_type must have value %s so registration with %s or %s would be possible",
+			tkgmProviderType, registerClusterKubeConfigPathForTKGmKey, registerClusterKubeConfigRawForTKGmKey)
+	}
+
+	manifests, err := getRegistrationManifest(config, d, managementCluster)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Applying %s manifest objects on to kubernetes cluster", constructFullname(d).ToString())
+
+	err = manifest.Create(kubeClient, manifests, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Cluster registered successfully. Tanzu Mission Control resources(%s) applied successfully", constructFullname(d).ToString())
+
+	return nil
+}
+
+func applySupervisorRegistration(kubeClient *k8sClient.Client, d *schema.ResourceData, managementCluster *managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) diag.Diagnostics {
+	spec := constructSpec(d)
+	if *spec.KubernetesProviderType != tkgsProviderType {
+		return diag.Errorf("kubernetes_provider_type must have value %s so registration with %s or %s would be possible",
+			tkgsProviderType, registerClusterKubeConfigPathForTKGsKey, registerClusterKubeConfigRawForTKGsKey)
+	}
 
-		log.Printf("[INFO] Cluster registered successfully. Tanzu Mission Control resources(%s) applied successfully", constructFullname(d).ToString())
+	if managementCluster.Status == nil || managementCluster.Status.RegistrationURL == "" {
+		return diag.Errorf("registration URL not found for management cluster, name : %s", d.Get(NameKey))
 	}
 
-	return append(diags, dataSourceClusterRead(context.WithValue(ctx, contextMethodKey{}, helper.CreateState), d, m)...)
+	namespace, err := getRegistrationTMCNamespace(kubeClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating %s object in %s namespace of the supervisor cluster", agentInstallGVK.Kind, namespace)
+
+	err = applyAgentInstall(kubeClient, namespace, agentInstallOperationInstall, managementCluster.Status.RegistrationURL)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Cluster registration initiated successfully. %s object created for %s", agentInstallGVK.Kind, constructFullname(d).ToString())
+
+	return nil
+}
+
+// getRegistrationTMCNamespace returns the Tanzu Mission Control namespace of the supervisor cluster and records it in the state.
+func getRegistrationTMCNamespace(kubeClient *k8sClient.Client, d *schema.ResourceData) (string, error) {
+	namespace, _ := d.Get(helper.GetFirstElementOf(registerClusterKey, registerClusterTMCNamespaceForTKGsKey)).(string)
+	if namespace != "" {
+		return namespace, nil
+	}
+
+	namespace, err := getSupervisorTMCNamespace(kubeClient)
+	if err != nil {
+		return "", err
+	}
+
+	registrationData := d.Get(registerClusterKey).([]interface{})[0].(map[string]interface{})
+	registrationData[registerClusterTMCNamespaceForTKGsKey] = namespace
+
+	if err := d.Set(registerClusterKey, []interface{}{registrationData}); err != nil {
+		return "", err
+	}
+
+	return namespace, nil
+}
+
+func isSupervisorRegistration(d *schema.ResourceData) bool {
+	for _, key := range []string{registerClusterKubeConfigPathForTKGsKey, registerClusterKubeConfigRawForTKGsKey} {
+		if _, ok := d.GetOk(helper.GetFirstElementOf(registerClusterKey, key)); ok {
+			return true
+		}
+	}
+
+	return false
 }
 
 func createRegistrationResource(config authctx.TanzuContext, d *schema.ResourceData) (*managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterCreateManagementClusterResponse, error) {
@@ -260,6 +404,57 @@ func createRegistrationResource(config authctx.TanzuContext, d *schema.ResourceD
 	return createResponse, createError
 }
 
+// getRegistrationManifest returns the registration manifest to be applied on the management cluster.
+func getRegistrationManifest(config authctx.TanzuContext, d *schema.ResourceData, managementCluster *managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) (string, error) {
+	if managementCluster.Spec.ImageRegistry != "" || managementCluster.Spec.ProxyName != "" {
+		clusterManifest, err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterManifestHelperGetManifest(constructFullname(d))
+		if err != nil {
+			return "", errors.Wrapf(err, "Unable to get manifest for management cluster, name : %s", d.Get(NameKey))
+		}
+
+		return clusterManifest.Manifest, nil
+	}
+
+	if managementCluster.Status == nil || managementCluster.Status.RegistrationURL == "" {
+		return "", errors.Errorf("registration URL not found for management cluster, name : %s", d.Get(NameKey))
+	}
+
+	deploymentManifest, err := manifest.GetK8sManifest(managementCluster.Status.RegistrationURL)
+	if err != nil {
+		return "", err
+	}
+
+	return string(deploymentManifest), nil
+}
+
+func getK8sClientFromRegistration(d *schema.ResourceData) (*k8sClient.Client, error) {
+	v, ok := d.GetOk(registerClusterKey)
+	if !ok {
+		return nil, fmt.Errorf("%v is not set: a kubeconfig is required to access the management cluster", registerClusterKey)
+	}
+
+	err := validateKubeConfig(v)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{registerClusterKubeConfigPathForTKGmKey, registerClusterKubeConfigPathForTKGsKey} {
+		if value, ok := d.GetOk(helper.GetFirstElementOf(registerClusterKey, key)); ok {
+			kubeConfigFile, _ := value.(string)
+			return getK8sClientFromFilePath(kubeConfigFile)
+		}
+	}
+
+	for _, key := range []string{registerClusterKubeConfigRawForTKGmKey, registerClusterKubeConfigRawForTKGsKey} {
+		if value, ok := d.GetOk(helper.GetFirstElementOf(registerClusterKey, key)); ok {
+			rawKubeConfig, _ := value.(string)
+			return getK8sClientFromRawInput(rawKubeConfig)
+		}
+	}
+
+	return nil, fmt.Errorf("no valid kube config type found: minimum one valid kube config type is required")
+}
+
 func validateKubeConfig(value interface{}) error {
 	data, _ := value.([]interface{})
 
@@ -271,15 +466,18 @@ func validateKubeConfig(value interface{}) error {
 
 	kubeConfigTypeFound := make([]string, 0)
 
-	if v, ok := kubeConfigData[registerClusterKubeConfigPathForTKGmKey]; ok {
-		if v1, ok := v.(string); ok && len(v1) != 0 {
-			kubeConfigTypeFound = append(kubeConfigTypeFound, registerClusterKubeConfigPathForTKGmKey)
-		}
+	kubeConfigKeys := []string{
+		registerClusterKubeConfigPathForTKGmKey,
+		registerClusterKubeConfigRawForTKGmKey,
+		registerClusterKubeConfigPathForTKGsKey,
+		registerClusterKubeConfigRawForTKGsKey,
 	}
 
-	if v, ok := kubeConfigData[registerClusterKubeConfigRawForTKGmKey]; ok {
-		if v1, ok := v.(string); ok && len(v1) != 0 {
-			kubeConfigTypeFound = append(kubeConfigTypeFound, registerClusterKubeConfigRawForTKGmKey)
+	for _, key := range kubeConfigKeys {
+		if v, ok := kubeConfigData[key]; ok {
+			if v1, ok := v.(string); ok && len(v1) != 0 {
+				kubeConfigTypeFound = append(kubeConfigTypeFound, key)
+			}
 		}
 	}
 
@@ -344,10 +542,29 @@ func getK8sClient(filePath string, rawConfiguration string) (*k8sClient.Client,
 func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	config := m.(authctx.TanzuContext)
 
+	if !d.HasChanges(specKey, common.MetaKey) {
+		return dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.UpdateState), d, m)
+	}
+
+	getResp, err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterResourceServiceGet(constructFullname(d))
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Management cluster registration, name : %s", d.Get(NameKey)))
+	}
+
+	if err := common.CheckResourceVersion(d, getResp.ManagementCluster.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Management cluster registration, name : %s", d.Get(NameKey)))
+	}
+
+	meta := common.ConstructMeta(d)
+
+	if getResp.ManagementCluster.Meta != nil {
+		meta.Labels = common.WithSystemManagedKeys(meta.Labels, getResp.ManagementCluster.Meta.Labels)
+	}
+
 	registrationRequest := &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterCreateManagementClusterRequest{
 		ManagementCluster: &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster{
 			FullName: constructFullname(d),
-			Meta:     common.ConstructMeta(d),
+			Meta:     meta,
 			Spec:     constructSpec(d),
 		},
 	}
@@ -360,22 +577,129 @@ func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(registrationResponse.ManagementCluster.Meta.UID)
 
-	return dataSourceClusterRead(ctx, d, m)
+	return dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.UpdateState), d, m)
 }
 
 func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(authctx.TanzuContext)
 
-	err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterResourceServiceDelete(constructFullname(d), "false")
+	var (
+		diags      diag.Diagnostics
+		kubeClient *k8sClient.Client
+		manifests  string
+	)
+
+	cleanup, _ := d.Get(cleanupKey).(bool)
+	force, _ := d.Get(forceKey).(bool)
+
+	// the registration manifest has to be fetched before the registration entry is removed from Tanzu Mission Control.
+	if cleanup {
+		resp, err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterResourceServiceGet(constructFullname(d))
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			return diag.FromErr(errors.Wrapf(err, "Unable to get management cluster registration entry, name : %s", d.Get(NameKey)))
+		}
+
+		if err == nil && resp.ManagementCluster != nil {
+			kubeClient, err = getK8sClientFromRegistration(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if !isSupervisorRegistration(d) {
+				manifests, err = getRegistrationManifest(config, d, resp.ManagementCluster)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterResourceServiceDelete(constructFullname(d), strconv.FormatBool(force))
 	if err != nil && !clienterrors.IsNotFoundError(err) {
 		return diag.FromErr(errors.Wrapf(err, "Unable to delete managamement cluster registration entry, name : %s", d.Get(NameKey)))
 	}
 
-	var diags diag.Diagnostics
+	var cleanupErr error
+
+	switch {
+	case kubeClient != nil && isSupervisorRegistration(d):
+		log.Printf("[INFO] Uninstalling Tanzu Mission Control agent of %s from the supervisor cluster", constructFullname(d).ToString())
+
+		cleanupErr = uninstallSupervisorAgent(kubeClient, d)
+	case kubeClient != nil && manifests != "":
+		log.Printf("[INFO] Removing %s manifest objects from kubernetes cluster", constructFullname(d).ToString())
+
+		cleanupErr = manifest.Delete(kubeClient, manifests)
+	}
+
+	if cleanupErr != nil {
+		return append(diags, diag.FromErr(errors.Wrapf(cleanupErr, "Management cluster registration entry deleted, but Tanzu Mission Control agent clean up failed, name : %s", d.Get(NameKey)))...)
+	}
+
+	_ = schema.RemoveFromState(d, m)
 
 	return diags
 }
 
+func uninstallSupervisorAgent(kubeClient *k8sClient.Client, d *schema.ResourceData) error {
+	namespace, err := getRegistrationTMCNamespace(kubeClient, d)
+	if err != nil {
+		return err
+	}
+
+	return applyAgentInstall(kubeClient, namespace, agentInstallOperationUninstall, "")
+}
+
+func resourceClusterImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	config, ok := m.(authctx.TanzuContext)
+	if !ok {
+		return nil, errors.New("error while retrieving Tanzu auth config")
+	}
+
+	name := d.Id()
+	if name == "" {
+		return nil, errors.New("management cluster name is needed to import a management cluster registration")
+	}
+
+	fullName := &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterFullName{Name: name}
+
+	resp, err := config.TMCConnection.ManagementClusterRegistrationResourceService.ManagementClusterResourceServiceGet(fullName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to import management cluster registration entry, name : %s", name)
+	}
+
+	if resp.ManagementCluster == nil {
+		return nil, errors.Errorf("Unable to import management cluster registration entry, name : %s: management cluster not found", name)
+	}
+
+	if err = d.Set(NameKey, resp.ManagementCluster.FullName.Name); err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(waitKey, defaultWaitTimeout.String()); err != nil {
+		return nil, err
+	}
+
+	diags := dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m)
+	if diags.HasError() {
+		return nil, errors.Errorf("Unable to import management cluster registration entry, name : %s", name)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func validateDeleteOptions(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if cleanup, _ := d.Get(cleanupKey).(bool); !cleanup {
+		return nil
+	}
+
+	if _, ok := d.GetOk(registerClusterKey); !ok {
+		return fmt.Errorf("%v requires %v with a kubeconfig of the management cluster", cleanupKey, registerClusterKey)
+	}
+
+	return nil
+}
+
 func constructFullname(d *schema.ResourceData) (fullname *managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterFullName) {
 	fullname = &managementclusterregistrationmodel.VmwareTanzuManageV1alpha1ManagementclusterFullName{}
 
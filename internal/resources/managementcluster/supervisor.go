@@ -0,0 +1,121 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	supervisorTMCNamespacePrefix = "svc-tmc-"
+	agentInstallName             = "tmc-agent-installer-config"
+
+	agentInstallOperationInstall   = "INSTALL"
+	agentInstallOperationUninstall = "UNINSTALL"
+)
+
+var (
+	agentInstallGVK = runtimeSchema.GroupVersionKind{
+		Group:   "installers.tmc.cloud.vmware.com",
+		Version: "v1alpha1",
+		Kind:    "AgentInstall",
+	}
+
+	namespaceListGVK = runtimeSchema.GroupVersionKind{
+		Group:   "",
+		Version: "v1",
+		Kind:    "NamespaceList",
+	}
+)
+
+// getSupervisorTMCNamespace returns the namespace reserved for the Tanzu Mission Control service on the Supervisor cluster.
+func getSupervisorTMCNamespace(kubeClient *k8sClient.Client) (string, error) {
+	namespaces := &unstructured.UnstructuredList{}
+	namespaces.SetGroupVersionKind(namespaceListGVK)
+
+	err := (*kubeClient).List(context.Background(), namespaces)
+	if err != nil {
+		return "", fmt.Errorf("failed to list namespaces of the supervisor cluster, error :%v", err)
+	}
+
+	for _, namespace := range namespaces.Items {
+		if strings.HasPrefix(namespace.GetName(), supervisorTMCNamespacePrefix) {
+			return namespace.GetName(), nil
+		}
+	}
+
+	return "", fmt.Errorf("no namespace with prefix %s found on the supervisor cluster: "+
+		"make sure the Tanzu Mission Control service is enabled on the supervisor cluster", supervisorTMCNamespacePrefix)
+}
+
+// applyAgentInstall creates the AgentInstall object in the given namespace, or updates it when it already exists.
+func applyAgentInstall(kubeClient *k8sClient.Client, namespace, operation, registrationLink string) error {
+	agentInstall := &unstructured.Unstructured{}
+	agentInstall.SetGroupVersionKind(agentInstallGVK)
+
+	err := (*kubeClient).Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: agentInstallName}, agentInstall)
+
+	switch {
+	case k8serrors.IsNotFound(err):
+		agentInstall = &unstructured.Unstructured{}
+		agentInstall.SetGroupVersionKind(agentInstallGVK)
+		agentInstall.SetNamespace(namespace)
+		agentInstall.SetName(agentInstallName)
+
+		if err := setAgentInstallSpec(agentInstall, operation, registrationLink); err != nil {
+			return err
+		}
+
+		err = (*kubeClient).Create(context.Background(), agentInstall)
+	case err == nil:
+		if err := setAgentInstallSpec(agentInstall, operation, registrationLink); err != nil {
+			return err
+		}
+
+		err = (*kubeClient).Update(context.Background(), agentInstall)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to apply %s object %s/%s, error :%v", agentInstallGVK.Kind, namespace, agentInstallName, err)
+	}
+
+	return nil
+}
+
+func setAgentInstallSpec(agentInstall *unstructured.Unstructured, operation, registrationLink string) error {
+	if err := unstructured.SetNestedField(agentInstall.Object, operation, "spec", "operation"); err != nil {
+		return err
+	}
+
+	if registrationLink == "" {
+		return nil
+	}
+
+	return unstructured.SetNestedField(agentInstall.Object, registrationLink, "spec", "registrationLink")
+}
+
+// getAgentInstallStatus returns the status reported by the AgentInstall object.
+func getAgentInstallStatus(kubeClient *k8sClient.Client, namespace string) (string, error) {
+	agentInstall := &unstructured.Unstructured{}
+	agentInstall.SetGroupVersionKind(agentInstallGVK)
+
+	err := (*kubeClient).Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: agentInstallName}, agentInstall)
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s object %s/%s, error :%v", agentInstallGVK.Kind, namespace, agentInstallName, err)
+	}
+
+	status, _, err := unstructured.NestedString(agentInstall.Object, "status", "status")
+
+	return status, err
+}
@@ -16,5 +16,15 @@ const (
 	workspaceNameKey          = "workspace_name"
 	workspaceNameDefaultValue = "default"
 	attachKey                 = "attach"
+	adoptedKey                = "adopted"
 	ResourceName              = "tanzu-mission-control_namespace"
+	ListDataSourceName        = "tanzu-mission-control_namespaces"
+	queryKey                  = "query"
+	labelsKey                 = "labels"
+	includeUnmanagedKey       = "include_unmanaged"
+	namespacesKey             = "namespaces"
+	totalCountKey             = "total_count"
+	uidKey                    = "uid"
+	managedKey                = "managed"
+	phaseKey                  = "phase"
 )
@@ -0,0 +1,263 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func DataSourceNamespaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNamespacesRead,
+		Schema:      namespacesSchema,
+		Description: "Tanzu Mission Control Namespaces Data Source",
+	}
+}
+
+var namespacesSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the namespaces to search for; supports globbing.",
+		Optional:    true,
+		Default:     "*",
+	},
+	ClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster of the namespaces to search for; supports globbing.",
+		Optional:    true,
+		Default:     "*",
+	},
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster of the namespaces to search for; supports globbing.",
+		Optional:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner of the namespaces to search for; supports globbing.",
+		Optional:    true,
+	},
+	workspaceNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the workspace of the namespaces to search for; supports globbing. Unmanaged namespaces do not belong to any workspace.",
+		Optional:    true,
+	},
+	queryKey: {
+		Type:        schema.TypeString,
+		Description: "TQL query to filter the namespaces.",
+		Optional:    true,
+	},
+	labelsKey: {
+		Type:        schema.TypeMap,
+		Description: "Only list the namespaces carrying all of these labels.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	includeUnmanagedKey: {
+		Type:        schema.TypeBool,
+		Description: "List the unmanaged namespaces too, which are not part of any workspace.",
+		Optional:    true,
+		Default:     false,
+	},
+	namespacesKey: {
+		Type:        schema.TypeList,
+		Description: "List of namespaces matching the search criteria, sorted by full name.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the namespace",
+					Computed:    true,
+				},
+				ClusterNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the cluster",
+					Computed:    true,
+				},
+				ManagementClusterNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the management cluster",
+					Computed:    true,
+				},
+				ProvisionerNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the provisioner",
+					Computed:    true,
+				},
+				workspaceNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the workspace, empty for unmanaged namespaces",
+					Computed:    true,
+				},
+				managedKey: {
+					Type:        schema.TypeBool,
+					Description: "Flag representing whether the namespace is part of a workspace",
+					Computed:    true,
+				},
+				uidKey: {
+					Type:        schema.TypeString,
+					Description: "UID of the namespace",
+					Computed:    true,
+				},
+				labelsKey: {
+					Type:        schema.TypeMap,
+					Description: "Labels of the namespace",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				phaseKey: {
+					Type:        schema.TypeString,
+					Description: "Phase of the namespace",
+					Computed:    true,
+				},
+			},
+		},
+	},
+	totalCountKey: {
+		Type:        schema.TypeInt,
+		Description: "Total count of namespaces matching the search criteria.",
+		Computed:    true,
+	},
+}
+
+func dataSourceNamespacesRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	request := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest{
+		SearchScope: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope{},
+	}
+
+	request.SearchScope.Name, _ = d.Get(NameKey).(string)
+	request.SearchScope.ClusterName, _ = d.Get(ClusterNameKey).(string)
+	request.SearchScope.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+	request.SearchScope.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+	request.SearchScope.WorkspaceName, _ = d.Get(workspaceNameKey).(string)
+	request.Query, _ = d.Get(queryKey).(string)
+
+	labelsData, _ := d.Get(labelsKey).(map[string]interface{})
+	labels := common.GetTypeStringMapData(labelsData)
+	includeUnmanaged, _ := d.Get(includeUnmanagedKey).(bool)
+
+	namespaces, err := ListNamespaces(config, request)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to list Tanzu Mission Control namespaces, cluster : %s", request.SearchScope.ClusterName))
+	}
+
+	namespaces = filterNamespaces(namespaces, labels, includeUnmanaged)
+
+	if err := d.Set(namespacesKey, flattenNamespaces(namespaces)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(totalCountKey, len(namespaces)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idKeys := []string{
+		request.SearchScope.Name,
+		request.SearchScope.ClusterName,
+		request.SearchScope.ManagementClusterName,
+		request.SearchScope.ProvisionerName,
+		request.SearchScope.WorkspaceName,
+		request.Query,
+		helper.LabelSelectorString(labels),
+		fmt.Sprintf("%t", includeUnmanaged),
+	}
+	d.SetId(fmt.Sprintf("namespaces/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+// isManaged reports whether the namespace is part of a workspace.
+func isManaged(namespace *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace) bool {
+	return namespace.Spec != nil && namespace.Spec.WorkspaceName != ""
+}
+
+// filterNamespaces returns the namespaces carrying all the labels, skipping unmanaged namespaces unless requested, sorted by full name.
+func filterNamespaces(namespaces []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace, labels map[string]string, includeUnmanaged bool) []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace {
+	filtered := make([]*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace, 0, len(namespaces))
+
+	for _, namespace := range namespaces {
+		if namespace == nil || namespace.FullName == nil {
+			continue
+		}
+
+		if !includeUnmanaged && !isManaged(namespace) {
+			continue
+		}
+
+		var namespaceLabels map[string]string
+
+		if namespace.Meta != nil {
+			namespaceLabels = namespace.Meta.Labels
+		}
+
+		if !helper.MatchLabels(namespaceLabels, labels) {
+			continue
+		}
+
+		filtered = append(filtered, namespace)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return namespaceFullNameString(filtered[i].FullName) < namespaceFullNameString(filtered[j].FullName)
+	})
+
+	return filtered
+}
+
+func namespaceFullNameString(fn *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName) string {
+	return strings.Join([]string{fn.ManagementClusterName, fn.ProvisionerName, fn.ClusterName, fn.Name}, "/")
+}
+
+func flattenNamespaces(namespaces []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace) (data []interface{}) {
+	for _, namespace := range namespaces {
+		if namespace == nil {
+			continue
+		}
+
+		flattenData := make(map[string]interface{})
+
+		if namespace.FullName != nil {
+			flattenData[NameKey] = namespace.FullName.Name
+			flattenData[ClusterNameKey] = namespace.FullName.ClusterName
+			flattenData[ManagementClusterNameKey] = namespace.FullName.ManagementClusterName
+			flattenData[ProvisionerNameKey] = namespace.FullName.ProvisionerName
+		}
+
+		flattenData[managedKey] = isManaged(namespace)
+
+		if namespace.Spec != nil {
+			flattenData[workspaceNameKey] = namespace.Spec.WorkspaceName
+		}
+
+		if namespace.Meta != nil {
+			flattenData[uidKey] = namespace.Meta.UID
+			flattenData[labelsKey] = namespace.Meta.Labels
+		}
+
+		if namespace.Status != nil && namespace.Status.Phase != nil {
+			flattenData[phaseKey] = string(*namespace.Status.Phase)
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data
+}
@@ -0,0 +1,49 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// nolint: dupl
+package namespace
+
+import (
+	"strconv"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// ListNamespaces returns all the namespaces matching the search scope and query of the request, across all pages.
+func ListNamespaces(config authctx.TanzuContext, request *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequest) ([]*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace, error) {
+	namespaces := make([]*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace, 0)
+
+	pageRequest := *request
+	pageRequest.SortBy = "fullName.name"
+	pageRequest.IncludeTotalCount = true
+
+	err := helper.ListAllPages(helper.DefaultPageSize, func(offset, size int) (int, int, error) {
+		pageRequest.Pagination = &optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{
+			Offset: strconv.Itoa(offset),
+			Size:   strconv.Itoa(size),
+		}
+
+		resp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceList(&pageRequest)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return 0, 0, nil
+			}
+
+			return 0, 0, err
+		}
+
+		namespaces = append(namespaces, resp.Namespaces...)
+		totalCount, _ := strconv.Atoi(resp.TotalCount)
+
+		return len(resp.Namespaces), totalCount, nil
+	})
+
+	return namespaces, err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package namespace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestFilterNamespaces(t *testing.T) {
+	t.Parallel()
+
+	managed := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+		FullName: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{ClusterName: "c1", Name: "team-b"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"team": "b"}},
+		Spec:     &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "ws-b"},
+	}
+	unmanaged := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+		FullName: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{ClusterName: "c1", Name: "legacy"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"team": "b"}},
+	}
+	other := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+		FullName: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{ClusterName: "c0", Name: "team-a"},
+		Spec:     &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "ws-a"},
+	}
+	namespaces := []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{managed, nil, unmanaged, {}, other}
+
+	cases := []struct {
+		description      string
+		labels           map[string]string
+		includeUnmanaged bool
+		expected         []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace
+	}{
+		{
+			description: "no filter skips unmanaged namespaces",
+			expected:    []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{other, managed},
+		},
+		{
+			description:      "no filter with unmanaged namespaces",
+			includeUnmanaged: true,
+			expected:         []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{other, unmanaged, managed},
+		},
+		{
+			description:      "filter by labels",
+			labels:           map[string]string{"team": "b"},
+			includeUnmanaged: true,
+			expected:         []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{unmanaged, managed},
+		},
+		{
+			description: "filter by labels without match",
+			labels:      map[string]string{"team": "c"},
+			expected:    []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := filterNamespaces(namespaces, test.labels, test.includeUnmanaged)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenNamespaces(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil data in namespace list",
+		},
+		{
+			description: "normal scenario with managed and unmanaged namespaces",
+			input: []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				{
+					FullName: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+						Name:                  "team-a",
+						ClusterName:           "c1",
+						ManagementClusterName: "attached",
+						ProvisionerName:       "attached",
+					},
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+						UID:    "ns:01",
+						Labels: map[string]string{"team": "a"},
+					},
+					Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{
+						WorkspaceName: "ws-a",
+					},
+					Status: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceStatus{
+						Phase: namespacemodel.NewVmwareTanzuManageV1alpha1ClusterNamespaceStatusPhase(namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceStatusPhaseREADY),
+					},
+				},
+				nil,
+				{
+					FullName: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+						Name:        "legacy",
+						ClusterName: "c1",
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NameKey:                  "team-a",
+					ClusterNameKey:           "c1",
+					ManagementClusterNameKey: "attached",
+					ProvisionerNameKey:       "attached",
+					workspaceNameKey:         "ws-a",
+					managedKey:               true,
+					uidKey:                   "ns:01",
+					labelsKey:                map[string]string{"team": "a"},
+					phaseKey:                 "READY",
+				},
+				map[string]interface{}{
+					NameKey:                  "legacy",
+					ClusterNameKey:           "c1",
+					ManagementClusterNameKey: "",
+					ProvisionerNameKey:       "",
+					managedKey:               false,
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenNamespaces(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -25,10 +25,26 @@ func ResourceNamespace() *schema.Resource {
 		ReadContext:   dataSourceNamespaceRead,
 		UpdateContext: resourceNamespaceInPlaceUpdate,
 		DeleteContext: resourceNamespaceDelete,
-		Schema:        namespaceSchema,
+		Schema:        resourceNamespaceSchema(),
 	}
 }
 
+// resourceNamespaceSchema returns the namespace schema with the attributes only known to the resource.
+func resourceNamespaceSchema() map[string]*schema.Schema {
+	resourceSchema := make(map[string]*schema.Schema, len(namespaceSchema)+1)
+
+	for key, value := range namespaceSchema {
+		resourceSchema[key] = value
+	}
+
+	resourceSchema[adoptedKey] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+
+	return resourceSchema
+}
+
 var namespaceSchema = map[string]*schema.Schema{
 	NameKey: {
 		Type:     schema.TypeString,
@@ -141,11 +157,28 @@ func flattenSpec(spec *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceS
 func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	config := m.(authctx.TanzuContext)
 
+	spec := constructSpec(d)
+
+	if spec.Attach {
+		attached, err := attachUnmanagedNamespace(config, d, spec)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if attached {
+			if err := d.Set(adoptedKey, true); err != nil {
+				return diag.FromErr(err)
+			}
+
+			return dataSourceNamespaceRead(ctx, d, m)
+		}
+	}
+
 	namespaceRequest := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceRequest{
 		Namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
 			FullName: constructFullname(d),
 			Meta:     common.ConstructMeta(d),
-			Spec:     constructSpec(d),
+			Spec:     spec,
 		},
 	}
 
@@ -160,6 +193,64 @@ func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, m inte
 	return dataSourceNamespaceRead(ctx, d, m)
 }
 
+// attachUnmanagedNamespace moves an existing unmanaged namespace into the workspace of the spec, without recreating it.
+// It reports false when the namespace is not known to Tanzu Mission Control yet, so that it is attached by a create request.
+func attachUnmanagedNamespace(config authctx.TanzuContext, d *schema.ResourceData, spec *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec) (bool, error) {
+	fullName := constructFullname(d)
+
+	getResp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceGet(fullName)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, errors.Wrapf(err, "unable to get Tanzu Mission Control namespace entry, name : %s", fullName.Name)
+	}
+
+	namespace := getResp.Namespace
+	if namespace == nil {
+		return false, nil
+	}
+
+	if isManaged(namespace) {
+		return false, errors.Errorf("unable to attach Tanzu Mission Control namespace entry, name : %s; the namespace is already managed by workspace %s", fullName.Name, namespace.Spec.WorkspaceName)
+	}
+
+	meta := common.ConstructMeta(d)
+
+	if namespace.Meta == nil {
+		namespace.Meta = meta
+	} else {
+		namespace.Meta.Labels = common.WithSystemManagedKeys(meta.Labels, namespace.Meta.Labels)
+		namespace.Meta.Description = meta.Description
+	}
+
+	namespace.Spec = spec
+
+	resp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceUpdate(
+		&namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceRequest{
+			Namespace: namespace,
+		},
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to attach Tanzu Mission Control namespace entry, name : %s", fullName.Name)
+	}
+
+	uid := namespace.Meta.UID
+
+	if resp != nil && resp.Namespace != nil && resp.Namespace.Meta != nil && resp.Namespace.Meta.UID != "" {
+		uid = resp.Namespace.Meta.UID
+	}
+
+	if uid == "" {
+		return false, errors.Errorf("unable to attach Tanzu Mission Control namespace entry, name : %s; the namespace has no UID", fullName.Name)
+	}
+
+	d.SetId(uid)
+
+	return true, nil
+}
+
 func resourceNamespaceDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(authctx.TanzuContext)
 
@@ -168,6 +259,16 @@ func resourceNamespaceDelete(_ context.Context, d *schema.ResourceData, m interf
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	if adopted, _ := d.Get(adoptedKey).(bool); adopted {
+		if err := detachAdoptedNamespace(config, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		_ = schema.RemoveFromState(d, m)
+
+		return diags
+	}
+
 	err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceDelete(constructFullname(d))
 	if err != nil && !clienterrors.IsNotFoundError(err) {
 		return diag.FromErr(errors.Wrapf(err, "unable to delete Tanzu Mission Control namespace entry, name : %s", namespaceName))
@@ -180,6 +281,39 @@ func resourceNamespaceDelete(_ context.Context, d *schema.ResourceData, m interf
 	return diags
 }
 
+// detachAdoptedNamespace removes an adopted namespace from its workspace, so that it is unmanaged again and left on the cluster.
+func detachAdoptedNamespace(config authctx.TanzuContext, d *schema.ResourceData) error {
+	fullName := constructFullname(d)
+
+	getResp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceGet(fullName)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, "unable to get Tanzu Mission Control namespace entry, name : %s", fullName.Name)
+	}
+
+	namespace := getResp.Namespace
+	if namespace == nil || !isManaged(namespace) {
+		return nil
+	}
+
+	namespace.Spec.WorkspaceName = ""
+	namespace.Spec.Attach = false
+
+	_, err = config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceUpdate(
+		&namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceRequest{
+			Namespace: namespace,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "unable to detach Tanzu Mission Control namespace entry, name : %s", fullName.Name)
+	}
+
+	return nil
+}
+
 func resourceNamespaceInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	config := m.(authctx.TanzuContext)
 
@@ -202,12 +336,14 @@ func resourceNamespaceInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(errors.Wrapf(err, "unable to get Tanzu Mission Control namespace entry, name : %s", d.Get(ClusterNameKey)))
 	}
 
+	if err := common.CheckResourceVersion(d, getResp.Namespace.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to update Tanzu Mission Control namespace entry, name : %s", d.Get(ClusterNameKey)))
+	}
+
 	if common.HasMetaChanged(d) {
 		meta := common.ConstructMeta(d)
 
-		if value, ok := getResp.Namespace.Meta.Labels[common.CreatorLabelKey]; ok {
-			meta.Labels[common.CreatorLabelKey] = value
-		}
+		meta.Labels = common.WithSystemManagedKeys(meta.Labels, getResp.Namespace.Meta.Labels)
 
 		getResp.Namespace.Meta.Labels = meta.Labels
 		getResp.Namespace.Meta.Description = meta.Description
@@ -0,0 +1,43 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+const (
+	ResourceName = "tanzu-mission-control_policy_assignment"
+
+	nameKey               = "name"
+	policyKey             = "policy"
+	policyTypeKey         = "policy_type"
+	targetsKey            = "targets"
+	clusterGroupsKey      = "cluster_groups"
+	clusterGroupLabelsKey = "cluster_group_labels"
+	workspacesKey         = "workspaces"
+	workspaceLabelsKey    = "workspace_labels"
+	statusKey             = "status"
+	scopeKey              = "scope"
+	uidKey                = "uid"
+	resourceVersionKey    = "resource_version"
+	stateKey              = "state"
+	messageKey            = "message"
+
+	customPolicyKey         = "custom"
+	securityPolicyKey       = "security"
+	namespaceQuotaPolicyKey = "namespace_quota"
+	mutationPolicyKey       = "mutation"
+	imagePolicyKey          = "image"
+	networkPolicyKey        = "network"
+
+	// assignmentLabelKey marks the policies created by a policy assignment, its value is the ID of the owning assignment resource.
+	assignmentLabelKey = "terraform.tanzu-mission-control/policy-assignment"
+)
+
+// States of the policy on a target of the assignment.
+const (
+	appliedState = "APPLIED"
+	driftedState = "DRIFTED"
+	missingState = "MISSING"
+	failedState  = "FAILED"
+)
@@ -0,0 +1,82 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+)
+
+func DataSourcePolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePolicyAssignmentRead,
+		Schema:      policyAssignmentDataSourceSchema,
+		Description: "Tanzu Mission Control Policy Assignment Data Source",
+	}
+}
+
+var policyAssignmentDataSourceSchema = map[string]*schema.Schema{
+	nameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the policy on every target",
+		Required:    true,
+	},
+	policyTypeKey: {
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Type of the policy, one of: %s", strings.Join(policyKindsAllowed(), ", ")),
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(policyKindsAllowed(), false),
+	},
+	targetsKey: targetsSchema,
+	statusKey:  statusSchema,
+}
+
+func dataSourcePolicyAssignmentRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	policyName, _ := d.Get(nameKey).(string)
+	policyType, _ := d.Get(policyTypeKey).(string)
+
+	kind := policyKindByKey(policyType)
+	if kind == nil {
+		return diag.Errorf("policy type %s is not valid: minimum one valid policy type is required among: %s", policyType, strings.Join(policyKindsAllowed(), `, `))
+	}
+
+	targets, err := resolveTargets(config, kind, firstElement(d.Get(targetsKey)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statuses := make([]*targetStatus, 0, len(targets))
+
+	for _, target := range targets {
+		targetPolicy, err := getTargetPolicy(config, kind, target, policyName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if targetPolicy == nil {
+			statuses = append(statuses, &targetStatus{name: target, state: missingState})
+			continue
+		}
+
+		statuses = append(statuses, appliedStatus(target, targetPolicy.meta))
+	}
+
+	if err := d.Set(statusKey, flattenStatus(kind, statuses)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", kind.key, policyName))
+
+	return diags
+}
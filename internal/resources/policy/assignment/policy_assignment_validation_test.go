@@ -0,0 +1,93 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePolicyAndTargets(t *testing.T) {
+	t.Parallel()
+
+	securityPolicy := map[string]interface{}{
+		securityPolicyKey: []interface{}{map[string]interface{}{}},
+	}
+
+	cases := []struct {
+		description string
+		policy      map[string]interface{}
+		targets     map[string]interface{}
+		expectErr   bool
+	}{
+		{
+			description: "security policy assigned to cluster groups by name and label",
+			policy:      securityPolicy,
+			targets: map[string]interface{}{
+				clusterGroupsKey:      []interface{}{"cg-1"},
+				clusterGroupLabelsKey: map[string]interface{}{"env": "prod"},
+			},
+		},
+		{
+			description: "network policy assigned to workspaces",
+			policy: map[string]interface{}{
+				networkPolicyKey: []interface{}{map[string]interface{}{}},
+			},
+			targets: map[string]interface{}{
+				workspacesKey: []interface{}{"ws-1"},
+			},
+		},
+		{
+			description: "no policy type",
+			policy:      map[string]interface{}{},
+			targets: map[string]interface{}{
+				clusterGroupsKey: []interface{}{"cg-1"},
+			},
+			expectErr: true,
+		},
+		{
+			description: "more than one policy type",
+			policy: map[string]interface{}{
+				securityPolicyKey:       []interface{}{map[string]interface{}{}},
+				namespaceQuotaPolicyKey: []interface{}{map[string]interface{}{}},
+			},
+			targets: map[string]interface{}{
+				clusterGroupsKey: []interface{}{"cg-1"},
+			},
+			expectErr: true,
+		},
+		{
+			description: "security policy assigned to workspaces",
+			policy:      securityPolicy,
+			targets: map[string]interface{}{
+				workspaceLabelsKey: map[string]interface{}{"env": "prod"},
+			},
+			expectErr: true,
+		},
+		{
+			description: "no targets",
+			policy:      securityPolicy,
+			targets: map[string]interface{}{
+				clusterGroupsKey:      []interface{}{},
+				clusterGroupLabelsKey: map[string]interface{}{},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validatePolicyAndTargets(test.policy, test.targets)
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
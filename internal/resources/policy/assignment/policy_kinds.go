@@ -0,0 +1,145 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
+	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
+	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
+	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
+	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
+	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+// specValidator checks the spec block of a policy type found at the given key of the diff.
+type specValidator func(diff *schema.ResourceDiff, specKey string) error
+
+// policyKind describes a type of policy which can be assigned in bulk, and the scope of its targets.
+type policyKind struct {
+	key           string
+	description   string
+	scope         string
+	specSchema    *schema.Schema
+	constructSpec func(data []interface{}) *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+	flattenSpec   func(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) []interface{}
+	validateSpec  []specValidator
+}
+
+var policyKinds = []*policyKind{
+	{
+		key:           customPolicyKey,
+		description:   "Spec of the custom policy",
+		scope:         scope.ClusterGroupKey,
+		specSchema:    policykindcustom.SpecSchema,
+		constructSpec: policykindcustom.ConstructSpecFromData,
+		flattenSpec:   policykindcustom.FlattenSpec,
+		validateSpec:  []specValidator{policykindcustom.ValidateInputAt},
+	},
+	{
+		key:           securityPolicyKey,
+		description:   "Spec of the security policy",
+		scope:         scope.ClusterGroupKey,
+		specSchema:    policykindsecurity.SpecSchema,
+		constructSpec: policykindsecurity.ConstructSpecFromData,
+		flattenSpec:   policykindsecurity.FlattenSpec,
+		validateSpec:  []specValidator{policykindsecurity.ValidateInputAt},
+	},
+	{
+		key:           namespaceQuotaPolicyKey,
+		description:   "Spec of the namespace quota policy",
+		scope:         scope.ClusterGroupKey,
+		specSchema:    policykindquota.SpecSchema,
+		constructSpec: policykindquota.ConstructSpecFromData,
+		flattenSpec:   policykindquota.FlattenSpec,
+		validateSpec:  []specValidator{policykindquota.ValidateInputAt},
+	},
+	{
+		key:           mutationPolicyKey,
+		description:   "Spec of the mutation policy",
+		scope:         scope.ClusterGroupKey,
+		specSchema:    policykindmutation.SpecSchema,
+		constructSpec: policykindmutation.ConstructSpecFromData,
+		flattenSpec:   policykindmutation.FlattenSpec,
+		validateSpec:  []specValidator{policykindmutation.ValidateInputAt},
+	},
+	{
+		key:           imagePolicyKey,
+		description:   "Spec of the image policy",
+		scope:         scope.WorkspaceKey,
+		specSchema:    policykindimage.SpecSchema,
+		constructSpec: policykindimage.ConstructSpecFromData,
+		flattenSpec:   policykindimage.FlattenSpec,
+		validateSpec:  []specValidator{policykindimage.ValidateInputAt, policykindimage.ValidateSignatureVerificationAt},
+	},
+	{
+		key:           networkPolicyKey,
+		description:   "Spec of the network policy",
+		scope:         scope.WorkspaceKey,
+		specSchema:    policykindnetwork.SpecSchema,
+		constructSpec: policykindnetwork.ConstructSpecFromData,
+		flattenSpec:   policykindnetwork.FlattenSpec,
+		validateSpec:  []specValidator{policykindnetwork.ValidateInputAt},
+	},
+}
+
+func policyKindsAllowed() (keys []string) {
+	for _, kind := range policyKinds {
+		keys = append(keys, kind.key)
+	}
+
+	return keys
+}
+
+func policyKindByKey(key string) *policyKind {
+	for _, kind := range policyKinds {
+		if kind.key == key {
+			return kind
+		}
+	}
+
+	return nil
+}
+
+// policyKindsFound returns the keys of the policy type blocks set in the policy block data.
+func policyKindsFound(policyData map[string]interface{}) (keys []string) {
+	for _, kind := range policyKinds {
+		if v, ok := policyData[kind.key].([]interface{}); ok && len(v) != 0 {
+			keys = append(keys, kind.key)
+		}
+	}
+
+	return keys
+}
+
+// policySchema builds the policy block of the assignment, with one optional spec block per type of policy.
+func policySchema() *schema.Schema {
+	kindsSchema := make(map[string]*schema.Schema, len(policyKinds))
+
+	for _, kind := range policyKinds {
+		kindsSchema[kind.key] = &schema.Schema{
+			Type:        schema.TypeList,
+			Description: kind.description,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        kind.specSchema.Elem,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Definition of the policy assigned to every target, having exactly one of the policy types: custom, security, namespace_quota or mutation for cluster group targets and image or network for workspace targets",
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: kindsSchema,
+		},
+	}
+}
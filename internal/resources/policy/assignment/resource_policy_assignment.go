@@ -0,0 +1,378 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+func ResourcePolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyAssignmentCreate,
+		ReadContext:   resourcePolicyAssignmentRead,
+		UpdateContext: resourcePolicyAssignmentUpdate,
+		DeleteContext: resourcePolicyAssignmentDelete,
+		Schema:        policyAssignmentSchema,
+		CustomizeDiff: customdiff.All(
+			validatePolicyAssignment,
+			diffPolicyAssignmentTargets,
+		),
+		Description: "Tanzu Mission Control Policy Assignment Resource",
+	}
+}
+
+var policyAssignmentSchema = map[string]*schema.Schema{
+	nameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the policy created on every target",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	policyKey:      policySchema(),
+	targetsKey:     targetsSchema,
+	statusKey:      statusSchema,
+}
+
+func resourcePolicyAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	policyName, _ := d.Get(nameKey).(string)
+
+	kind := assignedPolicyKind(d.Get(policyKey))
+	if kind == nil {
+		return diag.Errorf("Unable to create Tanzu Mission Control policy assignment, name : %s; no valid policy type block found among: %s", policyName, strings.Join(policyKindsAllowed(), `, `))
+	}
+
+	targets, err := resolveTargets(config, kind, firstElement(d.Get(targetsKey)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner := resource.UniqueId()
+	statuses, diags := applyAssignment(config, kind, policyName, owner, constructTargetPolicy(d, kind), targets, nil)
+
+	// always run
+	d.SetId(owner)
+
+	if err := d.Set(statusKey, flattenStatus(kind, statuses)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourcePolicyAssignmentRead(ctx, d, m)
+}
+
+func resourcePolicyAssignmentRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	policyName, _ := d.Get(nameKey).(string)
+
+	kind := assignedPolicyKind(d.Get(policyKey))
+	if kind == nil {
+		return diag.Errorf("Unable to read Tanzu Mission Control policy assignment, name : %s; no valid policy type block found among: %s", policyName, strings.Join(policyKindsAllowed(), `, `))
+	}
+
+	targets, _ := statusTargets(d.Get(statusKey))
+	statuses := make([]*targetStatus, 0, len(targets))
+
+	var (
+		applied       *targetPolicy
+		appliedTarget string
+	)
+
+	// The policy of the first target is set in the state, the policies of the other targets are compared to it
+	// and marked as drifted when they differ, which plans an update of the assignment.
+	for _, target := range targets {
+		targetPolicy, err := getTargetPolicy(config, kind, target, policyName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if targetPolicy == nil {
+			statuses = append(statuses, &targetStatus{name: target, state: missingState})
+			continue
+		}
+
+		status := appliedStatus(target, targetPolicy.meta)
+
+		switch {
+		case applied == nil:
+			applied, appliedTarget = targetPolicy, target
+		case !equalTargetPolicies(kind, applied, targetPolicy):
+			status.state = driftedState
+			status.message = fmt.Sprintf("the policy differs from the policy on target %s", appliedTarget)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	if applied == nil {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(withoutAssignmentLabel(applied.meta))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	priorSpec, _ := firstElement(d.Get(policyKey))[kind.key].([]interface{})
+	flattenPolicy := map[string]interface{}{kind.key: policy.FlattenSpecExclusions(kind.flattenSpec(applied.spec), priorSpec)}
+
+	if err := d.Set(policyKey, []interface{}{flattenPolicy}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenStatus(kind, statuses)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourcePolicyAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	policyName, _ := d.Get(nameKey).(string)
+
+	kind := assignedPolicyKind(d.Get(policyKey))
+	if kind == nil {
+		return diag.Errorf("Unable to update Tanzu Mission Control policy assignment, name : %s; no valid policy type block found among: %s", policyName, strings.Join(policyKindsAllowed(), `, `))
+	}
+
+	targets, err := resolveTargets(config, kind, firstElement(d.Get(targetsKey)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldStatus, _ := d.GetChange(statusKey)
+	previousTargets, _ := statusTargets(oldStatus)
+	known := statusByTarget(oldStatus)
+
+	statuses := make([]*targetStatus, 0)
+
+	for _, target := range removedTargets(previousTargets, targets) {
+		if err := deleteTargetPolicy(config, kind, target, policyName, d.Id(), known[target].uid); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			statuses = append(statuses, &targetStatus{name: target, state: failedState, message: err.Error()})
+		}
+	}
+
+	appliedStatuses, applyDiags := applyAssignment(config, kind, policyName, d.Id(), constructTargetPolicy(d, kind), targets, known)
+	statuses = append(statuses, appliedStatuses...)
+	diags = append(diags, applyDiags...)
+
+	if err := d.Set(statusKey, flattenStatus(kind, statuses)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourcePolicyAssignmentRead(ctx, d, m)
+}
+
+func resourcePolicyAssignmentDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	policyName, _ := d.Get(nameKey).(string)
+
+	kind := assignedPolicyKind(d.Get(policyKey))
+	if kind == nil {
+		return diag.Errorf("Unable to delete Tanzu Mission Control policy assignment, name : %s; no valid policy type block found among: %s", policyName, strings.Join(policyKindsAllowed(), `, `))
+	}
+
+	targets, _ := statusTargets(d.Get(statusKey))
+	known := statusByTarget(d.Get(statusKey))
+
+	for _, target := range targets {
+		if err := deleteTargetPolicy(config, kind, target, policyName, d.Id(), known[target].uid); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// applyAssignment creates or updates the policy on every target, the failure to apply the policy on a target does not stop the others from being applied.
+// owner is the ID of the assignment and known holds the UID and resource version of the policies recorded in the state for each target.
+func applyAssignment(config authctx.TanzuContext, kind *policyKind, policyName, owner string, desired *targetPolicy, targets []string, known map[string]targetStatus) (statuses []*targetStatus, diags diag.Diagnostics) {
+	for _, target := range targets {
+		meta, err := applyTargetPolicy(config, kind, target, policyName, owner, known[target], desired)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			statuses = append(statuses, &targetStatus{name: target, state: failedState, message: err.Error()})
+
+			continue
+		}
+
+		statuses = append(statuses, appliedStatus(target, meta))
+	}
+
+	return statuses, diags
+}
+
+func constructTargetPolicy(d *schema.ResourceData, kind *policyKind) *targetPolicy {
+	meta := common.ConstructMeta(d)
+
+	// UID and resource version of the meta data in state belong to the policy of a single target.
+	meta.UID = ""
+	meta.ResourceVersion = ""
+
+	policyData := firstElement(d.Get(policyKey))
+	specData, _ := policyData[kind.key].([]interface{})
+
+	return &targetPolicy{
+		meta: meta,
+		spec: kind.constructSpec(specData),
+	}
+}
+
+// assignedPolicyKind returns the kind of the first policy type block set in the policy block data.
+func assignedPolicyKind(data interface{}) *policyKind {
+	kindsFound := policyKindsFound(firstElement(data))
+	if len(kindsFound) == 0 {
+		return nil
+	}
+
+	return policyKindByKey(kindsFound[0])
+}
+
+func firstElement(data interface{}) map[string]interface{} {
+	list, _ := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	element, _ := list[0].(map[string]interface{})
+
+	return element
+}
+
+// validatePolicyAssignment checks the policy type and targets, then runs the validations of the policy type on its spec block.
+func validatePolicyAssignment(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if err := validatePolicyAndTargets(firstElement(diff.Get(policyKey)), firstElement(diff.Get(targetsKey))); err != nil {
+		return err
+	}
+
+	kind := assignedPolicyKind(diff.Get(policyKey))
+	specKey := fmt.Sprintf("%s.0.%s", policyKey, kind.key)
+
+	for _, validate := range kind.validateSpec {
+		if err := validate(diff, specKey); err != nil {
+			return err
+		}
+	}
+
+	if err := policy.ValidateLabelSelectorRequirementAt(diff, specKey); err != nil {
+		return err
+	}
+
+	return policy.ValidateExclusionsAt(diff, specKey)
+}
+
+// validatePolicyAndTargets checks exactly one type of policy is defined, and that its targets are cluster groups or workspaces depending on the type.
+func validatePolicyAndTargets(policyData, targetsData map[string]interface{}) error {
+	kindsFound := policyKindsFound(policyData)
+
+	switch {
+	case len(kindsFound) == 0:
+		return fmt.Errorf("no valid policy type block found: minimum one valid policy type block is required among: %v", strings.Join(policyKindsAllowed(), `, `))
+	case len(kindsFound) > 1:
+		return fmt.Errorf("found policy types: %v are not valid: maximum one valid policy type block is allowed", strings.Join(kindsFound, `, `))
+	}
+
+	kind := policyKindByKey(kindsFound[0])
+	targetKeys := map[string][]string{
+		scope.ClusterGroupKey: {clusterGroupsKey, clusterGroupLabelsKey},
+		scope.WorkspaceKey:    {workspacesKey, workspaceLabelsKey},
+	}
+
+	targetsFound := make([]string, 0)
+
+	for _, key := range []string{clusterGroupsKey, clusterGroupLabelsKey, workspacesKey, workspaceLabelsKey} {
+		switch v := targetsData[key].(type) {
+		case []interface{}:
+			if len(v) != 0 {
+				targetsFound = append(targetsFound, key)
+			}
+		case map[string]interface{}:
+			if len(v) != 0 {
+				targetsFound = append(targetsFound, key)
+			}
+		}
+	}
+
+	if len(targetsFound) == 0 {
+		return fmt.Errorf("no targets found: %s policy requires targets among: %v", kind.key, strings.Join(targetKeys[kind.scope], `, `))
+	}
+
+	for _, key := range targetsFound {
+		if !slices.Contains(targetKeys[kind.scope], key) {
+			return fmt.Errorf("found targets: %v are not valid: %s policy can only be assigned to targets among: %v", key, kind.key, strings.Join(targetKeys[kind.scope], `, `))
+		}
+	}
+
+	return nil
+}
+
+// diffPolicyAssignmentTargets plans the recreation of the assignment when the type of policy changes, and plans an update
+// when targets were added to the label selection or lost the policy since it was applied.
+func diffPolicyAssignmentTargets(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	oldPolicy, newPolicy := diff.GetChange(policyKey)
+	oldKind, newKind := assignedPolicyKind(oldPolicy), assignedPolicyKind(newPolicy)
+
+	if oldKind != nil && newKind != nil && oldKind.key != newKind.key {
+		return diff.ForceNew(policyKey)
+	}
+
+	if newKind == nil || !diff.NewValueKnown(targetsKey) {
+		return nil
+	}
+
+	config, ok := m.(authctx.TanzuContext)
+	if !ok {
+		return nil
+	}
+
+	targets, err := resolveTargets(config, newKind, firstElement(diff.Get(targetsKey)))
+	if err != nil {
+		return err
+	}
+
+	appliedTargets, allApplied := statusTargets(diff.Get(statusKey))
+
+	if !allApplied || !slices.Equal(targets, appliedTargets) {
+		return diff.SetNewComputed(statusKey)
+	}
+
+	return nil
+}
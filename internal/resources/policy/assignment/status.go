@@ -0,0 +1,115 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var statusSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Status of the policy on each target of the assignment",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			scopeKey: {
+				Type:        schema.TypeString,
+				Description: "Scope of the target, cluster_group or workspace",
+				Computed:    true,
+			},
+			nameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster group or workspace",
+				Computed:    true,
+			},
+			uidKey: {
+				Type:        schema.TypeString,
+				Description: "UID of the policy on the target",
+				Computed:    true,
+			},
+			resourceVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Resource version of the policy on the target, an update fails when the policy was modified outside of Terraform since it was read",
+				Computed:    true,
+			},
+			stateKey: {
+				Type:        schema.TypeString,
+				Description: "State of the policy on the target: APPLIED, DRIFTED when the policy differs from the policy on the first target, MISSING when the policy no longer exists on the target or FAILED when the policy could not be applied",
+				Computed:    true,
+			},
+			messageKey: {
+				Type:        schema.TypeString,
+				Description: "Error message when the policy could not be applied to the target",
+				Computed:    true,
+			},
+		},
+	},
+}
+
+func flattenStatus(kind *policyKind, statuses []*targetStatus) (data []interface{}) {
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			scopeKey:           kind.scope,
+			nameKey:            status.name,
+			uidKey:             status.uid,
+			resourceVersionKey: status.resourceVersion,
+			stateKey:           status.state,
+			messageKey:         status.message,
+		})
+	}
+
+	return data
+}
+
+// statusTargets returns the names of the targets recorded in the status data, and whether all of them have the policy applied.
+func statusTargets(data interface{}) (targets []string, allApplied bool) {
+	allApplied = true
+	statusData, _ := data.([]interface{})
+
+	for _, raw := range statusData {
+		status, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := status[nameKey].(string); ok && name != "" {
+			targets = append(targets, name)
+		}
+
+		if state, _ := status[stateKey].(string); state != appliedState {
+			allApplied = false
+		}
+	}
+
+	return targets, allApplied
+}
+
+// statusByTarget returns the UID and resource version of the policy recorded in the status data for each target.
+func statusByTarget(data interface{}) map[string]targetStatus {
+	statuses := make(map[string]targetStatus)
+	statusData, _ := data.([]interface{})
+
+	for _, raw := range statusData {
+		status, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := status[nameKey].(string)
+		uid, _ := status[uidKey].(string)
+		resourceVersion, _ := status[resourceVersionKey].(string)
+
+		if name != "" && uid != "" {
+			statuses[name] = targetStatus{name: name, uid: uid, resourceVersion: resourceVersion}
+		}
+	}
+
+	return statuses
+}
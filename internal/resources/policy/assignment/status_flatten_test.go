@@ -0,0 +1,108 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		kind        *policyKind
+		input       []*targetStatus
+		expected    []interface{}
+		targets     []string
+		allApplied  bool
+	}{
+		{
+			description: "check for nil status",
+			kind:        policyKindByKey(securityPolicyKey),
+			input:       nil,
+			expected:    nil,
+			allApplied:  true,
+		},
+		{
+			description: "normal scenario with cluster group targets",
+			kind:        policyKindByKey(securityPolicyKey),
+			input: []*targetStatus{
+				{name: "cg-1", uid: "uid-1", resourceVersion: "3", state: appliedState},
+				nil,
+				{name: "cg-2", state: failedState, message: "permission denied"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					scopeKey:           "cluster_group",
+					nameKey:            "cg-1",
+					uidKey:             "uid-1",
+					resourceVersionKey: "3",
+					stateKey:           appliedState,
+					messageKey:         "",
+				},
+				map[string]interface{}{
+					scopeKey:           "cluster_group",
+					nameKey:            "cg-2",
+					uidKey:             "",
+					resourceVersionKey: "",
+					stateKey:           failedState,
+					messageKey:         "permission denied",
+				},
+			},
+			targets: []string{"cg-1", "cg-2"},
+		},
+		{
+			description: "normal scenario with workspace targets",
+			kind:        policyKindByKey(networkPolicyKey),
+			input: []*targetStatus{
+				{name: "ws-1", state: missingState},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					scopeKey:           "workspace",
+					nameKey:            "ws-1",
+					uidKey:             "",
+					resourceVersionKey: "",
+					stateKey:           missingState,
+					messageKey:         "",
+				},
+			},
+			targets: []string{"ws-1"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenStatus(test.kind, test.input)
+			require.Equal(t, test.expected, actual)
+
+			targets, allApplied := statusTargets(actual)
+			require.Equal(t, test.targets, targets)
+			require.Equal(t, test.allApplied, allApplied)
+		})
+	}
+}
+
+func TestStatusByTarget(t *testing.T) {
+	t.Parallel()
+
+	kind := policyKindByKey(securityPolicyKey)
+	data := flattenStatus(kind, []*targetStatus{
+		{name: "cg-1", uid: "uid-1", resourceVersion: "3", state: appliedState},
+		{name: "cg-2", uid: "uid-2", state: appliedState},
+		{name: "cg-3", state: failedState, message: "permission denied"},
+	})
+
+	require.Equal(t, map[string]targetStatus{
+		"cg-1": {name: "cg-1", uid: "uid-1", resourceVersion: "3"},
+		"cg-2": {name: "cg-2", uid: "uid-2"},
+	}, statusByTarget(data))
+	require.Empty(t, statusByTarget(nil))
+}
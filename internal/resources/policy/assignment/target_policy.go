@@ -0,0 +1,269 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+// targetPolicy is the policy of an assignment on one of its cluster group or workspace targets.
+type targetPolicy struct {
+	meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+}
+
+// targetStatus is the state of the policy of an assignment on one of its targets.
+type targetStatus struct {
+	name            string
+	uid             string
+	resourceVersion string
+	state           string
+	message         string
+}
+
+// appliedStatus returns the status of a target where the policy exists.
+func appliedStatus(target string, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) *targetStatus {
+	return &targetStatus{name: target, uid: meta.UID, resourceVersion: meta.ResourceVersion, state: appliedState}
+}
+
+// getTargetPolicy returns the policy on the target, or nil when the policy does not exist on the target.
+func getTargetPolicy(config authctx.TanzuContext, kind *policyKind, target, policyName string) (*targetPolicy, error) {
+	if kind.scope == scope.WorkspaceKey {
+		resp, err := config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceGet(workspacePolicyFullName(target, policyName))
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return nil, nil
+			}
+
+			return nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace %s policy entry, name : %s, workspace : %s", kind.key, policyName, target)
+		}
+
+		return &targetPolicy{meta: resp.Policy.Meta, spec: resp.Policy.Spec}, nil
+	}
+
+	resp, err := config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceGet(clusterGroupPolicyFullName(target, policyName))
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group %s policy entry, name : %s, cluster group : %s", kind.key, policyName, target)
+	}
+
+	return &targetPolicy{meta: resp.Policy.Meta, spec: resp.Policy.Spec}, nil
+}
+
+// applyTargetPolicy creates the policy on the target, or updates the meta data and spec of the existing policy
+// when it is owned by the assignment, has the same type and recipe and was not modified since it was recorded in known.
+func applyTargetPolicy(config authctx.TanzuContext, kind *policyKind, target, policyName, owner string, known targetStatus, desired *targetPolicy) (*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, error) {
+	existing, err := getTargetPolicy(config, kind, target, policyName)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		if err := checkTargetPolicyOwnership(owner, existing, desired, known.uid); err != nil {
+			return nil, errors.Wrapf(err, "Unable to apply Tanzu Mission Control %s %s policy entry, name : %s, target : %s", kind.scope, kind.key, policyName, target)
+		}
+
+		if err := common.MatchResourceVersion(known.resourceVersion, existing.meta); err != nil {
+			return nil, errors.Wrapf(err, "Unable to apply Tanzu Mission Control %s %s policy entry, name : %s, target : %s", kind.scope, kind.key, policyName, target)
+		}
+	}
+
+	labels := make(map[string]string, len(desired.meta.Labels))
+
+	for key, value := range desired.meta.Labels {
+		labels[key] = value
+	}
+
+	meta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		Annotations: desired.meta.Annotations,
+		Description: desired.meta.Description,
+	}
+
+	if existing != nil && existing.meta != nil {
+		labels = common.WithSystemManagedKeys(labels, existing.meta.Labels)
+
+		meta = existing.meta
+		meta.Description = desired.meta.Description
+	}
+
+	labels[assignmentLabelKey] = owner
+	meta.Labels = labels
+
+	if kind.scope == scope.WorkspaceKey {
+		policyReq := &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicyRequest{
+			Policy: &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicy{
+				FullName: workspacePolicyFullName(target, policyName),
+				Meta:     meta,
+				Spec:     desired.spec,
+			},
+		}
+
+		var resp *policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicyResponse
+
+		if existing == nil {
+			resp, err = config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceCreate(policyReq)
+		} else {
+			resp, err = config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceUpdate(policyReq)
+		}
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to apply Tanzu Mission Control workspace %s policy entry, name : %s, workspace : %s", kind.key, policyName, target)
+		}
+
+		return resp.Policy.Meta, nil
+	}
+
+	policyReq := &policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyPolicyRequest{
+		Policy: &policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyPolicy{
+			FullName: clusterGroupPolicyFullName(target, policyName),
+			Meta:     meta,
+			Spec:     desired.spec,
+		},
+	}
+
+	var resp *policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyPolicyResponse
+
+	if existing == nil {
+		resp, err = config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceCreate(policyReq)
+	} else {
+		resp, err = config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceUpdate(policyReq)
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to apply Tanzu Mission Control cluster group %s policy entry, name : %s, cluster group : %s", kind.key, policyName, target)
+	}
+
+	return resp.Policy.Meta, nil
+}
+
+// equalTargetPolicies reports whether two targets have the same policy spec, description and labels, ignoring the labels managed by Tanzu Mission Control.
+func equalTargetPolicies(kind *policyKind, a, b *targetPolicy) bool {
+	if !reflect.DeepEqual(kind.flattenSpec(a.spec), kind.flattenSpec(b.spec)) {
+		return false
+	}
+
+	return a.meta.Description == b.meta.Description && reflect.DeepEqual(userLabels(a.meta.Labels), userLabels(b.meta.Labels))
+}
+
+func userLabels(labels map[string]string) map[string]string {
+	filtered := make(map[string]string, len(labels))
+
+	for key, value := range labels {
+		if key != assignmentLabelKey && !common.IsSystemManagedKey(key) {
+			filtered[key] = value
+		}
+	}
+
+	return filtered
+}
+
+// withoutAssignmentLabel returns a copy of the meta data of a target policy without the label marking it as owned by the assignment.
+func withoutAssignmentLabel(meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta {
+	if meta == nil {
+		return nil
+	}
+
+	metaCopy := *meta
+	metaCopy.Labels = make(map[string]string, len(meta.Labels))
+
+	for key, value := range meta.Labels {
+		if key != assignmentLabelKey {
+			metaCopy.Labels[key] = value
+		}
+	}
+
+	return &metaCopy
+}
+
+// isOwnedTargetPolicy reports whether the policy on a target is owned by the assignment: its assignment label is the ID
+// of the assignment, or it has no assignment label and its UID is the one recorded in the state of the assignment.
+func isOwnedTargetPolicy(owner string, existing *targetPolicy, knownUID string) bool {
+	if existing == nil || existing.meta == nil {
+		return false
+	}
+
+	label := existing.meta.Labels[assignmentLabelKey]
+
+	return (label != "" && label == owner) || (label == "" && knownUID != "" && existing.meta.UID == knownUID)
+}
+
+// checkTargetPolicyOwnership returns a conflict error when the existing policy on a target is not owned by the assignment,
+// or when its type or recipe differs from the desired policy, so that it is not overwritten.
+func checkTargetPolicyOwnership(owner string, existing, desired *targetPolicy, knownUID string) error {
+	if !isOwnedTargetPolicy(owner, existing, knownUID) {
+		if existing.meta != nil && existing.meta.Labels[assignmentLabelKey] != "" {
+			return errors.Errorf("conflict: the policy on the target is managed by another policy assignment, owner: %s", existing.meta.Labels[assignmentLabelKey])
+		}
+
+		return errors.New("conflict: the policy already exists on the target and is not managed by this policy assignment")
+	}
+
+	if existing.spec == nil || desired.spec == nil {
+		return nil
+	}
+
+	if existing.spec.Type != desired.spec.Type {
+		return errors.Errorf("conflict: the policy on the target has type %s instead of %s", existing.spec.Type, desired.spec.Type)
+	}
+
+	if existing.spec.Recipe != desired.spec.Recipe {
+		return errors.Errorf("conflict: the policy on the target has recipe %s instead of %s", existing.spec.Recipe, desired.spec.Recipe)
+	}
+
+	return nil
+}
+
+// deleteTargetPolicy deletes the policy on the target when it is owned by the assignment, policies created outside of the assignment are left untouched.
+func deleteTargetPolicy(config authctx.TanzuContext, kind *policyKind, target, policyName, owner, knownUID string) error {
+	existing, err := getTargetPolicy(config, kind, target, policyName)
+	if err != nil {
+		return err
+	}
+
+	if !isOwnedTargetPolicy(owner, existing, knownUID) {
+		return nil
+	}
+
+	if kind.scope == scope.WorkspaceKey {
+		err = config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceDelete(workspacePolicyFullName(target, policyName))
+	} else {
+		err = config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceDelete(clusterGroupPolicyFullName(target, policyName))
+	}
+
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "Unable to delete Tanzu Mission Control %s %s policy entry, name : %s, target : %s", kind.scope, kind.key, policyName, target)
+	}
+
+	return nil
+}
+
+func clusterGroupPolicyFullName(clusterGroupName, policyName string) *policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName {
+	return &policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName{
+		ClusterGroupName: clusterGroupName,
+		Name:             policyName,
+	}
+}
+
+func workspacePolicyFullName(workspaceName, policyName string) *policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName {
+	return &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName{
+		WorkspaceName: workspaceName,
+		Name:          policyName,
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+func TestCheckTargetPolicyOwnership(t *testing.T) {
+	t.Parallel()
+
+	owner := "assignment-a"
+	desired := &targetPolicy{
+		meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+		spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "baseline"},
+	}
+
+	cases := []struct {
+		description string
+		existing    *targetPolicy
+		knownUID    string
+		expectError bool
+	}{
+		{
+			description: "policy created by the assignment",
+			existing: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-1", Labels: map[string]string{assignmentLabelKey: owner}},
+				spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "baseline"},
+			},
+		},
+		{
+			description: "policy recorded in the state before the assignment label was set",
+			existing: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-1"},
+				spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "baseline"},
+			},
+			knownUID: "uid-1",
+		},
+		{
+			description: "policy created outside of the assignment",
+			existing: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-2"},
+				spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "baseline"},
+			},
+			knownUID:    "uid-1",
+			expectError: true,
+		},
+		{
+			description: "policy created by another assignment with the same policy name and type",
+			existing: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-2", Labels: map[string]string{assignmentLabelKey: "assignment-b"}},
+				spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "baseline"},
+			},
+			expectError: true,
+		},
+		{
+			description: "policy created by another assignment with the UID recorded in the state",
+			existing: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-1", Labels: map[string]string{assignmentLabelKey: "assignment-b"}},
+				spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "custom-policy", Recipe: "tmc-block-resources"},
+			},
+			knownUID:    "uid-1",
+			expectError: true,
+		},
+		{
+			description: "policy with another recipe",
+			existing: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-1", Labels: map[string]string{assignmentLabelKey: owner}},
+				spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "strict"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := checkTargetPolicyOwnership(owner, test.existing, desired, test.knownUID)
+			require.Equal(t, test.expectError, err != nil)
+		})
+	}
+}
+
+func TestIsOwnedTargetPolicySharedName(t *testing.T) {
+	t.Parallel()
+
+	// Two assignments creating a policy with the same name on the same target, only the one which created it owns it.
+	existing := &targetPolicy{
+		meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-1", Labels: map[string]string{assignmentLabelKey: "assignment-a"}},
+		spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "baseline"},
+	}
+
+	require.True(t, isOwnedTargetPolicy("assignment-a", existing, "uid-1"))
+	require.False(t, isOwnedTargetPolicy("assignment-b", existing, ""))
+	require.False(t, isOwnedTargetPolicy("assignment-b", existing, "uid-1"))
+	require.False(t, isOwnedTargetPolicy("", existing, ""))
+}
+
+func TestEqualTargetPolicies(t *testing.T) {
+	t.Parallel()
+
+	kind := policyKindByKey(securityPolicyKey)
+	spec := &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Type: "security-policy", Recipe: "baseline"}
+
+	cases := []struct {
+		description string
+		a           *targetPolicy
+		b           *targetPolicy
+		expected    bool
+	}{
+		{
+			description: "same policy with different system managed labels",
+			a: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-1", Labels: map[string]string{"team": "a", "tmc.cloud.vmware.com/creator": "user-1"}},
+				spec: spec,
+			},
+			b: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-2", Labels: map[string]string{"team": "a", assignmentLabelKey: securityPolicyKey}},
+				spec: spec,
+			},
+			expected: true,
+		},
+		{
+			description: "different labels",
+			a: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"team": "a"}},
+				spec: spec,
+			},
+			b: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"team": "b"}},
+				spec: spec,
+			},
+			expected: false,
+		},
+		{
+			description: "different description",
+			a: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Description: "a"},
+				spec: spec,
+			},
+			b: &targetPolicy{
+				meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Description: "b"},
+				spec: spec,
+			},
+			expected: false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, equalTargetPolicies(kind, test.a, test.b))
+		})
+	}
+}
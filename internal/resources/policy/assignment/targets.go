@@ -0,0 +1,159 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clustergroup"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/workspace"
+)
+
+var targetsSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Cluster groups or workspaces the policy is assigned to, selected by name or by label",
+	Required:    true,
+	MinItems:    1,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			clusterGroupsKey: {
+				Type:        schema.TypeList,
+				Description: "Names of the cluster groups to assign the custom, security, namespace_quota or mutation policy to",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			clusterGroupLabelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Labels selecting the cluster groups to assign the custom, security, namespace_quota or mutation policy to, a cluster group is selected when it has all the labels",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			workspacesKey: {
+				Type:        schema.TypeList,
+				Description: "Names of the workspaces to assign the image or network policy to",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			workspaceLabelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Labels selecting the workspaces to assign the image or network policy to, a workspace is selected when it has all the labels",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+// resolveTargets returns the sorted names of the cluster groups or workspaces, depending on the scope of the policy kind,
+// listed by name or selected by label in the targets block data.
+func resolveTargets(config authctx.TanzuContext, kind *policyKind, targetsData map[string]interface{}) ([]string, error) {
+	namesKey, labelsKey := clusterGroupsKey, clusterGroupLabelsKey
+
+	if kind.scope == scope.WorkspaceKey {
+		namesKey, labelsKey = workspacesKey, workspaceLabelsKey
+	}
+
+	names := make(map[string]bool)
+
+	if v, ok := targetsData[namesKey].([]interface{}); ok {
+		for _, raw := range v {
+			if name, ok := raw.(string); ok && name != "" {
+				names[name] = true
+			}
+		}
+	}
+
+	if v, ok := targetsData[labelsKey].(map[string]interface{}); ok && len(v) != 0 {
+		selector := make(map[string]string, len(v))
+
+		for key, value := range v {
+			selector[key], _ = value.(string)
+		}
+
+		selected, err := selectTargets(config, kind, selector)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range selected {
+			names[name] = true
+		}
+	}
+
+	targets := make([]string, 0, len(names))
+
+	for name := range names {
+		targets = append(targets, name)
+	}
+
+	sort.Strings(targets)
+
+	return targets, nil
+}
+
+func selectTargets(config authctx.TanzuContext, kind *policyKind, selector map[string]string) (selected []string, err error) {
+	if kind.scope == scope.WorkspaceKey {
+		workspaces, err := workspace.ListWorkspaces(config, &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest{})
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to list Tanzu Mission Control workspaces")
+		}
+
+		for _, ws := range workspaces {
+			if ws == nil || ws.FullName == nil || ws.Meta == nil {
+				continue
+			}
+
+			if helper.MatchLabels(ws.Meta.Labels, selector) {
+				selected = append(selected, ws.FullName.Name)
+			}
+		}
+
+		return selected, nil
+	}
+
+	clusterGroups, err := clustergroup.ListClusterGroups(config, &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to list Tanzu Mission Control cluster groups")
+	}
+
+	for _, clusterGroup := range clusterGroups {
+		if clusterGroup == nil || clusterGroup.FullName == nil || clusterGroup.Meta == nil {
+			continue
+		}
+
+		if helper.MatchLabels(clusterGroup.Meta.Labels, selector) {
+			selected = append(selected, clusterGroup.FullName.Name)
+		}
+	}
+
+	return selected, nil
+}
+
+// removedTargets returns the targets of the previous assignment which are no longer targeted.
+func removedTargets(previous, current []string) (removed []string) {
+	currentTargets := make(map[string]bool, len(current))
+
+	for _, name := range current {
+		currentTargets[name] = true
+	}
+
+	for _, name := range previous {
+		if !currentTargets[name] {
+			removed = append(removed, name)
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,49 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyassignment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemovedTargets(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		previous    []string
+		current     []string
+		expected    []string
+	}{
+		{
+			description: "no previous targets",
+			previous:    nil,
+			current:     []string{"cg-1"},
+			expected:    nil,
+		},
+		{
+			description: "targets removed and added",
+			previous:    []string{"cg-1", "cg-2", "cg-3"},
+			current:     []string{"cg-2", "cg-4"},
+			expected:    []string{"cg-1", "cg-3"},
+		},
+		{
+			description: "all targets removed",
+			previous:    []string{"cg-1"},
+			current:     nil,
+			expected:    []string{"cg-1"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, removedTargets(test.previous, test.current))
+		})
+	}
+}
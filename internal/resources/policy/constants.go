@@ -16,4 +16,6 @@ const (
 	InputKey                  = "input"
 	RecipeVersionDefaultValue = "v1"
 	UnknownRecipe             = ""
+	ExclusionsKey             = "exclusions"
+	ExcludedNamespacesKey     = "namespaces"
 )
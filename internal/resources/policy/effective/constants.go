@@ -0,0 +1,44 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicies
+
+const (
+	ResourceName = "tanzu-mission-control_effective_policies"
+
+	clusterKey               = "cluster"
+	managementClusterNameKey = "management_cluster_name"
+	provisionerNameKey       = "provisioner_name"
+	nameKey                  = "name"
+	namespaceNameKey         = "namespace_name"
+	policyTypeKey            = "policy_type"
+	effectivePoliciesKey     = "effective_policies"
+	mergedInputKey           = "merged_input"
+	policiesKey              = "policies"
+	recipeKey                = "recipe"
+	recipeVersionKey         = "recipe_version"
+	inputKey                 = "input"
+	inheritedKey             = "inherited"
+	sourceScopeKey           = "source_scope"
+	sourceNameKey            = "source_name"
+	resourcesKey             = "resources"
+	previewPolicyKey         = "preview_policy"
+	previewKey               = "preview"
+	attachedValue            = "attached"
+
+	quotaPolicyType    = "namespace-quota-policy"
+	securityPolicyType = "security-policy"
+)
+
+// Source scopes of a policy, named after the blocks of the policy resource scopes.
+const (
+	organizationScope = "organization"
+	clusterGroupScope = "cluster_group"
+	workspaceScope    = "workspace"
+	clusterScope      = "cluster"
+	namespaceScope    = "namespace"
+)
+
+var policyTypesAllowed = []string{"custom-policy", securityPolicyType, "image-policy", "network-policy", quotaPolicyType, "mutation-policy"}
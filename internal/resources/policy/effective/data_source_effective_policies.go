@@ -0,0 +1,301 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicies
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+)
+
+func DataSourceEffectivePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEffectivePoliciesRead,
+		Schema:      effectivePoliciesSchema,
+		Description: "Tanzu Mission Control Effective Policies Data Source",
+	}
+}
+
+var effectivePoliciesSchema = map[string]*schema.Schema{
+	clusterKey: {
+		Type:        schema.TypeList,
+		Description: "Full name of the cluster to list the effective policies of",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				managementClusterNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the management cluster",
+					Default:     attachedValue,
+					Optional:    true,
+				},
+				provisionerNameKey: {
+					Type:        schema.TypeString,
+					Description: "Provisioner of the cluster",
+					Default:     attachedValue,
+					Optional:    true,
+				},
+				nameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the cluster",
+					Required:    true,
+				},
+			},
+		},
+	},
+	namespaceNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the namespace of the cluster to list the effective policies of, the policies of the cluster are listed when not set",
+		Optional:    true,
+	},
+	policyTypeKey: {
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Type of the policies to list, one of: %s", strings.Join(policyTypesAllowed, ", ")),
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(policyTypesAllowed, false),
+	},
+	previewPolicyKey: previewPolicySchema,
+	effectivePoliciesKey: {
+		Type:        schema.TypeList,
+		Description: "Policies applying to the cluster or namespace, grouped by policy type",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				policyTypeKey: {
+					Type:        schema.TypeString,
+					Description: "Type of the policies",
+					Computed:    true,
+				},
+				mergedInputKey: {
+					Type: schema.TypeString,
+					Description: "JSON encoded parameters effectively applied by the policies together: the lowest value of every quota for namespace-quota-policy, " +
+						"the recipes applied and whether all of them are in audit mode for security-policy. Empty for the other policy types.",
+					Computed: true,
+				},
+				policiesKey: {
+					Type:        schema.TypeList,
+					Description: "Policies of the type, inherited and direct",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							nameKey: {
+								Type:        schema.TypeString,
+								Description: "Name of the policy",
+								Computed:    true,
+							},
+							recipeKey: {
+								Type:        schema.TypeString,
+								Description: "Recipe of the policy",
+								Computed:    true,
+							},
+							recipeVersionKey: {
+								Type:        schema.TypeString,
+								Description: "Version of the recipe of the policy",
+								Computed:    true,
+							},
+							inputKey: {
+								Type:        schema.TypeString,
+								Description: "JSON encoded input of the policy recipe",
+								Computed:    true,
+							},
+							inheritedKey: {
+								Type:        schema.TypeBool,
+								Description: "Whether the policy is inherited from a parent of the cluster or namespace",
+								Computed:    true,
+							},
+							previewKey: {
+								Type:        schema.TypeBool,
+								Description: "Whether the policy is a preview policy, which is not created yet",
+								Computed:    true,
+							},
+							sourceScopeKey: {
+								Type:        schema.TypeString,
+								Description: "Scope the policy is defined on: organization, cluster_group, workspace, cluster or namespace",
+								Computed:    true,
+							},
+							sourceNameKey: {
+								Type:        schema.TypeString,
+								Description: "Name of the organization, cluster group, workspace, cluster or namespace the policy is defined on",
+								Computed:    true,
+							},
+							resourcesKey: {
+								Type:        schema.TypeList,
+								Description: "Kubernetes resources generated for the policy, YAML encoded",
+								Computed:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func constructListRequest(d *schema.ResourceData) *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest {
+	fullName := &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveFullName{}
+
+	if clusterData, ok := d.Get(clusterKey).([]interface{}); ok && len(clusterData) != 0 && clusterData[0] != nil {
+		cluster, _ := clusterData[0].(map[string]interface{})
+
+		fullName.ManagementClusterName, _ = cluster[managementClusterNameKey].(string)
+		fullName.ProvisionerName, _ = cluster[provisionerNameKey].(string)
+		fullName.ClusterName, _ = cluster[nameKey].(string)
+	}
+
+	fullName.NamespaceName, _ = d.Get(namespaceNameKey).(string)
+
+	request := &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveListEffectivePoliciesRequest{
+		FullName: fullName,
+	}
+
+	request.PolicyType, _ = d.Get(policyTypeKey).(string)
+
+	return request
+}
+
+func dataSourceEffectivePoliciesRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	request := constructListRequest(d)
+	fn := request.FullName
+
+	previews, err := constructPreviewPolicies(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := config.TMCConnection.EffectivePolicyResourceService.EffectivePolicyResourceServiceList(request)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			return diag.Errorf("Tanzu Mission Control cluster or namespace not found, cluster : %s, namespace : %s", fn.ClusterName, fn.NamespaceName)
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "Unable to list Tanzu Mission Control effective policies, cluster : %s, namespace : %s", fn.ClusterName, fn.NamespaceName))
+	}
+
+	policies, previewSpecs := addPreviewPolicies(resp.EffectivePolicies, previews)
+
+	effectivePolicies, err := flattenEffectivePolicies(policies, previewSpecs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(effectivePoliciesKey, effectivePolicies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idKeys := []string{fn.ManagementClusterName, fn.ProvisionerName, fn.ClusterName, fn.NamespaceName, request.PolicyType}
+	d.SetId(fmt.Sprintf("effective_policies/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+func flattenEffectivePolicies(effectivePolicies []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy,
+	previewSpecs map[*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec]bool) (data []interface{}, err error) {
+	for _, effectivePolicy := range effectivePolicies {
+		if effectivePolicy == nil {
+			continue
+		}
+
+		var (
+			policies []interface{}
+			specs    []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+		)
+
+		for _, policySpec := range effectivePolicy.PolicySpecs {
+			if policySpec == nil {
+				continue
+			}
+
+			sourceScope, sourceName := parseSourceRid(policySpec.SourceRid)
+
+			flattenPolicy := map[string]interface{}{
+				nameKey:        policySpec.PolicyName,
+				inheritedKey:   policySpec.Inherited,
+				previewKey:     previewSpecs[policySpec],
+				sourceScopeKey: sourceScope,
+				sourceNameKey:  sourceName,
+			}
+
+			if spec := policySpec.Spec; spec != nil {
+				flattenPolicy[recipeKey] = spec.Recipe
+				flattenPolicy[recipeVersionKey] = spec.RecipeVersion
+				flattenPolicy[resourcesKey] = spec.Resources
+
+				if spec.Input != nil {
+					input, err := json.Marshal(spec.Input)
+					if err != nil {
+						return nil, errors.Wrapf(err, "Unable to encode the input of policy %s", policySpec.PolicyName)
+					}
+
+					flattenPolicy[inputKey] = string(input)
+				}
+
+				specs = append(specs, spec)
+			}
+
+			policies = append(policies, flattenPolicy)
+		}
+
+		flattenData := map[string]interface{}{
+			policyTypeKey: effectivePolicy.Type,
+			policiesKey:   policies,
+		}
+
+		if merged := mergeInputs(effectivePolicy.Type, specs); merged != nil {
+			mergedInput, err := json.Marshal(merged)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Unable to encode the merged input of %s policies", effectivePolicy.Type)
+			}
+
+			flattenData[mergedInputKey] = string(mergedInput)
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data, nil
+}
+
+// parseSourceRid returns the scope and name of the resource a policy is defined on from its resource ID,
+// e.g. rid:cg:<org id>:<cluster group> or rid:ns:<org id>:<management cluster>:<provisioner>:<cluster>:<namespace>.
+func parseSourceRid(rid string) (scope, name string) {
+	parts := strings.Split(rid, ":")
+	if len(parts) < 3 || parts[0] != "rid" {
+		return "", ""
+	}
+
+	switch parts[1] {
+	case "o":
+		scope = organizationScope
+	case "cg":
+		scope = clusterGroupScope
+	case "ws":
+		scope = workspaceScope
+	case "c":
+		scope = clusterScope
+	case "ns":
+		scope = namespaceScope
+	default:
+		return "", ""
+	}
+
+	return scope, parts[len(parts)-1]
+}
@@ -0,0 +1,215 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+)
+
+func TestFlattenEffectivePolicies(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil effective policies",
+		},
+		{
+			description: "check for nil effective policy entry in the list",
+			input:       []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy{nil},
+		},
+		{
+			description: "normal scenario with inherited and direct policies",
+			input: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy{
+				{
+					Type: securityPolicyType,
+					PolicySpecs: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+						{
+							Inherited:  true,
+							PolicyName: "org-baseline",
+							SourceRid:  "rid:o:d2c2ae23-3e4f-4ee0-a3d2-e25b6a8d0c35",
+							Spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+								Input:         map[string]interface{}{"audit": true},
+								Recipe:        "baseline",
+								RecipeVersion: "v1",
+								Resources:     []string{"kind: PodSecurityPolicy"},
+							},
+						},
+						{
+							PolicyName: "cluster-strict",
+							SourceRid:  "rid:c:d2c2ae23-3e4f-4ee0-a3d2-e25b6a8d0c35:attached:attached:tf-cluster",
+							Spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+								Recipe:        "strict",
+								RecipeVersion: "v1",
+							},
+						},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					policyTypeKey:  securityPolicyType,
+					mergedInputKey: `{"audit":false,"disableNativePsp":false,"recipes":["baseline","strict"]}`,
+					policiesKey: []interface{}{
+						map[string]interface{}{
+							nameKey:          "org-baseline",
+							inheritedKey:     true,
+							previewKey:       false,
+							sourceScopeKey:   organizationScope,
+							sourceNameKey:    "d2c2ae23-3e4f-4ee0-a3d2-e25b6a8d0c35",
+							recipeKey:        "baseline",
+							recipeVersionKey: "v1",
+							resourcesKey:     []string{"kind: PodSecurityPolicy"},
+							inputKey:         `{"audit":true}`,
+						},
+						map[string]interface{}{
+							nameKey:          "cluster-strict",
+							inheritedKey:     false,
+							previewKey:       false,
+							sourceScopeKey:   clusterScope,
+							sourceNameKey:    "tf-cluster",
+							recipeKey:        "strict",
+							recipeVersionKey: "v1",
+							resourcesKey:     []string(nil),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := flattenEffectivePolicies(test.input, nil)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseSourceRid(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description   string
+		rid           string
+		expectedScope string
+		expectedName  string
+	}{
+		{
+			description:   "cluster group",
+			rid:           "rid:cg:org-id:tf-cluster-group",
+			expectedScope: clusterGroupScope,
+			expectedName:  "tf-cluster-group",
+		},
+		{
+			description:   "workspace",
+			rid:           "rid:ws:org-id:tf-workspace",
+			expectedScope: workspaceScope,
+			expectedName:  "tf-workspace",
+		},
+		{
+			description:   "namespace",
+			rid:           "rid:ns:org-id:attached:attached:tf-cluster:tf-namespace",
+			expectedScope: namespaceScope,
+			expectedName:  "tf-namespace",
+		},
+		{
+			description: "unknown resource type",
+			rid:         "rid:mc:org-id:tf-management-cluster",
+		},
+		{
+			description: "invalid resource id",
+			rid:         "tf-cluster",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			scope, name := parseSourceRid(test.rid)
+			require.Equal(t, test.expectedScope, scope)
+			require.Equal(t, test.expectedName, name)
+		})
+	}
+}
+
+func TestAddPreviewPolicies(t *testing.T) {
+	t.Parallel()
+
+	effectivePolicies := []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy{
+		{
+			FullName: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectiveFullName{OrgID: "org-id"},
+			Type:     quotaPolicyType,
+			PolicySpecs: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+				{
+					PolicyName: "cluster-quota",
+					SourceRid:  "rid:c:org-id:attached:attached:tf-cluster",
+					Spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+						Recipe: "custom",
+						Input:  map[string]interface{}{"limitsCpu": "4"},
+					},
+				},
+			},
+		},
+	}
+
+	previews := []*previewPolicy{
+		{
+			policyType: quotaPolicyType,
+			policySpec: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+				Inherited:  true,
+				PolicyName: "org-quota",
+				Spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+					Recipe: "custom",
+					Input:  map[string]interface{}{"limitsCpu": "2"},
+				},
+			},
+		},
+		{
+			policyType: securityPolicyType,
+			policySpec: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+				Inherited:  true,
+				PolicyName: "org-baseline",
+				Spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+					Recipe: "baseline",
+					Input:  map[string]interface{}{"audit": true},
+				},
+			},
+		},
+	}
+
+	policies, previewSpecs := addPreviewPolicies(effectivePolicies, previews)
+
+	actual, err := flattenEffectivePolicies(policies, previewSpecs)
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+
+	quota, _ := actual[0].(map[string]interface{})
+	require.Equal(t, `{"limitsCpu":"2"}`, quota[mergedInputKey])
+
+	quotaPolicies, _ := quota[policiesKey].([]interface{})
+	require.Len(t, quotaPolicies, 2)
+	require.Equal(t, false, quotaPolicies[0].(map[string]interface{})[previewKey])
+
+	preview, _ := quotaPolicies[1].(map[string]interface{})
+	require.Equal(t, true, preview[previewKey])
+	require.Equal(t, organizationScope, preview[sourceScopeKey])
+	require.Equal(t, "org-id", preview[sourceNameKey])
+
+	security, _ := actual[1].(map[string]interface{})
+	require.Equal(t, securityPolicyType, security[policyTypeKey])
+	require.Equal(t, `{"audit":true,"disableNativePsp":false,"recipes":["baseline"]}`, security[mergedInputKey])
+}
@@ -0,0 +1,160 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicies
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+const (
+	recipesField          = "recipes"
+	auditField            = "audit"
+	disableNativePspField = "disableNativePsp"
+)
+
+// mergeInputs merges the recipe inputs of the policies of one type into the parameters effectively applied,
+// for the policy types whose policies combine into a single limit. Nil is returned for the other types.
+func mergeInputs(policyType string, specs []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) map[string]interface{} {
+	switch policyType {
+	case quotaPolicyType:
+		return mergeQuotaInputs(specs)
+	case securityPolicyType:
+		return mergeSecurityInputs(specs)
+	}
+
+	return nil
+}
+
+// mergeQuotaInputs keeps the lowest value of every quota, as all the resource quotas created for the policies are enforced together.
+func mergeQuotaInputs(specs []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) map[string]interface{} {
+	merged := map[string]interface{}{}
+
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+
+		if input, ok := spec.Input.(map[string]interface{}); ok {
+			mergeQuotaValues(merged, input)
+		}
+	}
+
+	return merged
+}
+
+func mergeQuotaValues(merged, input map[string]interface{}) {
+	for key, value := range input {
+		current, exists := merged[key]
+		if !exists {
+			if nested, ok := value.(map[string]interface{}); ok {
+				value = copyMap(nested)
+			}
+
+			merged[key] = value
+
+			continue
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if nested, ok := current.(map[string]interface{}); ok {
+				mergeQuotaValues(nested, v)
+			}
+		case float64:
+			if c, ok := current.(float64); ok && v < c {
+				merged[key] = v
+			}
+		case string:
+			if c, ok := current.(string); ok && lowerQuantity(v, c) {
+				merged[key] = v
+			}
+		}
+	}
+}
+
+// lowerQuantity reports whether quantity a is lower than quantity b, quantities failing to parse are never lower.
+func lowerQuantity(a, b string) bool {
+	qa, err := resource.ParseQuantity(a)
+	if err != nil {
+		return false
+	}
+
+	qb, err := resource.ParseQuantity(b)
+	if err != nil {
+		return true
+	}
+
+	return qa.Cmp(qb) < 0
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(m))
+
+	for key, value := range m {
+		if nested, ok := value.(map[string]interface{}); ok {
+			value = copyMap(nested)
+		}
+
+		copied[key] = value
+	}
+
+	return copied
+}
+
+// mergeSecurityInputs lists the recipes applied, the constraints of all of them are enforced together.
+// The policies are only in audit (dry-run) mode when every policy is, recipes without audit mode are enforcing.
+func mergeSecurityInputs(specs []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) map[string]interface{} {
+	var (
+		recipes          []string
+		audit            = len(specs) != 0
+		disableNativePsp bool
+	)
+
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+
+		recipes = appendUnique(recipes, spec.Recipe)
+
+		input, _ := spec.Input.(map[string]interface{})
+
+		if a, ok := input[auditField].(bool); !ok || !a {
+			audit = false
+		}
+
+		if d, ok := input[disableNativePspField].(bool); ok && d {
+			disableNativePsp = true
+		}
+	}
+
+	sort.Strings(recipes)
+
+	return map[string]interface{}{
+		recipesField:          recipes,
+		auditField:            audit,
+		disableNativePspField: disableNativePsp,
+	}
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+func TestMergeInputs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		policyType  string
+		input       []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+		expected    map[string]interface{}
+	}{
+		{
+			description: "policy type without merged input",
+			policyType:  "image-policy",
+			input: []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+				{Recipe: "allowed-name-tag"},
+			},
+		},
+		{
+			description: "quota policies keep the lowest values",
+			policyType:  quotaPolicyType,
+			input: []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+				{
+					Recipe: "custom",
+					Input: map[string]interface{}{
+						"limitsCpu":              "4",
+						"requestsMemory":         "2Gi",
+						"persistentvolumeclaims": float64(10),
+						"resourceCounts": map[string]interface{}{
+							"pods": float64(20),
+						},
+					},
+				},
+				nil,
+				{
+					Recipe: "custom",
+					Input: map[string]interface{}{
+						"limitsCpu":              "2000m",
+						"requestsMemory":         "4Gi",
+						"requestsStorage":        "100Gi",
+						"persistentvolumeclaims": float64(5),
+						"resourceCounts": map[string]interface{}{
+							"pods":     float64(30),
+							"services": float64(4),
+						},
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"limitsCpu":              "2000m",
+				"requestsMemory":         "2Gi",
+				"requestsStorage":        "100Gi",
+				"persistentvolumeclaims": float64(5),
+				"resourceCounts": map[string]interface{}{
+					"pods":     float64(20),
+					"services": float64(4),
+				},
+			},
+		},
+		{
+			description: "security policies in audit mode",
+			policyType:  securityPolicyType,
+			input: []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+				{Recipe: "baseline", Input: map[string]interface{}{"audit": true}},
+				{Recipe: "strict", Input: map[string]interface{}{"audit": true, "disableNativePsp": true}},
+			},
+			expected: map[string]interface{}{
+				recipesField:          []string{"baseline", "strict"},
+				auditField:            true,
+				disableNativePspField: true,
+			},
+		},
+		{
+			description: "security policies with an enforcing recipe",
+			policyType:  securityPolicyType,
+			input: []*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+				{Recipe: "strict", Input: map[string]interface{}{"audit": true}},
+				{Recipe: "pod_security_admission", Input: map[string]interface{}{"enforce": map[string]interface{}{"level": "baseline"}}},
+				{Recipe: "strict", Input: map[string]interface{}{"audit": true}},
+			},
+			expected: map[string]interface{}{
+				recipesField:          []string{"pod_security_admission", "strict"},
+				auditField:            false,
+				disableNativePspField: false,
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := mergeInputs(test.policyType, test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicies
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+)
+
+var previewPolicySchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Organization policies which are not created yet, listed and merged with the effective policies to preview their impact",
+	Optional:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			nameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the policy",
+				Required:    true,
+			},
+			policyTypeKey: {
+				Type:         schema.TypeString,
+				Description:  fmt.Sprintf("Type of the policy, one of: %s", strings.Join(policyTypesAllowed, ", ")),
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(policyTypesAllowed, false),
+			},
+			recipeKey: {
+				Type:        schema.TypeString,
+				Description: "Recipe of the policy",
+				Required:    true,
+			},
+			recipeVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Version of the recipe of the policy",
+				Optional:    true,
+			},
+			inputKey: {
+				Type:         schema.TypeString,
+				Description:  "JSON encoded input of the policy recipe, e.g. jsonencode({ audit = true })",
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+	},
+}
+
+// previewPolicy is an organization policy which is not created yet.
+type previewPolicy struct {
+	policyType string
+	policySpec *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec
+}
+
+func constructPreviewPolicies(d *schema.ResourceData) ([]*previewPolicy, error) {
+	data, _ := d.Get(previewPolicyKey).([]interface{})
+	filter, _ := d.Get(policyTypeKey).(string)
+	previews := make([]*previewPolicy, 0, len(data))
+
+	for _, raw := range data {
+		policyData, _ := raw.(map[string]interface{})
+		if policyData == nil {
+			continue
+		}
+
+		name, _ := policyData[nameKey].(string)
+		policyType, _ := policyData[policyTypeKey].(string)
+
+		if filter != "" && policyType != filter {
+			return nil, errors.Errorf("preview policy %s of type %s does not match policy_type %s", name, policyType, filter)
+		}
+
+		spec := &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{}
+		spec.Recipe, _ = policyData[recipeKey].(string)
+		spec.RecipeVersion, _ = policyData[recipeVersionKey].(string)
+
+		if input, _ := policyData[inputKey].(string); input != "" {
+			if err := json.Unmarshal([]byte(input), &spec.Input); err != nil {
+				return nil, errors.Wrapf(err, "Unable to decode the input of preview policy %s", name)
+			}
+		}
+
+		previews = append(previews, &previewPolicy{
+			policyType: policyType,
+			policySpec: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+				Inherited:  true,
+				PolicyName: name,
+				Spec:       spec,
+			},
+		})
+	}
+
+	return previews, nil
+}
+
+// addPreviewPolicies adds the preview policies to the effective policies of their type, as organization policies inherited
+// by the cluster or namespace. It returns the effective policies and the policy specs added for the previews.
+func addPreviewPolicies(effectivePolicies []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy, previews []*previewPolicy) (
+	[]*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy, map[*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec]bool) {
+	previewSpecs := make(map[*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec]bool, len(previews))
+	orgID := ""
+
+	for _, effectivePolicy := range effectivePolicies {
+		if effectivePolicy != nil && effectivePolicy.FullName != nil && effectivePolicy.FullName.OrgID != "" {
+			orgID = effectivePolicy.FullName.OrgID
+			break
+		}
+	}
+
+	for _, preview := range previews {
+		preview.policySpec.SourceRid = fmt.Sprintf("rid:o:%s", orgID)
+		previewSpecs[preview.policySpec] = true
+
+		var target *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy
+
+		for _, effectivePolicy := range effectivePolicies {
+			if effectivePolicy != nil && effectivePolicy.Type == preview.policyType {
+				target = effectivePolicy
+				break
+			}
+		}
+
+		if target == nil {
+			target = &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy{Type: preview.policyType}
+			effectivePolicies = append(effectivePolicies, target)
+		}
+
+		target.PolicySpecs = append(target.PolicySpecs, preview.policySpec)
+	}
+
+	return effectivePolicies, previewSpecs
+}
@@ -0,0 +1,165 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+func exclusionsData() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			ExcludedNamespacesKey: []interface{}{"kube-system", "kube-public"},
+			NamespaceSelectorKey: []interface{}{
+				map[string]interface{}{
+					MatchExpressionsKey: []interface{}{
+						map[string]interface{}{
+							KeyKey:      "security-exempt",
+							OperatorKey: "Exists",
+							ValuesKey:   []interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExcludeNamespaces(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description       string
+		namespaceSelector *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector
+		exclusions        []interface{}
+		expected          *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector
+	}{
+		{
+			description: "check for nil exclusions",
+			exclusions:  nil,
+			expected:    nil,
+		},
+		{
+			description: "exclusions without namespace selector",
+			exclusions:  exclusionsData(),
+			expected: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+					{Key: NamespaceNameLabelKey, Operator: "NotIn", Values: []string{"kube-system", "kube-public"}},
+					{Key: "security-exempt", Operator: "DoesNotExist", Values: []string{}},
+				},
+			},
+		},
+		{
+			description: "exclusions appended to the namespace selector",
+			namespaceSelector: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+					{Key: "team", Operator: "In", Values: []string{"a"}},
+				},
+			},
+			exclusions: []interface{}{
+				map[string]interface{}{
+					NamespaceSelectorKey: []interface{}{
+						map[string]interface{}{
+							MatchExpressionsKey: []interface{}{
+								map[string]interface{}{
+									KeyKey:      "tier",
+									OperatorKey: "In",
+									ValuesKey:   []interface{}{"platform"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+					{Key: "team", Operator: "In", Values: []string{"a"}},
+					{Key: "tier", Operator: "NotIn", Values: []string{"platform"}},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := ExcludeNamespaces(test.namespaceSelector, test.exclusions)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenSpecExclusions(t *testing.T) {
+	t.Parallel()
+
+	teamExpression := map[string]interface{}{KeyKey: "team", OperatorKey: "In", ValuesKey: []string{"a"}}
+	namesExpression := map[string]interface{}{KeyKey: NamespaceNameLabelKey, OperatorKey: "NotIn", ValuesKey: []string{"kube-system", "kube-public"}}
+	labelExpression := map[string]interface{}{KeyKey: "security-exempt", OperatorKey: "DoesNotExist", ValuesKey: []string(nil)}
+
+	flattenedSpec := func(expressions ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				InputKey:             []interface{}{},
+				NamespaceSelectorKey: []interface{}{map[string]interface{}{MatchExpressionsKey: expressions}},
+			},
+		}
+	}
+
+	cases := []struct {
+		description string
+		flattened   []interface{}
+		prior       []interface{}
+		expected    []interface{}
+	}{
+		{
+			description: "no exclusions in the prior spec",
+			flattened:   flattenedSpec(teamExpression),
+			prior:       []interface{}{map[string]interface{}{}},
+			expected:    flattenedSpec(teamExpression),
+		},
+		{
+			description: "exclusion expressions moved out of the namespace selector",
+			flattened:   flattenedSpec(teamExpression, namesExpression, labelExpression),
+			prior:       []interface{}{map[string]interface{}{ExclusionsKey: exclusionsData()}},
+			expected: []interface{}{
+				map[string]interface{}{
+					InputKey:             []interface{}{},
+					NamespaceSelectorKey: []interface{}{map[string]interface{}{MatchExpressionsKey: []interface{}{teamExpression}}},
+					ExclusionsKey:        exclusionsData(),
+				},
+			},
+		},
+		{
+			description: "namespace selector made of the exclusion expressions only",
+			flattened:   flattenedSpec(namesExpression, labelExpression),
+			prior:       []interface{}{map[string]interface{}{ExclusionsKey: exclusionsData()}},
+			expected: []interface{}{
+				map[string]interface{}{
+					InputKey:      []interface{}{},
+					ExclusionsKey: exclusionsData(),
+				},
+			},
+		},
+		{
+			description: "exclusion expressions changed outside of Terraform",
+			flattened:   flattenedSpec(teamExpression, labelExpression),
+			prior:       []interface{}{map[string]interface{}{ExclusionsKey: exclusionsData()}},
+			expected:    flattenedSpec(teamExpression, labelExpression),
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecExclusions(test.flattened, test.prior)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
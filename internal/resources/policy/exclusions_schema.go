@@ -0,0 +1,231 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// NamespaceNameLabelKey is the label set by Kubernetes on every namespace with the name of the namespace as value.
+const NamespaceNameLabelKey = "kubernetes.io/metadata.name"
+
+// Exclusions is translated into match expressions appended to the namespace selector of the policy, the policy API
+// has no exclusions of its own and only selects the namespaces a policy applies to.
+var Exclusions = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Namespaces the policy is not applied to, the exclusions are added to the namespace selector of the policy",
+	Optional:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			ExcludedNamespacesKey: {
+				Type:        schema.TypeList,
+				Description: "Names of the excluded namespaces",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNamespaceName,
+				},
+			},
+			NamespaceSelectorKey: {
+				Type:        schema.TypeList,
+				Description: "Label based selector of the excluded namespaces, it must have a single match expression",
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        NamespaceSelector.Elem,
+			},
+		},
+	},
+}
+
+// negatedOperators maps each label selector operator to the operator selecting every other namespace.
+var negatedOperators = map[string]string{
+	"In":           "NotIn",
+	"NotIn":        "In",
+	"Exists":       "DoesNotExist",
+	"DoesNotExist": "Exists",
+}
+
+func validateNamespaceName(v interface{}, k string) (warnings []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if msgs := validation.IsDNS1123Label(value); len(msgs) != 0 {
+		errs = append(errs, fmt.Errorf("%s: %q is not a valid namespace name: %s", k, value, strings.Join(msgs, "; ")))
+	}
+
+	return warnings, errs
+}
+
+// ExcludeNamespaces appends the match expressions translated from the exclusions block data to the namespace selector.
+// Names are excluded with a NotIn expression on the namespace name label, and the expression of the exclusion selector is negated.
+func ExcludeNamespaces(namespaceSelector *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector, data []interface{}) *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector {
+	expressions := constructExclusionExpressions(data)
+	if len(expressions) == 0 {
+		return namespaceSelector
+	}
+
+	if namespaceSelector == nil {
+		namespaceSelector = &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+			MatchExpressions: make([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement, 0),
+		}
+	}
+
+	namespaceSelector.MatchExpressions = append(namespaceSelector.MatchExpressions, expressions...)
+
+	return namespaceSelector
+}
+
+func constructExclusionExpressions(data []interface{}) (expressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) {
+	if len(data) == 0 || data[0] == nil {
+		return expressions
+	}
+
+	exclusionsData, _ := data[0].(map[string]interface{})
+
+	if names := constructStringList(exclusionsData[ExcludedNamespacesKey]); len(names) != 0 {
+		expressions = append(expressions, &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+			Key:      NamespaceNameLabelKey,
+			Operator: "NotIn",
+			Values:   names,
+		})
+	}
+
+	if v, ok := exclusionsData[NamespaceSelectorKey].([]interface{}); ok {
+		excludedSelector := ConstructNamespaceSelector(v)
+
+		if excludedSelector != nil && len(excludedSelector.MatchExpressions) == 1 && excludedSelector.MatchExpressions[0] != nil {
+			expression := *excludedSelector.MatchExpressions[0]
+			expression.Operator = negatedOperators[expression.Operator]
+
+			expressions = append(expressions, &expression)
+		}
+	}
+
+	return expressions
+}
+
+func constructStringList(data interface{}) (values []string) {
+	list, _ := data.([]interface{})
+
+	for _, raw := range list {
+		if value, ok := raw.(string); ok {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// FlattenSpecExclusions moves the match expressions translated from the exclusions of the prior spec data out of the namespace
+// selector of the flattened spec, and sets the exclusions back. When the namespace selector does not end with these
+// expressions, e.g. after an import or a change outside of Terraform, every expression is kept in the namespace selector.
+func FlattenSpecExclusions(flattenedSpec, priorSpec []interface{}) []interface{} {
+	if len(flattenedSpec) == 0 || flattenedSpec[0] == nil || len(priorSpec) == 0 || priorSpec[0] == nil {
+		return flattenedSpec
+	}
+
+	exclusionsData, _ := priorSpec[0].(map[string]interface{})[ExclusionsKey].([]interface{})
+
+	expressions := constructExclusionExpressions(exclusionsData)
+	if len(expressions) == 0 {
+		return flattenedSpec
+	}
+
+	specData, _ := flattenedSpec[0].(map[string]interface{})
+
+	namespaceSelectorData, _ := specData[NamespaceSelectorKey].([]interface{})
+	if len(namespaceSelectorData) == 0 || namespaceSelectorData[0] == nil {
+		return flattenedSpec
+	}
+
+	matchExpressions, _ := namespaceSelectorData[0].(map[string]interface{})[MatchExpressionsKey].([]interface{})
+
+	kept := len(matchExpressions) - len(expressions)
+	if kept < 0 {
+		return flattenedSpec
+	}
+
+	for i, expression := range expressions {
+		if !matchesLabelSelectorRequirement(matchExpressions[kept+i], expression) {
+			return flattenedSpec
+		}
+	}
+
+	if kept == 0 {
+		delete(specData, NamespaceSelectorKey)
+	} else {
+		specData[NamespaceSelectorKey] = []interface{}{map[string]interface{}{MatchExpressionsKey: matchExpressions[:kept]}}
+	}
+
+	specData[ExclusionsKey] = exclusionsData
+
+	return flattenedSpec
+}
+
+// matchesLabelSelectorRequirement compares flattened match expression data with a match expression, empty and missing values are equal.
+func matchesLabelSelectorRequirement(data interface{}, expression *policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) bool {
+	expressionData, _ := data.(map[string]interface{})
+	values, _ := expressionData[ValuesKey].([]string)
+
+	if len(values) == 0 && len(expression.Values) == 0 {
+		values = expression.Values
+	}
+
+	return expressionData[KeyKey] == expression.Key && expressionData[OperatorKey] == expression.Operator && reflect.DeepEqual(values, expression.Values)
+}
+
+// ValidateSpecExclusions checks the exclusions of the policy can be translated into its namespace selector.
+func ValidateSpecExclusions(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	return ValidateExclusionsAt(diff, SpecKey)
+}
+
+// ValidateExclusionsAt checks the exclusions of the spec block found at the given key of the diff can be translated into
+// match expressions: the selector of the excluded namespaces must have a single match expression, as the negation of
+// several expressions would select the namespaces matching any of them which a namespace selector can not express.
+func ValidateExclusionsAt(diff *schema.ResourceDiff, specKey string) error {
+	specValue, ok := diff.GetOk(specKey)
+	if !ok {
+		return nil
+	}
+
+	specData, _ := specValue.([]interface{})
+	if len(specData) == 0 || specData[0] == nil {
+		return nil
+	}
+
+	exclusionsData, _ := specData[0].(map[string]interface{})[ExclusionsKey].([]interface{})
+	if len(exclusionsData) == 0 || exclusionsData[0] == nil {
+		return nil
+	}
+
+	return validateExclusions(exclusionsData[0].(map[string]interface{}))
+}
+
+func validateExclusions(exclusionsData map[string]interface{}) error {
+	namespaceSelectorData, _ := exclusionsData[NamespaceSelectorKey].([]interface{})
+	if len(namespaceSelectorData) == 0 || namespaceSelectorData[0] == nil {
+		return nil
+	}
+
+	matchExpressions, _ := namespaceSelectorData[0].(map[string]interface{})[MatchExpressionsKey].([]interface{})
+
+	if len(matchExpressions) != 1 {
+		return fmt.Errorf("exclusions are not valid: the namespace selector of the excluded namespaces must have a single match expression, found %d", len(matchExpressions))
+	}
+
+	return nil
+}
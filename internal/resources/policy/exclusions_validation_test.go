@@ -0,0 +1,105 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateExclusions(t *testing.T) {
+	t.Parallel()
+
+	selector := func(expressions ...interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{MatchExpressionsKey: expressions}}
+	}
+
+	existsExpression := map[string]interface{}{KeyKey: "security-exempt", OperatorKey: "Exists", ValuesKey: []interface{}{}}
+
+	cases := []struct {
+		description string
+		exclusions  map[string]interface{}
+		expectErr   bool
+	}{
+		{
+			description: "namespaces excluded by name",
+			exclusions: map[string]interface{}{
+				ExcludedNamespacesKey: []interface{}{"kube-system"},
+			},
+		},
+		{
+			description: "namespaces excluded by a single match expression",
+			exclusions: map[string]interface{}{
+				ExcludedNamespacesKey: []interface{}{"kube-system"},
+				NamespaceSelectorKey:  selector(existsExpression),
+			},
+		},
+		{
+			description: "namespaces excluded by several match expressions",
+			exclusions: map[string]interface{}{
+				NamespaceSelectorKey: selector(existsExpression, map[string]interface{}{KeyKey: "tier", OperatorKey: "In", ValuesKey: []interface{}{"platform"}}),
+			},
+			expectErr: true,
+		},
+		{
+			description: "namespace selector without match expressions",
+			exclusions: map[string]interface{}{
+				NamespaceSelectorKey: selector(),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateExclusions(test.exclusions)
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateNamespaceName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       interface{}
+		expectErr   bool
+	}{
+		{
+			description: "valid namespace name",
+			input:       "kube-system",
+		},
+		{
+			description: "namespace name with upper case characters",
+			input:       "Kube-System",
+			expectErr:   true,
+		},
+		{
+			description: "non string namespace name",
+			input:       10,
+			expectErr:   true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, errs := validateNamespaceName(test.input, ExcludedNamespacesKey)
+			if test.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyinsights
+
+const (
+	ResourceName = "tanzu-mission-control_policy_insights"
+
+	scopeKey                 = "scope"
+	clusterGroupKey          = "cluster_group"
+	workspaceKey             = "workspace"
+	clusterKey               = "cluster"
+	managementClusterNameKey = "management_cluster_name"
+	provisionerNameKey       = "provisioner_name"
+	nameKey                  = "name"
+	policyTypeKey            = "policy_type"
+	policyNameKey            = "policy_name"
+	severityKey              = "severity"
+	queryKey                 = "query"
+	insightsKey              = "insights"
+	recipeKey                = "recipe"
+	enforcementActionKey     = "enforcement_action"
+	messageKey               = "message"
+	lastObservedTimeKey      = "last_observed_time"
+	resourceKey              = "resource"
+	apiVersionKey            = "api_version"
+	kindKey                  = "kind"
+	namespaceKey             = "namespace"
+	totalCountKey            = "total_count"
+	attachedValue            = "attached"
+)
+
+var (
+	policyTypesAllowed = []string{"custom-policy", "security-policy", "image-policy", "network-policy", "namespace-quota-policy", "mutation-policy"}
+	severitiesAllowed  = []string{"LOW", "MEDIUM", "HIGH", "CRITICAL"}
+)
@@ -0,0 +1,291 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyinsights
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	policyinsightmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insight"
+)
+
+func DataSourcePolicyInsights() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePolicyInsightsRead,
+		Schema:      policyInsightsSchema,
+		Description: "Tanzu Mission Control Policy Insights Data Source",
+	}
+}
+
+var (
+	scopeClusterGroupKey = fmt.Sprintf("%s.0.%s", scopeKey, clusterGroupKey)
+	scopeWorkspaceKey    = fmt.Sprintf("%s.0.%s", scopeKey, workspaceKey)
+	scopeClusterKey      = fmt.Sprintf("%s.0.%s", scopeKey, clusterKey)
+)
+
+var policyInsightsSchema = map[string]*schema.Schema{
+	scopeKey: {
+		Type:        schema.TypeList,
+		Description: "Scope to search the policy insights in, having at most one of cluster_group, workspace or cluster. The whole organization is searched when no scope is given.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				clusterGroupKey: {
+					Type:          schema.TypeString,
+					Description:   "Name of the cluster group",
+					Optional:      true,
+					ConflictsWith: []string{scopeWorkspaceKey, scopeClusterKey},
+				},
+				workspaceKey: {
+					Type:          schema.TypeString,
+					Description:   "Name of the workspace",
+					Optional:      true,
+					ConflictsWith: []string{scopeClusterGroupKey, scopeClusterKey},
+				},
+				clusterKey: {
+					Type:          schema.TypeList,
+					Description:   "Full name of the cluster",
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{scopeClusterGroupKey, scopeWorkspaceKey},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							managementClusterNameKey: {
+								Type:        schema.TypeString,
+								Description: "Name of the management cluster",
+								Default:     attachedValue,
+								Optional:    true,
+							},
+							provisionerNameKey: {
+								Type:        schema.TypeString,
+								Description: "Provisioner of the cluster",
+								Default:     attachedValue,
+								Optional:    true,
+							},
+							nameKey: {
+								Type:        schema.TypeString,
+								Description: "Name of the cluster",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	policyTypeKey: {
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Type of the policies to return insights for, one of: %s", strings.Join(policyTypesAllowed, ", ")),
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(policyTypesAllowed, false),
+	},
+	policyNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the policy to return insights for",
+		Optional:    true,
+	},
+	severityKey: {
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Severity of the insights to return, one of: %s", strings.Join(severitiesAllowed, ", ")),
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(severitiesAllowed, false),
+	},
+	queryKey: {
+		Type:        schema.TypeString,
+		Description: "TQL query to further filter the policy insights",
+		Optional:    true,
+	},
+	insightsKey: {
+		Type:        schema.TypeList,
+		Description: "Policy violations matching the search criteria",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				policyNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the violated policy",
+					Computed:    true,
+				},
+				policyTypeKey: {
+					Type:        schema.TypeString,
+					Description: "Type of the violated policy",
+					Computed:    true,
+				},
+				recipeKey: {
+					Type:        schema.TypeString,
+					Description: "Recipe of the violated policy",
+					Computed:    true,
+				},
+				severityKey: {
+					Type:        schema.TypeString,
+					Description: "Severity of the violation",
+					Computed:    true,
+				},
+				enforcementActionKey: {
+					Type:        schema.TypeString,
+					Description: "Enforcement action of the policy: deny, or dryrun for policies in audit mode",
+					Computed:    true,
+				},
+				managementClusterNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the management cluster of the cluster reporting the violation",
+					Computed:    true,
+				},
+				provisionerNameKey: {
+					Type:        schema.TypeString,
+					Description: "Provisioner of the cluster reporting the violation",
+					Computed:    true,
+				},
+				clusterKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the cluster reporting the violation",
+					Computed:    true,
+				},
+				resourceKey: {
+					Type:        schema.TypeList,
+					Description: "Kubernetes resource violating the policy",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							apiVersionKey: {
+								Type:        schema.TypeString,
+								Description: "API version of the resource",
+								Computed:    true,
+							},
+							kindKey: {
+								Type:        schema.TypeString,
+								Description: "Kind of the resource",
+								Computed:    true,
+							},
+							namespaceKey: {
+								Type:        schema.TypeString,
+								Description: "Namespace of the resource, empty for cluster scoped resources",
+								Computed:    true,
+							},
+							nameKey: {
+								Type:        schema.TypeString,
+								Description: "Name of the resource",
+								Computed:    true,
+							},
+						},
+					},
+				},
+				messageKey: {
+					Type:        schema.TypeString,
+					Description: "Violation message",
+					Computed:    true,
+				},
+				lastObservedTimeKey: {
+					Type:        schema.TypeString,
+					Description: "Time the violation was last observed",
+					Computed:    true,
+				},
+			},
+		},
+	},
+	totalCountKey: {
+		Type:        schema.TypeInt,
+		Description: "Number of policy violations matching the search criteria",
+		Computed:    true,
+	},
+}
+
+func constructListRequest(d *schema.ResourceData) *policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest {
+	request := &policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest{
+		SearchScope: &policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightSearchScope{},
+	}
+
+	request.SearchScope.ClusterGroupName, _ = d.Get(scopeClusterGroupKey).(string)
+	request.SearchScope.WorkspaceName, _ = d.Get(scopeWorkspaceKey).(string)
+
+	if clusterData, ok := d.Get(scopeClusterKey).([]interface{}); ok && len(clusterData) != 0 && clusterData[0] != nil {
+		cluster, _ := clusterData[0].(map[string]interface{})
+
+		request.SearchScope.ManagementClusterName, _ = cluster[managementClusterNameKey].(string)
+		request.SearchScope.ProvisionerName, _ = cluster[provisionerNameKey].(string)
+		request.SearchScope.ClusterName, _ = cluster[nameKey].(string)
+	}
+
+	request.PolicyType, _ = d.Get(policyTypeKey).(string)
+	request.PolicyName, _ = d.Get(policyNameKey).(string)
+	request.Severity, _ = d.Get(severityKey).(string)
+	request.Query, _ = d.Get(queryKey).(string)
+
+	return request
+}
+
+func dataSourcePolicyInsightsRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	request := constructListRequest(d)
+
+	insights, err := ListPolicyInsights(config, request)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "Unable to list Tanzu Mission Control policy insights"))
+	}
+
+	if err := d.Set(insightsKey, flattenInsights(insights)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(totalCountKey, len(insights)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	scope := request.SearchScope
+	idKeys := []string{scope.ClusterGroupName, scope.WorkspaceName, scope.ManagementClusterName, scope.ProvisionerName, scope.ClusterName,
+		request.PolicyType, request.PolicyName, request.Severity, request.Query}
+	d.SetId(fmt.Sprintf("policy_insights/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+func flattenInsights(insights []*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight) (data []interface{}) {
+	for _, insight := range insights {
+		if insight == nil {
+			continue
+		}
+
+		flattenData := map[string]interface{}{
+			policyNameKey:            insight.PolicyName,
+			policyTypeKey:            insight.PolicyType,
+			recipeKey:                insight.Recipe,
+			severityKey:              insight.Severity,
+			enforcementActionKey:     insight.EnforcementAction,
+			managementClusterNameKey: insight.ManagementClusterName,
+			provisionerNameKey:       insight.ProvisionerName,
+			clusterKey:               insight.ClusterName,
+			messageKey:               insight.Message,
+		}
+
+		if !time.Time(insight.LastObservedTime).IsZero() {
+			flattenData[lastObservedTimeKey] = insight.LastObservedTime.String()
+		}
+
+		if resource := insight.Resource; resource != nil {
+			flattenData[resourceKey] = []interface{}{
+				map[string]interface{}{
+					apiVersionKey: resource.APIVersion,
+					kindKey:       resource.Kind,
+					namespaceKey:  resource.Namespace,
+					nameKey:       resource.Name,
+				},
+			}
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data
+}
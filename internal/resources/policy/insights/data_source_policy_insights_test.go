@@ -0,0 +1,104 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyinsights
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	policyinsightmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insight"
+)
+
+func TestFlattenInsights(t *testing.T) {
+	t.Parallel()
+
+	observed := strfmt.DateTime(time.Date(2023, 7, 1, 10, 30, 0, 0, time.UTC))
+
+	cases := []struct {
+		description string
+		input       []*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil insights",
+		},
+		{
+			description: "check for nil insight entry in the list",
+			input:       []*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight{nil},
+		},
+		{
+			description: "normal scenario with insights",
+			input: []*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight{
+				{
+					ClusterName:           "tf-cluster",
+					EnforcementAction:     "dryrun",
+					LastObservedTime:      observed,
+					ManagementClusterName: "attached",
+					Message:               "label owner is required",
+					PolicyName:            "require-owner",
+					PolicyType:            "custom-policy",
+					ProvisionerName:       "attached",
+					Recipe:                "tmc-require-labels",
+					Resource: &policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightResource{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "web",
+						Namespace:  "default",
+					},
+					Severity: "MEDIUM",
+				},
+				{
+					PolicyName: "baseline",
+					PolicyType: "security-policy",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					policyNameKey:            "require-owner",
+					policyTypeKey:            "custom-policy",
+					recipeKey:                "tmc-require-labels",
+					severityKey:              "MEDIUM",
+					enforcementActionKey:     "dryrun",
+					managementClusterNameKey: "attached",
+					provisionerNameKey:       "attached",
+					clusterKey:               "tf-cluster",
+					messageKey:               "label owner is required",
+					lastObservedTimeKey:      observed.String(),
+					resourceKey: []interface{}{
+						map[string]interface{}{
+							apiVersionKey: "apps/v1",
+							kindKey:       "Deployment",
+							namespaceKey:  "default",
+							nameKey:       "web",
+						},
+					},
+				},
+				map[string]interface{}{
+					policyNameKey:            "baseline",
+					policyTypeKey:            "security-policy",
+					recipeKey:                "",
+					severityKey:              "",
+					enforcementActionKey:     "",
+					managementClusterNameKey: "",
+					provisionerNameKey:       "",
+					clusterKey:               "",
+					messageKey:               "",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenInsights(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
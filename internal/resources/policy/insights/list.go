@@ -0,0 +1,47 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyinsights
+
+import (
+	"strconv"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+	policyinsightmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insight"
+)
+
+// ListPolicyInsights returns all the policy insights matching the search scope and filters of the request, across all pages.
+func ListPolicyInsights(config authctx.TanzuContext, request *policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightListPolicyInsightsRequest) ([]*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight, error) {
+	insights := make([]*policyinsightmodel.VmwareTanzuManageV1alpha1PolicyInsightPolicyInsight, 0)
+
+	pageRequest := *request
+	pageRequest.IncludeTotalCount = true
+
+	err := helper.ListAllPages(helper.DefaultPageSize, func(offset, size int) (int, int, error) {
+		pageRequest.Pagination = &optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{
+			Offset: strconv.Itoa(offset),
+			Size:   strconv.Itoa(size),
+		}
+
+		resp, err := config.TMCConnection.PolicyInsightResourceService.PolicyInsightResourceServiceList(&pageRequest)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return 0, 0, nil
+			}
+
+			return 0, 0, err
+		}
+
+		insights = append(insights, resp.Insights...)
+		totalCount, _ := strconv.Atoi(resp.TotalCount)
+
+		return len(resp.Insights), totalCount, nil
+	})
+
+	return insights, err
+}
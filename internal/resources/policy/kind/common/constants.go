@@ -8,4 +8,6 @@ package common
 const (
 	APIGroupsKey = "api_groups"
 	KindsKey     = "kinds"
+	CPUKey       = "cpu"
+	MemoryKey    = "memory"
 )
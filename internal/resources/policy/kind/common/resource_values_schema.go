@@ -0,0 +1,52 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceValuesSchema returns the schema of a cpu and memory quantity pair, shared by the recipes setting container resources.
+func ResourceValuesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				CPUKey: {
+					Type:         schema.TypeString,
+					Description:  "CPU quantity, e.g. 500m",
+					Optional:     true,
+					ValidateFunc: ValidateQuantity,
+				},
+				MemoryKey: {
+					Type:         schema.TypeString,
+					Description:  "Memory quantity, e.g. 512Mi",
+					Optional:     true,
+					ValidateFunc: ValidateQuantity,
+				},
+			},
+		},
+	}
+}
+
+func ValidateQuantity(v interface{}, k string) (warnings []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := resource.ParseQuantity(value); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %q is not a valid Kubernetes quantity: %v", k, value, err))
+	}
+
+	return warnings, errs
+}
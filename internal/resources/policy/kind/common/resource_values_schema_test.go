@@ -0,0 +1,49 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateQuantity(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       interface{}
+		expectError bool
+	}{
+		{
+			description: "cpu quantity",
+			input:       "500m",
+		},
+		{
+			description: "memory quantity",
+			input:       "512Mi",
+		},
+		{
+			description: "invalid quantity",
+			input:       "one gigabyte",
+			expectError: true,
+		},
+		{
+			description: "non string value",
+			input:       1,
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, errs := ValidateQuantity(test.input, CPUKey)
+			require.Equal(t, test.expectError, len(errs) != 0)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindcustom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	policytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/template"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/recipe"
+	custompolicytemplateresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/template"
+)
+
+// ValidateCustomTemplateParameters validates the parameters of a custom_template recipe against the parameters schema of the referenced template.
+// Validation is skipped when the template is not known yet, e.g. when it is created in the same apply.
+func ValidateCustomTemplateParameters(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	customTemplateKey := fmt.Sprintf("%s.0.%s.0.%s.0", policy.SpecKey, policy.InputKey, reciperesource.CustomTemplateKey)
+	templateNameKey := fmt.Sprintf("%s.%s", customTemplateKey, reciperesource.TemplateNameKey)
+	parametersKey := fmt.Sprintf("%s.%s", customTemplateKey, reciperesource.ParametersKey)
+
+	templateName, _ := diff.Get(templateNameKey).(string)
+	if templateName == "" || !diff.NewValueKnown(templateNameKey) || !diff.NewValueKnown(parametersKey) {
+		return nil
+	}
+
+	config, ok := m.(authctx.TanzuContext)
+	if !ok || config.TMCConnection == nil || config.TMCConnection.PolicyTemplateResourceService == nil {
+		return nil
+	}
+
+	fn := &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+		Name: templateName,
+	}
+
+	resp, err := config.TMCConnection.PolicyTemplateResourceService.PolicyTemplateResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, "Unable to get Tanzu Mission Control custom policy template entry, name : %s", templateName)
+	}
+
+	if resp == nil || resp.Template == nil {
+		return nil
+	}
+
+	var parameters interface{}
+
+	if value, _ := diff.Get(parametersKey).(string); value != "" {
+		if err := json.Unmarshal([]byte(value), &parameters); err != nil {
+			return errors.Wrapf(err, "parameters of custom policy template %s are not valid JSON", templateName)
+		}
+	}
+
+	if err := custompolicytemplateresource.ValidateParameters(custompolicytemplateresource.ParametersSchema(resp.Template.Spec), parameters); err != nil {
+		return errors.Wrapf(err, "parameters are not valid for custom policy template %s", templateName)
+	}
+
+	return nil
+}
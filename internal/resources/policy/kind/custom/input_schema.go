@@ -20,7 +20,7 @@ import (
 var (
 	inputSchema = &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "Input for the custom policy, having one of the valid recipes: tmc_block_nodeport_service, tmc_block_resources, tmc_block_rolebinding_subjects, tmc_external_ips, tmc_https_ingress or tmc_require_labels.",
+		Description: "Input for the custom policy, having one of the valid recipes: tmc_block_nodeport_service, tmc_block_resources, tmc_block_rolebinding_subjects, tmc_external_ips, tmc_https_ingress, tmc_require_labels or custom_template referencing a custom policy template.",
 		Required:    true,
 		MaxItems:    1,
 		MinItems:    1,
@@ -33,10 +33,11 @@ var (
 				reciperesource.TMCExternalIPSKey:              reciperesource.TMCExternalIps,
 				reciperesource.TMCHTTPSIngressKey:             reciperesource.TMCHTTPSIngress,
 				reciperesource.TMCRequireLabelsKey:            reciperesource.TMCRequireLabels,
+				reciperesource.CustomTemplateKey:              reciperesource.CustomTemplate,
 			},
 		},
 	}
-	RecipesAllowed = [...]string{reciperesource.TMCBlockNodeportServiceKey, reciperesource.TMCBlockResourcesKey, reciperesource.TMCBlockRolebindingSubjectsKey, reciperesource.TMCExternalIPSKey, reciperesource.TMCHTTPSIngressKey, reciperesource.TMCRequireLabelsKey}
+	RecipesAllowed = [...]string{reciperesource.TMCBlockNodeportServiceKey, reciperesource.TMCBlockResourcesKey, reciperesource.TMCBlockRolebindingSubjectsKey, reciperesource.TMCExternalIPSKey, reciperesource.TMCHTTPSIngressKey, reciperesource.TMCRequireLabelsKey, reciperesource.CustomTemplateKey}
 )
 
 type (
@@ -50,6 +51,8 @@ type (
 		inputTMCExternalIps              *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TMCExternalIPS
 		inputTMCHTTPSIngress             *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TMCCommonRecipe
 		inputTMCRequireLabels            *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TMCRequireLabels
+		inputCustomTemplate              *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1CustomTemplate
+		templateName                     string
 	}
 )
 
@@ -114,6 +117,15 @@ func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
 		}
 	}
 
+	if input, ok := inputData[reciperesource.CustomTemplateKey]; ok {
+		if recipeType, ok := input.([]interface{}); ok && len(recipeType) != 0 {
+			inputRecipeData = &inputRecipe{
+				recipe: CustomTemplateRecipe,
+			}
+			inputRecipeData.templateName, inputRecipeData.inputCustomTemplate = reciperesource.ConstructCustomTemplate(recipeType)
+		}
+	}
+
 	return inputRecipeData
 }
 
@@ -137,6 +149,8 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 		flattenInputData[reciperesource.TMCHTTPSIngressKey] = reciperesource.FlattenTMCCommonRecipe(inputRecipeData.inputTMCHTTPSIngress)
 	case TMCRequireLabelsRecipe:
 		flattenInputData[reciperesource.TMCRequireLabelsKey] = reciperesource.FlattenTMCRequireLabels(inputRecipeData.inputTMCRequireLabels)
+	case CustomTemplateRecipe:
+		flattenInputData[reciperesource.CustomTemplateKey] = reciperesource.FlattenCustomTemplate(inputRecipeData.templateName, inputRecipeData.inputCustomTemplate)
 
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
@@ -146,7 +160,12 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 }
 
 func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
-	value, ok := diff.GetOk(policy.SpecKey)
+	return ValidateInputAt(diff, policy.SpecKey)
+}
+
+// ValidateInputAt checks the spec block found at the given key of the diff has exactly one valid input recipe block.
+func ValidateInputAt(diff *schema.ResourceDiff, specKey string) error {
+	value, ok := diff.GetOk(specKey)
 	if !ok {
 		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
 	}
@@ -212,6 +231,12 @@ func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}
 		}
 	}
 
+	if recipeData, ok := inputData[reciperesource.CustomTemplateKey]; ok {
+		if recipeType, ok := recipeData.([]interface{}); ok && len(recipeType) != 0 {
+			recipesFound = append(recipesFound, reciperesource.CustomTemplateKey)
+		}
+	}
+
 	if len(recipesFound) == 0 {
 		return fmt.Errorf("no valid input recipe block found: minimum one valid input recipe block is required among: %v", strings.Join(RecipesAllowed[:], `, `))
 	} else if len(recipesFound) > 1 {
@@ -0,0 +1,135 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// Package recipe contains schema and helper functions for different input recipes.
+// Contains recipe schema for recipes backed by a custom policy template.
+package recipe
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipecustommodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom"
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
+)
+
+var CustomTemplate = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for custom policy using a custom policy template",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			TemplateNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the custom policy template.",
+				Required:    true,
+			},
+			AuditKey: {
+				Type:        schema.TypeBool,
+				Description: "Audit (dry-run).",
+				Optional:    true,
+				Default:     false,
+			},
+			ParametersKey: {
+				Type:             schema.TypeString,
+				Description:      "Parameters of the constraint in JSON, validated against the parameters schema of the template.",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: isParametersEqual,
+			},
+			TargetKubernetesResourcesKey: common.TargetKubernetesResourcesSchema,
+		},
+	},
+}
+
+func ConstructCustomTemplate(data []interface{}) (templateName string, customTemplate *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1CustomTemplate) {
+	if len(data) == 0 || data[0] == nil {
+		return templateName, customTemplate
+	}
+
+	customTemplateData, _ := data[0].(map[string]interface{})
+
+	customTemplate = &policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1CustomTemplate{}
+
+	if v, ok := customTemplateData[TemplateNameKey]; ok {
+		helper.SetPrimitiveValue(v, &templateName, TemplateNameKey)
+	}
+
+	if v, ok := customTemplateData[AuditKey]; ok {
+		helper.SetPrimitiveValue(v, &customTemplate.Audit, AuditKey)
+	}
+
+	if v, ok := customTemplateData[ParametersKey].(string); ok && v != "" {
+		parameters := make(map[string]interface{})
+
+		if err := json.Unmarshal([]byte(v), &parameters); err == nil {
+			customTemplate.Parameters = parameters
+		}
+	}
+
+	if v, ok := customTemplateData[TargetKubernetesResourcesKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			if len(vs) != 0 && vs[0] != nil {
+				customTemplate.TargetKubernetesResources = make([]*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources, 0)
+
+				for _, raw := range vs {
+					customTemplate.TargetKubernetesResources = append(customTemplate.TargetKubernetesResources, common.ExpandTargetKubernetesResources(raw))
+				}
+			}
+		}
+	}
+
+	return templateName, customTemplate
+}
+
+func FlattenCustomTemplate(templateName string, customTemplate *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1CustomTemplate) (data []interface{}) {
+	if customTemplate == nil {
+		return data
+	}
+
+	flattenCustomTemplate := make(map[string]interface{})
+
+	flattenCustomTemplate[TemplateNameKey] = templateName
+	flattenCustomTemplate[AuditKey] = customTemplate.Audit
+
+	if len(customTemplate.Parameters) != 0 {
+		if b, err := json.Marshal(customTemplate.Parameters); err == nil {
+			flattenCustomTemplate[ParametersKey] = string(b)
+		}
+	}
+
+	if customTemplate.TargetKubernetesResources != nil {
+		var tkrs []interface{}
+
+		for _, tkr := range customTemplate.TargetKubernetesResources {
+			tkrs = append(tkrs, common.FlattenTargetKubernetesResources(tkr))
+		}
+
+		flattenCustomTemplate[TargetKubernetesResourcesKey] = tkrs
+	}
+
+	return []interface{}{flattenCustomTemplate}
+}
+
+func isParametersEqual(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	var oldJSON, newJSON interface{}
+
+	if err := json.Unmarshal([]byte(oldValue), &oldJSON); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(newValue), &newJSON); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldJSON, newJSON)
+}
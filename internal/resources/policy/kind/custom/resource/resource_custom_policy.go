@@ -26,7 +26,9 @@ func ResourceCustomPolicy() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindcustom.ResourceName])),
 			policykindcustom.ValidateInput,
+			policykindcustom.ValidateCustomTemplateParameters,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateSpecExclusions,
 		),
 	}
 }
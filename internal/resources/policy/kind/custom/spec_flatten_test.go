@@ -93,6 +93,34 @@ func TestFlattenSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "spec referencing a custom policy template",
+			input: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+				Input:  constructCustomTemplateInput(),
+				Recipe: "k8srequiredannotations",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					policy.InputKey: []interface{}{
+						map[string]interface{}{
+							reciperesource.CustomTemplateKey: []interface{}{
+								map[string]interface{}{
+									reciperesource.TemplateNameKey: "k8srequiredannotations",
+									reciperesource.AuditKey:        false,
+									reciperesource.ParametersKey:   `{"annotations":["owner"]}`,
+									reciperesource.TargetKubernetesResourcesKey: []interface{}{
+										map[string]interface{}{
+											reciperesource.APIGroupsKey: []string{"apps"},
+											reciperesource.KindsKey:     []string{"Deployment"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, each := range cases {
@@ -127,3 +155,29 @@ func constructTMCHTTPSIngressInput() (tmcHTTPSIngressRecipeInput map[string]inte
 
 	return tmcHTTPSIngressRecipeInput
 }
+
+func constructCustomTemplateInput() (customTemplateRecipeInput map[string]interface{}) {
+	customTemplateInput := policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1CustomTemplate{
+		Parameters: map[string]interface{}{
+			"annotations": []interface{}{"owner"},
+		},
+		TargetKubernetesResources: []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources{
+			{
+				APIGroups: []string{"apps"},
+				Kinds:     []string{"Deployment"},
+			},
+		},
+	}
+
+	binary, err := customTemplateInput.MarshalBinary()
+	if err != nil {
+		return nil
+	}
+
+	err = json.Unmarshal(binary, &customTemplateRecipeInput)
+	if err != nil {
+		return nil
+	}
+
+	return customTemplateRecipeInput
+}
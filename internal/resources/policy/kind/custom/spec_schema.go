@@ -26,6 +26,7 @@ var SpecSchema = &schema.Schema{
 		Schema: map[string]*schema.Schema{
 			policy.InputKey:             inputSchema,
 			policy.NamespaceSelectorKey: policy.NamespaceSelector,
+			policy.ExclusionsKey:        policy.Exclusions,
 		},
 	},
 }
@@ -38,6 +39,11 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 
 	data, _ := value.([]interface{})
 
+	return ConstructSpecFromData(data)
+}
+
+// ConstructSpecFromData constructs the custom policy spec from the data of a spec block.
+func ConstructSpecFromData(data []interface{}) (spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) {
 	if len(data) == 0 || data[0] == nil {
 		return spec
 	}
@@ -67,6 +73,11 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 
 	spec.Recipe = strings.ReplaceAll(string(inputRecipeData.recipe), "_", "-")
 
+	// Custom template recipes are named after the template they reference.
+	if inputRecipeData.recipe == CustomTemplateRecipe {
+		spec.Recipe = inputRecipeData.templateName
+	}
+
 	switch inputRecipeData.recipe {
 	case TMCBlockNodeportServiceRecipe:
 		if inputRecipeData.inputTMCBlockNodeportService != nil {
@@ -92,6 +103,10 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		if inputRecipeData.inputTMCRequireLabels != nil {
 			spec.Input = *inputRecipeData.inputTMCRequireLabels
 		}
+	case CustomTemplateRecipe:
+		if inputRecipeData.inputCustomTemplate != nil {
+			spec.Input = *inputRecipeData.inputCustomTemplate
+		}
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -102,6 +117,12 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		}
 	}
 
+	if v, ok := specData[policy.ExclusionsKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			spec.NamespaceSelector = policy.ExcludeNamespaces(spec.NamespaceSelector, v1)
+		}
+	}
+
 	return spec
 }
 
@@ -203,6 +224,20 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 		}
 	case string(UnknownRecipe):
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
+	default:
+		// Any other recipe is the name of a custom policy template.
+		var customTemplateRecipeInput policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1CustomTemplate
+
+		err = customTemplateRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:              CustomTemplateRecipe,
+			inputCustomTemplate: &customTemplateRecipeInput,
+			templateName:        spec.Recipe,
+		}
 	}
 
 	flattenSpecData[policy.InputKey] = flattenInput(inputRecipeData)
@@ -0,0 +1,30 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplateresource
+
+const (
+	ResourceName = "tanzu-mission-control_custom_policy_template"
+
+	NameKey             = "name"
+	SpecKey             = "spec"
+	objectTypeKey       = "object_type"
+	templateTypeKey     = "template_type"
+	isDeprecatedKey     = "is_deprecated"
+	dataInventoryKey    = "data_inventory"
+	groupKey            = "group"
+	versionKey          = "version"
+	kindKey             = "kind"
+	constraintKindKey   = "constraint_kind"
+	regoKey             = "rego"
+	libsKey             = "libs"
+	parametersSchemaKey = "parameters_schema"
+
+	defaultObjectType   = "ConstraintTemplate"
+	defaultTemplateType = "OPAGatekeeper"
+
+	constraintTemplateAPIVersion = "templates.gatekeeper.sh/v1beta1"
+	gatekeeperAdmissionTarget    = "admission.k8s.gatekeeper.sh"
+)
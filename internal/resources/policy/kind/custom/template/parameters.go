@@ -0,0 +1,162 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplateresource
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+const parametersRootPath = "parameters"
+
+// ValidateParameters checks custom policy parameters against the OpenAPI v3 parameters schema of a policy template.
+// Only the subset of keywords gatekeeper relies on is enforced: type, properties, additionalProperties, required, items and enum.
+func ValidateParameters(parametersSchema map[string]interface{}, parameters interface{}) error {
+	if len(parametersSchema) == 0 {
+		if isEmptyParameters(parameters) {
+			return nil
+		}
+
+		return fmt.Errorf("%s: template does not accept any parameters", parametersRootPath)
+	}
+
+	return validateValue(parametersRootPath, parametersSchema, parameters)
+}
+
+func validateValue(path string, valueSchema map[string]interface{}, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	if enum, ok := valueSchema["enum"].([]interface{}); ok && len(enum) != 0 && !containsValue(enum, value) {
+		return fmt.Errorf("%s: value %v is not one of %v", path, value, enum)
+	}
+
+	valueType, _ := valueSchema["type"].(string)
+
+	switch valueType {
+	case "object":
+		return validateObject(path, valueSchema, value)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected an array, got %T", path, value)
+		}
+
+		itemSchema, _ := valueSchema["items"].(map[string]interface{})
+
+		for i, item := range items {
+			if err := validateValue(fmt.Sprintf("%s[%d]", path, i), itemSchema, item); err != nil {
+				return err
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected a string, got %T", path, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean, got %T", path, value)
+		}
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return fmt.Errorf("%s: expected an integer, got %v", path, value)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s: expected a number, got %T", path, value)
+		}
+	case "":
+		if _, ok := valueSchema["properties"]; ok {
+			return validateObject(path, valueSchema, value)
+		}
+	}
+
+	return nil
+}
+
+func validateObject(path string, valueSchema map[string]interface{}, value interface{}) error {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s: expected an object, got %T", path, value)
+	}
+
+	if required, ok := valueSchema["required"].([]interface{}); ok {
+		for _, raw := range required {
+			key, _ := raw.(string)
+
+			if _, ok := object[key]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, key)
+			}
+		}
+	}
+
+	properties, _ := valueSchema["properties"].(map[string]interface{})
+	additionalProperties, hasAdditionalProperties := valueSchema["additionalProperties"]
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		propertyPath := strings.Join([]string{path, key}, ".")
+
+		if propertySchema, ok := properties[key].(map[string]interface{}); ok {
+			if err := validateValue(propertyPath, propertySchema, object[key]); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		switch additional := additionalProperties.(type) {
+		case map[string]interface{}:
+			if err := validateValue(propertyPath, additional, object[key]); err != nil {
+				return err
+			}
+		case bool:
+			if !additional {
+				return fmt.Errorf("%s: unknown property", propertyPath)
+			}
+		default:
+			// Structural schemas reject unknown fields unless they are explicitly preserved.
+			if !hasAdditionalProperties && properties != nil {
+				if preserve, _ := valueSchema["x-kubernetes-preserve-unknown-fields"].(bool); !preserve {
+					return fmt.Errorf("%s: unknown property", propertyPath)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if fmt.Sprint(v) == fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isEmptyParameters(parameters interface{}) bool {
+	switch p := parameters.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(p) == 0
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplateresource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const requiredLabelsSchema = `{
+  "properties": {
+    "labels": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["key"],
+        "properties": {
+          "key": {"type": "string"},
+          "allowedRegex": {"type": "string"}
+        }
+      }
+    },
+    "mode": {"type": "string", "enum": ["enforce", "warn"]},
+    "maxReplicas": {"type": "integer"}
+  }
+}`
+
+func TestValidateParameters(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		schema      string
+		parameters  string
+		expectError bool
+	}{
+		{
+			description: "valid parameters",
+			schema:      requiredLabelsSchema,
+			parameters:  `{"labels": [{"key": "owner", "allowedRegex": "^[a-z]+$"}], "mode": "warn", "maxReplicas": 3}`,
+		},
+		{
+			description: "no parameters",
+			schema:      requiredLabelsSchema,
+		},
+		{
+			description: "wrong type",
+			schema:      requiredLabelsSchema,
+			parameters:  `{"labels": "owner"}`,
+			expectError: true,
+		},
+		{
+			description: "missing required property",
+			schema:      requiredLabelsSchema,
+			parameters:  `{"labels": [{"allowedRegex": ".*"}]}`,
+			expectError: true,
+		},
+		{
+			description: "unknown property",
+			schema:      requiredLabelsSchema,
+			parameters:  `{"label": [{"key": "owner"}]}`,
+			expectError: true,
+		},
+		{
+			description: "value not in enum",
+			schema:      requiredLabelsSchema,
+			parameters:  `{"mode": "audit"}`,
+			expectError: true,
+		},
+		{
+			description: "fractional integer",
+			schema:      requiredLabelsSchema,
+			parameters:  `{"maxReplicas": 1.5}`,
+			expectError: true,
+		},
+		{
+			description: "parameters for a template without parameters",
+			parameters:  `{"mode": "warn"}`,
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			var (
+				parametersSchema map[string]interface{}
+				parameters       interface{}
+			)
+
+			if test.schema != "" {
+				require.NoError(t, json.Unmarshal([]byte(test.schema), &parametersSchema))
+			}
+
+			if test.parameters != "" {
+				require.NoError(t, json.Unmarshal([]byte(test.parameters), &parameters))
+			}
+
+			err := ValidateParameters(parametersSchema, parameters)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
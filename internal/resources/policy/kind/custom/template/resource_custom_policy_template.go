@@ -0,0 +1,208 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplateresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	policytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/template"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func ResourceCustomPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomPolicyTemplateCreate,
+		ReadContext:   resourceCustomPolicyTemplateRead,
+		UpdateContext: resourceCustomPolicyTemplateInPlaceUpdate,
+		DeleteContext: resourceCustomPolicyTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCustomPolicyTemplateImporter,
+		},
+		Schema:        customPolicyTemplateSchema,
+		CustomizeDiff: validateConstraintKind,
+	}
+}
+
+var customPolicyTemplateSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the custom policy template",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	SpecKey:        specSchema,
+}
+
+// validateConstraintKind enforces the gatekeeper requirement that a ConstraintTemplate is named after its constraint kind.
+func validateConstraintKind(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown(NameKey) || !diff.NewValueKnown(SpecKey) {
+		return nil
+	}
+
+	name, _ := diff.Get(NameKey).(string)
+	constraintKind, _ := diff.Get(fmt.Sprintf("%s.0.%s", SpecKey, constraintKindKey)).(string)
+
+	if constraintKind != "" && name != strings.ToLower(constraintKind) {
+		return fmt.Errorf("name %q of the custom policy template must be the lower case of its constraint_kind %q", name, constraintKind)
+	}
+
+	return nil
+}
+
+func constructFullName(d *schema.ResourceData) *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName {
+	name, _ := d.Get(NameKey).(string)
+
+	return &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+		Name: name,
+	}
+}
+
+func resourceCustomPolicyTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	specData, _ := d.Get(SpecKey).([]interface{})
+
+	request := &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{
+		Template: &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplate{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     constructSpec(fn.Name, specData),
+		},
+	}
+
+	response, err := config.TMCConnection.PolicyTemplateResourceService.PolicyTemplateResourceServiceCreate(request)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control custom policy template entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.Template.Meta.UID)
+
+	return resourceCustomPolicyTemplateRead(ctx, d, m)
+}
+
+func resourceCustomPolicyTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	response, err := config.TMCConnection.PolicyTemplateResourceService.PolicyTemplateResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control custom policy template entry, name : %s", fn.Name))
+	}
+
+	if err := setResourceData(d, response.Template); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceCustomPolicyTemplateInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	if !common.HasMetaChanged(d) && !d.HasChange(SpecKey) {
+		return diags
+	}
+
+	getResp, err := config.TMCConnection.PolicyTemplateResourceService.PolicyTemplateResourceServiceGet(fn)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control custom policy template entry, name : %s", fn.Name))
+	}
+
+	if err := common.CheckResourceVersion(d, getResp.Template.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control custom policy template entry, name : %s", fn.Name))
+	}
+
+	if common.HasMetaChanged(d) {
+		meta := common.ConstructMeta(d)
+
+		meta.Labels = common.WithSystemManagedKeys(meta.Labels, getResp.Template.Meta.Labels)
+
+		getResp.Template.Meta.Labels = meta.Labels
+		getResp.Template.Meta.Description = meta.Description
+	}
+
+	if d.HasChange(SpecKey) {
+		specData, _ := d.Get(SpecKey).([]interface{})
+		getResp.Template.Spec = constructSpec(fn.Name, specData)
+	}
+
+	_, err = config.TMCConnection.PolicyTemplateResourceService.PolicyTemplateResourceServiceUpdate(getResp)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control custom policy template entry, name : %s", fn.Name))
+	}
+
+	return resourceCustomPolicyTemplateRead(ctx, d, m)
+}
+
+func resourceCustomPolicyTemplateDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullName(d)
+
+	err := config.TMCConnection.PolicyTemplateResourceService.PolicyTemplateResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control custom policy template entry, name : %s", fn.Name))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+func resourceCustomPolicyTemplateImporter(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	config, ok := m.(authctx.TanzuContext)
+	if !ok {
+		return nil, errors.New("error while retrieving Tanzu auth config")
+	}
+
+	fn := &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+		Name: d.Id(),
+	}
+
+	response, err := config.TMCConnection.PolicyTemplateResourceService.PolicyTemplateResourceServiceGet(fn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to import Tanzu Mission Control custom policy template entry, name : %s", fn.Name)
+	}
+
+	if err := d.Set(NameKey, fn.Name); err != nil {
+		return nil, err
+	}
+
+	if err := setResourceData(d, response.Template); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setResourceData(d *schema.ResourceData, template *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplate) error {
+	if template == nil {
+		return errors.New("custom policy template response is empty")
+	}
+
+	d.SetId(template.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(template.Meta)); err != nil {
+		return err
+	}
+
+	return d.Set(SpecKey, flattenSpec(template.Spec))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplateresource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/template"
+)
+
+func TestFlattenSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete spec",
+			input: constructSpec("k8srequiredannotations", []interface{}{
+				map[string]interface{}{
+					objectTypeKey:   defaultObjectType,
+					templateTypeKey: defaultTemplateType,
+					isDeprecatedKey: false,
+					dataInventoryKey: []interface{}{
+						map[string]interface{}{
+							groupKey:   "",
+							versionKey: "v1",
+							kindKey:    "Namespace",
+						},
+					},
+					constraintKindKey:   "K8sRequiredAnnotations",
+					regoKey:             "package k8srequiredannotations\n",
+					libsKey:             []interface{}{"package lib.helpers\n"},
+					parametersSchemaKey: `{"properties": {"annotations": {"type": "array", "items": {"type": "string"}}}}`,
+				},
+			}),
+			expected: []interface{}{
+				map[string]interface{}{
+					objectTypeKey:   defaultObjectType,
+					templateTypeKey: defaultTemplateType,
+					isDeprecatedKey: false,
+					dataInventoryKey: []interface{}{
+						map[string]interface{}{
+							groupKey:   "",
+							versionKey: "v1",
+							kindKey:    "Namespace",
+						},
+					},
+					constraintKindKey:   "K8sRequiredAnnotations",
+					regoKey:             "package k8srequiredannotations\n",
+					libsKey:             []interface{}{"package lib.helpers\n"},
+					parametersSchemaKey: `{"properties":{"annotations":{"items":{"type":"string"},"type":"array"}}}`,
+				},
+			},
+		},
+		{
+			description: "spec without parameters schema",
+			input: &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec{
+				ObjectType:   defaultObjectType,
+				TemplateType: defaultTemplateType,
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"crd": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"names": map[string]interface{}{"kind": "K8sDenyAll"},
+							},
+						},
+						"targets": []interface{}{
+							map[string]interface{}{
+								"target": gatekeeperAdmissionTarget,
+								"rego":   "package k8sdenyall\n",
+							},
+						},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					objectTypeKey:     defaultObjectType,
+					templateTypeKey:   defaultTemplateType,
+					isDeprecatedKey:   false,
+					dataInventoryKey:  []interface{}{},
+					constraintKindKey: "K8sDenyAll",
+					regoKey:           "package k8sdenyall\n",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenSpec(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
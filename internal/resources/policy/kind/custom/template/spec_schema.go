@@ -0,0 +1,266 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplateresource
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/template"
+)
+
+var specSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the custom policy template",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			objectTypeKey: {
+				Type:        schema.TypeString,
+				Description: "Type of the kubernetes object backing the template",
+				Optional:    true,
+				Default:     defaultObjectType,
+			},
+			templateTypeKey: {
+				Type:        schema.TypeString,
+				Description: "Type of the policy template",
+				Optional:    true,
+				Default:     defaultTemplateType,
+			},
+			isDeprecatedKey: {
+				Type:        schema.TypeBool,
+				Description: "Flag representing whether the template is deprecated",
+				Optional:    true,
+				Default:     false,
+			},
+			dataInventoryKey: {
+				Type:        schema.TypeList,
+				Description: "Kubernetes resources which need to be synced into the OPA cache for templates with referential constraints",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						groupKey: {
+							Type:        schema.TypeString,
+							Description: "API group of the resource, empty for the core group",
+							Optional:    true,
+						},
+						versionKey: {
+							Type:        schema.TypeString,
+							Description: "API version of the resource",
+							Required:    true,
+						},
+						kindKey: {
+							Type:        schema.TypeString,
+							Description: "Kind of the resource",
+							Required:    true,
+						},
+					},
+				},
+			},
+			constraintKindKey: {
+				Type:        schema.TypeString,
+				Description: "Kind of the constraint defined by the template; the template name must be this kind in lower case",
+				Required:    true,
+			},
+			regoKey: {
+				Type:        schema.TypeString,
+				Description: "Rego source of the template targeting admission.k8s.gatekeeper.sh",
+				Required:    true,
+			},
+			libsKey: {
+				Type:        schema.TypeList,
+				Description: "Additional Rego libraries used by the template",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			parametersSchemaKey: {
+				Type:             schema.TypeString,
+				Description:      "OpenAPI v3 schema, in JSON, of the parameters accepted by custom policies using the template",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: isJSONEqual,
+			},
+		},
+	},
+}
+
+func constructSpec(name string, data []interface{}) (spec *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec) {
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData, _ := data[0].(map[string]interface{})
+
+	spec = &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec{
+		DataInventory: make([]*policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateDataInventory, 0),
+	}
+
+	spec.ObjectType, _ = specData[objectTypeKey].(string)
+	spec.TemplateType, _ = specData[templateTypeKey].(string)
+	spec.IsDeprecated, _ = specData[isDeprecatedKey].(bool)
+
+	if v, ok := specData[dataInventoryKey].([]interface{}); ok {
+		for _, raw := range v {
+			inventoryData, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			inventory := &policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateDataInventory{}
+			inventory.Group, _ = inventoryData[groupKey].(string)
+			inventory.Version, _ = inventoryData[versionKey].(string)
+			inventory.Kind, _ = inventoryData[kindKey].(string)
+
+			spec.DataInventory = append(spec.DataInventory, inventory)
+		}
+	}
+
+	constraintKind, _ := specData[constraintKindKey].(string)
+	rego, _ := specData[regoKey].(string)
+
+	target := map[string]interface{}{
+		"target": gatekeeperAdmissionTarget,
+		"rego":   rego,
+	}
+
+	if v, ok := specData[libsKey].([]interface{}); ok && len(v) != 0 {
+		target["libs"] = v
+	}
+
+	crdSpec := map[string]interface{}{
+		"names": map[string]interface{}{
+			"kind": constraintKind,
+		},
+	}
+
+	if v, ok := specData[parametersSchemaKey].(string); ok && v != "" {
+		parametersSchema := make(map[string]interface{})
+
+		if err := json.Unmarshal([]byte(v), &parametersSchema); err == nil {
+			crdSpec["validation"] = map[string]interface{}{
+				"openAPIV3Schema": parametersSchema,
+			}
+		}
+	}
+
+	spec.Object = map[string]interface{}{
+		"apiVersion": constraintTemplateAPIVersion,
+		"kind":       spec.ObjectType,
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+		"spec": map[string]interface{}{
+			"crd": map[string]interface{}{
+				"spec": crdSpec,
+			},
+			"targets": []interface{}{target},
+		},
+	}
+
+	return spec
+}
+
+func flattenSpec(spec *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[objectTypeKey] = spec.ObjectType
+	flattenSpecData[templateTypeKey] = spec.TemplateType
+	flattenSpecData[isDeprecatedKey] = spec.IsDeprecated
+
+	inventories := make([]interface{}, 0)
+
+	for _, inventory := range spec.DataInventory {
+		if inventory == nil {
+			continue
+		}
+
+		inventories = append(inventories, map[string]interface{}{
+			groupKey:   inventory.Group,
+			versionKey: inventory.Version,
+			kindKey:    inventory.Kind,
+		})
+	}
+
+	flattenSpecData[dataInventoryKey] = inventories
+
+	templateSpec, _ := spec.Object["spec"].(map[string]interface{})
+	crdSpec := nestedMap(templateSpec, "crd", "spec")
+
+	flattenSpecData[constraintKindKey], _ = nestedMap(crdSpec, "names")["kind"].(string)
+
+	if parametersSchema := ParametersSchema(spec); parametersSchema != nil {
+		if b, err := json.Marshal(parametersSchema); err == nil {
+			flattenSpecData[parametersSchemaKey] = string(b)
+		}
+	}
+
+	if targets, ok := templateSpec["targets"].([]interface{}); ok {
+		for _, raw := range targets {
+			target, ok := raw.(map[string]interface{})
+			if !ok || target["target"] != gatekeeperAdmissionTarget {
+				continue
+			}
+
+			flattenSpecData[regoKey], _ = target["rego"].(string)
+
+			if libs, ok := target["libs"].([]interface{}); ok {
+				flattenSpecData[libsKey] = libs
+			}
+		}
+	}
+
+	return []interface{}{flattenSpecData}
+}
+
+// ParametersSchema returns the OpenAPI v3 schema of the parameters defined by a ConstraintTemplate based policy template spec.
+func ParametersSchema(spec *policytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec) map[string]interface{} {
+	if spec == nil {
+		return nil
+	}
+
+	templateSpec, _ := spec.Object["spec"].(map[string]interface{})
+	validation := nestedMap(templateSpec, "crd", "spec", "validation")
+
+	parametersSchema, _ := validation["openAPIV3Schema"].(map[string]interface{})
+
+	return parametersSchema
+}
+
+func nestedMap(data map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, key := range keys {
+		if data == nil {
+			return nil
+		}
+
+		data, _ = data[key].(map[string]interface{})
+	}
+
+	return data
+}
+
+func isJSONEqual(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	var oldJSON, newJSON interface{}
+
+	if err := json.Unmarshal([]byte(oldValue), &oldJSON); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(newValue), &newJSON); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldJSON, newJSON)
+}
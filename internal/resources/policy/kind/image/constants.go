@@ -17,9 +17,10 @@ const (
 
 // Allowed input recipes.
 const (
-	UnknownRecipe        Recipe = policy.UnknownRecipe
-	AllowedNameTagRecipe Recipe = reciperesource.AllowedNameTagKey
-	CustomRecipe         Recipe = reciperesource.CustomKey
-	BlockLatestTagRecipe Recipe = reciperesource.BlockLatestTagKey
-	RequireDigestRecipe  Recipe = reciperesource.RequireDigestKey
+	UnknownRecipe               Recipe = policy.UnknownRecipe
+	AllowedNameTagRecipe        Recipe = reciperesource.AllowedNameTagKey
+	CustomRecipe                Recipe = reciperesource.CustomKey
+	BlockLatestTagRecipe        Recipe = reciperesource.BlockLatestTagKey
+	RequireDigestRecipe         Recipe = reciperesource.RequireDigestKey
+	SignatureVerificationRecipe Recipe = reciperesource.SignatureVerificationKey
 )
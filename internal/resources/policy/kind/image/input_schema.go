@@ -20,32 +20,35 @@ import (
 var (
 	inputSchema = &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "Input for the image policy, having one of the valid recipes: allowed-name-tag, custom, block-latest-tag or require-digest.",
+		Description: "Input for the image policy, having one of the valid recipes: allowed-name-tag, custom, block-latest-tag, require-digest or signature-verification.",
 		Required:    true,
 		MaxItems:    1,
 		MinItems:    1,
 		ForceNew:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				reciperesource.AllowedNameTagKey: reciperesource.AllowedNameTag,
-				reciperesource.CustomKey:         reciperesource.Custom,
-				reciperesource.BlockLatestTagKey: reciperesource.BlockLatestTag,
-				reciperesource.RequireDigestKey:  reciperesource.RequireDigest,
+				reciperesource.AllowedNameTagKey:        reciperesource.AllowedNameTag,
+				reciperesource.CustomKey:                reciperesource.Custom,
+				reciperesource.BlockLatestTagKey:        reciperesource.BlockLatestTag,
+				reciperesource.RequireDigestKey:         reciperesource.RequireDigest,
+				reciperesource.SignatureVerificationKey: reciperesource.SignatureVerification,
 			},
 		},
 	}
-	RecipesAllowed = [...]string{reciperesource.AllowedNameTagKey, reciperesource.CustomKey, reciperesource.BlockLatestTagKey, reciperesource.RequireDigestKey}
+	RecipesAllowed = [...]string{reciperesource.AllowedNameTagKey, reciperesource.CustomKey, reciperesource.BlockLatestTagKey, reciperesource.RequireDigestKey,
+		reciperesource.SignatureVerificationKey}
 )
 
 type (
 	Recipe string
 	// InputRecipe is a struct for all types of image policy inputs.
 	inputRecipe struct {
-		recipe              Recipe
-		inputAllowedNameTag *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1AllowedNameTag
-		inputCustom         *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1Custom
-		inputBlockLatestTag *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1CommonRecipe
-		inputRequireDigest  *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1CommonRecipe
+		recipe                     Recipe
+		inputAllowedNameTag        *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1AllowedNameTag
+		inputCustom                *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1Custom
+		inputBlockLatestTag        *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1CommonRecipe
+		inputRequireDigest         *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1CommonRecipe
+		inputSignatureVerification *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification
 	}
 )
 
@@ -92,6 +95,15 @@ func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
 		}
 	}
 
+	if v, ok := inputData[reciperesource.SignatureVerificationKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			inputRecipeData = &inputRecipe{
+				recipe:                     SignatureVerificationRecipe,
+				inputSignatureVerification: reciperesource.ConstructSignatureVerification(v1),
+			}
+		}
+	}
+
 	return inputRecipeData
 }
 
@@ -111,6 +123,8 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 		flattenInputData[reciperesource.BlockLatestTagKey] = reciperesource.FlattenCommonRecipe(inputRecipeData.inputBlockLatestTag)
 	case RequireDigestRecipe:
 		flattenInputData[reciperesource.RequireDigestKey] = reciperesource.FlattenCommonRecipe(inputRecipeData.inputRequireDigest)
+	case SignatureVerificationRecipe:
+		flattenInputData[reciperesource.SignatureVerificationKey] = reciperesource.FlattenSignatureVerification(inputRecipeData.inputSignatureVerification)
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -119,7 +133,12 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 }
 
 func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
-	value, ok := diff.GetOk(policy.SpecKey)
+	return ValidateInputAt(diff, policy.SpecKey)
+}
+
+// ValidateInputAt checks the spec block found at the given key of the diff has exactly one valid input recipe block.
+func ValidateInputAt(diff *schema.ResourceDiff, specKey string) error {
+	value, ok := diff.GetOk(specKey)
 	if !ok {
 		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
 	}
@@ -173,6 +192,12 @@ func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}
 		}
 	}
 
+	if v, ok := inputData[reciperesource.SignatureVerificationKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			recipesFound = append(recipesFound, reciperesource.SignatureVerificationKey)
+		}
+	}
+
 	if len(recipesFound) == 0 {
 		return fmt.Errorf("no valid input recipe block found: minimum one valid input recipe block is required among: %v", strings.Join(RecipesAllowed[:], `, `))
 	} else if len(recipesFound) > 1 {
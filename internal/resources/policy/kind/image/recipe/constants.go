@@ -6,17 +6,24 @@ SPDX-License-Identifier: MPL-2.0
 package recipe
 
 const (
-	AllowedNameTagKey = "allowed_name_tag"
-	CustomKey         = "custom"
-	BlockLatestTagKey = "block_latest_tag"
-	RequireDigestKey  = "require_digest"
-	AuditKey          = "audit"
-	RulesKey          = "rules"
-	HostNameKey       = "hostname"
-	ImageNameKey      = "imagename"
-	PortKey           = "port"
-	RequireKey        = "requiredigest"
-	TagKey            = "tag"
-	NegateKey         = "negate"
-	ValueKey          = "value"
+	AllowedNameTagKey        = "allowed_name_tag"
+	CustomKey                = "custom"
+	BlockLatestTagKey        = "block_latest_tag"
+	RequireDigestKey         = "require_digest"
+	SignatureVerificationKey = "signature_verification"
+	AuditKey                 = "audit"
+	RulesKey                 = "rules"
+	HostNameKey              = "hostname"
+	ImageNameKey             = "imagename"
+	PortKey                  = "port"
+	RequireKey               = "requiredigest"
+	TagKey                   = "tag"
+	NegateKey                = "negate"
+	ValueKey                 = "value"
+	ImagePatternKey          = "image_pattern"
+	PublicKeysKey            = "public_keys"
+	KeylessKey               = "keyless"
+	IssuerKey                = "issuer"
+	SubjectKey               = "subject"
+	SubjectRegExpKey         = "subject_regexp"
 )
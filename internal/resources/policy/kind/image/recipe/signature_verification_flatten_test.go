@@ -0,0 +1,98 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipeimagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/image"
+)
+
+func TestFlattenSignatureVerification(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil image policy signature-verification recipe",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "scenario with nil value of Audit value in signature-verification recipe",
+			input: &policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification{
+				Audit: nil,
+				Rules: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+					{
+						ImagePattern: "harbor.example.com/apps/*",
+						PublicKeys:   []string{"public-key"},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					RulesKey: []interface{}{
+						map[string]interface{}{
+							ImagePatternKey: "harbor.example.com/apps/*",
+							PublicKeysKey:   []string{"public-key"},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "normal scenario with complete signature-verification recipe",
+			input: &policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification{
+				Audit: helper.BoolPointer(true),
+				Rules: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+					{
+						ImagePattern: "harbor.example.com/apps/*",
+						PublicKeys:   []string{"public-key"},
+						Keyless: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless{
+							{
+								Issuer:        "https://token.actions.githubusercontent.com",
+								SubjectRegExp: "^https://github.com/example/.*$",
+							},
+							nil,
+						},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					AuditKey: true,
+					RulesKey: []interface{}{
+						map[string]interface{}{
+							ImagePatternKey: "harbor.example.com/apps/*",
+							PublicKeysKey:   []string{"public-key"},
+							KeylessKey: []interface{}{
+								map[string]interface{}{
+									IssuerKey:        "https://token.actions.githubusercontent.com",
+									SubjectKey:       "",
+									SubjectRegExpKey: "^https://github.com/example/.*$",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSignatureVerification(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipeimagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/image"
+)
+
+var SignatureVerification = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for image policy signature-verification recipe version v1, requiring the images to be signed with cosign",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			AuditKey: {
+				Type:        schema.TypeBool,
+				Description: "Audit (dry-run). Violations will be logged but not denied.",
+				Optional:    true,
+				Default:     false,
+			},
+			RulesKey: {
+				Type:        schema.TypeList,
+				Description: "It specifies a list of rules that defines the signatures required per image pattern. Each rule needs public keys, keyless identities or both; a signature matching one of them is required.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ImagePatternKey: {
+							Type:         schema.TypeString,
+							Description:  "Image pattern the rule applies to, wildcards are supported (for example: harbor.example.com/apps/*).",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						PublicKeysKey: {
+							Type:        schema.TypeList,
+							Description: "PEM encoded cosign public keys the images can be signed with.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						KeylessKey: {
+							Type:        schema.TypeList,
+							Description: "Keyless (Fulcio certificate) identities the images can be signed by.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									IssuerKey: {
+										Type:         schema.TypeString,
+										Description:  "OIDC issuer of the signing certificate (for example: https://token.actions.githubusercontent.com).",
+										Required:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+									SubjectKey: {
+										Type:        schema.TypeString,
+										Description: "Subject of the signing certificate, e.g. the email address or workflow identity of the signer.",
+										Optional:    true,
+									},
+									SubjectRegExpKey: {
+										Type:         schema.TypeString,
+										Description:  "Regular expression matching the subject of the signing certificate. Exactly one of subject or subject_regexp is required.",
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func ConstructSignatureVerification(data []interface{}) (signatureVerification *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification) {
+	if len(data) == 0 || data[0] == nil {
+		return signatureVerification
+	}
+
+	signatureVerificationData, _ := data[0].(map[string]interface{})
+
+	signatureVerification = &policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification{}
+
+	if v, ok := signatureVerificationData[AuditKey]; ok {
+		signatureVerification.Audit = helper.BoolPointer(v.(bool))
+	}
+
+	if v, ok := signatureVerificationData[RulesKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			if len(vs) != 0 && vs[0] != nil {
+				signatureVerification.Rules = make([]*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules, 0)
+
+				for _, raw := range vs {
+					signatureVerification.Rules = append(signatureVerification.Rules, expandSignatureVerificationRules(raw))
+				}
+			}
+		}
+	}
+
+	return signatureVerification
+}
+
+func expandSignatureVerificationRules(data interface{}) (rules *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules) {
+	if data == nil {
+		return rules
+	}
+
+	rulesData, ok := data.(map[string]interface{})
+	if !ok {
+		return rules
+	}
+
+	rules = &policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{}
+
+	if v, ok := rulesData[ImagePatternKey]; ok {
+		helper.SetPrimitiveValue(v, &rules.ImagePattern, ImagePatternKey)
+	}
+
+	if v, ok := rulesData[PublicKeysKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			for _, raw := range vs {
+				if publicKey, ok := raw.(string); ok {
+					rules.PublicKeys = append(rules.PublicKeys, publicKey)
+				}
+			}
+		}
+	}
+
+	if v, ok := rulesData[KeylessKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			for _, raw := range vs {
+				keylessData, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				keyless := &policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless{}
+
+				keyless.Issuer, _ = keylessData[IssuerKey].(string)
+				keyless.Subject, _ = keylessData[SubjectKey].(string)
+				keyless.SubjectRegExp, _ = keylessData[SubjectRegExpKey].(string)
+
+				rules.Keyless = append(rules.Keyless, keyless)
+			}
+		}
+	}
+
+	return rules
+}
+
+func FlattenSignatureVerification(signatureVerification *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification) (data []interface{}) {
+	if signatureVerification == nil {
+		return data
+	}
+
+	flattenSignatureVerification := make(map[string]interface{})
+
+	if signatureVerification.Audit != nil {
+		flattenSignatureVerification[AuditKey] = *signatureVerification.Audit
+	}
+
+	if signatureVerification.Rules != nil {
+		var rules []interface{}
+
+		for _, rule := range signatureVerification.Rules {
+			rules = append(rules, flattenSignatureVerificationRules(rule))
+		}
+
+		flattenSignatureVerification[RulesKey] = rules
+	}
+
+	return []interface{}{flattenSignatureVerification}
+}
+
+func flattenSignatureVerificationRules(rules *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules) (data interface{}) {
+	if rules == nil {
+		return data
+	}
+
+	flattenRules := make(map[string]interface{})
+
+	flattenRules[ImagePatternKey] = rules.ImagePattern
+
+	if len(rules.PublicKeys) != 0 {
+		flattenRules[PublicKeysKey] = rules.PublicKeys
+	}
+
+	if len(rules.Keyless) != 0 {
+		var keylessList []interface{}
+
+		for _, keyless := range rules.Keyless {
+			if keyless == nil {
+				continue
+			}
+
+			keylessList = append(keylessList, map[string]interface{}{
+				IssuerKey:        keyless.Issuer,
+				SubjectKey:       keyless.Subject,
+				SubjectRegExpKey: keyless.SubjectRegExp,
+			})
+		}
+
+		flattenRules[KeylessKey] = keylessList
+	}
+
+	return flattenRules
+}
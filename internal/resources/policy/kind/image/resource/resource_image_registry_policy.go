@@ -26,7 +26,9 @@ func ResourceImagePolicy() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindimage.ResourceName])),
 			policykindimage.ValidateInput,
+			policykindimage.ValidateSignatureVerification,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateSpecExclusions,
 		),
 	}
 }
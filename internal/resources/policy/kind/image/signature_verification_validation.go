@@ -0,0 +1,99 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindimage
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policyrecipeimagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/image"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image/recipe"
+)
+
+// ValidateSignatureVerification checks the rules of a signature_verification recipe at plan time:
+// every rule needs a valid public key or keyless identity, and image patterns must be unique.
+func ValidateSignatureVerification(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return ValidateSignatureVerificationAt(diff, policy.SpecKey)
+}
+
+// ValidateSignatureVerificationAt checks the signature_verification recipe of the spec block found at the given key of the diff.
+func ValidateSignatureVerificationAt(diff *schema.ResourceDiff, specKey string) error {
+	signatureVerificationKey := fmt.Sprintf("%s.0.%s.0.%s", specKey, policy.InputKey, reciperesource.SignatureVerificationKey)
+
+	if !diff.NewValueKnown(signatureVerificationKey) {
+		return nil
+	}
+
+	data, _ := diff.Get(signatureVerificationKey).([]interface{})
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	return validateSignatureVerificationRules(reciperesource.ConstructSignatureVerification(data))
+}
+
+func validateSignatureVerificationRules(signatureVerification *policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification) error {
+	if signatureVerification == nil {
+		return nil
+	}
+
+	imagePatterns := make(map[string]bool)
+
+	for _, rule := range signatureVerification.Rules {
+		if rule == nil {
+			continue
+		}
+
+		if imagePatterns[rule.ImagePattern] {
+			return fmt.Errorf("%s: image pattern %q is used by more than one rule", reciperesource.SignatureVerificationKey, rule.ImagePattern)
+		}
+
+		imagePatterns[rule.ImagePattern] = true
+
+		if len(rule.PublicKeys) == 0 && len(rule.Keyless) == 0 {
+			return fmt.Errorf("%s: rule for image pattern %q requires at least one of %s or %s",
+				reciperesource.SignatureVerificationKey, rule.ImagePattern, reciperesource.PublicKeysKey, reciperesource.KeylessKey)
+		}
+
+		for i, publicKey := range rule.PublicKeys {
+			if err := validatePublicKey(publicKey); err != nil {
+				return fmt.Errorf("%s: rule for image pattern %q: %s %d is not valid: %v",
+					reciperesource.SignatureVerificationKey, rule.ImagePattern, reciperesource.PublicKeysKey, i, err)
+			}
+		}
+
+		for _, keyless := range rule.Keyless {
+			if keyless == nil {
+				continue
+			}
+
+			if (keyless.Subject == "") == (keyless.SubjectRegExp == "") {
+				return fmt.Errorf("%s: rule for image pattern %q: keyless identity of issuer %s requires exactly one of %s or %s",
+					reciperesource.SignatureVerificationKey, rule.ImagePattern, keyless.Issuer, reciperesource.SubjectKey, reciperesource.SubjectRegExpKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePublicKey(publicKey string) error {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return fmt.Errorf("no PEM encoded public key found")
+	}
+
+	if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+		return err
+	}
+
+	return nil
+}
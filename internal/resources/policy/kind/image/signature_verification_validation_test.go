@@ -0,0 +1,121 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindimage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipeimagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/image"
+)
+
+const testCosignPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE14H6be0D93m3zKUaJqfjO3SYiCK7
+P+h8hcK89o9GKcqphplqirqb7T91bnSuZFE+dFHa49RD5NpakwEr1YHRgA==
+-----END PUBLIC KEY-----
+`
+
+func TestValidateSignatureVerificationRules(t *testing.T) {
+	t.Parallel()
+
+	keyless := func(subject, subjectRegExp string) []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless {
+		return []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationKeyless{
+			{
+				Issuer:        "https://token.actions.githubusercontent.com",
+				Subject:       subject,
+				SubjectRegExp: subjectRegExp,
+			},
+		}
+	}
+
+	cases := []struct {
+		description string
+		input       []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules
+		expectError bool
+	}{
+		{
+			description: "public key and keyless rules",
+			input: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+				{
+					ImagePattern: "harbor.example.com/apps/*",
+					PublicKeys:   []string{testCosignPublicKey},
+				},
+				{
+					ImagePattern: "ghcr.io/example/*",
+					Keyless:      keyless("", "^https://github.com/example/.*$"),
+				},
+			},
+		},
+		{
+			description: "rule without public key or keyless identity",
+			input: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+				{
+					ImagePattern: "harbor.example.com/apps/*",
+				},
+			},
+			expectError: true,
+		},
+		{
+			description: "invalid public key",
+			input: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+				{
+					ImagePattern: "harbor.example.com/apps/*",
+					PublicKeys:   []string{"cosign.pub"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			description: "keyless identity without subject",
+			input: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+				{
+					ImagePattern: "ghcr.io/example/*",
+					Keyless:      keyless("", ""),
+				},
+			},
+			expectError: true,
+		},
+		{
+			description: "keyless identity with subject and subject regexp",
+			input: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+				{
+					ImagePattern: "ghcr.io/example/*",
+					Keyless:      keyless("release@example.com", ".*@example.com"),
+				},
+			},
+			expectError: true,
+		},
+		{
+			description: "duplicate image pattern",
+			input: []*policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerificationRules{
+				{
+					ImagePattern: "harbor.example.com/apps/*",
+					PublicKeys:   []string{testCosignPublicKey},
+				},
+				{
+					ImagePattern: "harbor.example.com/apps/*",
+					Keyless:      keyless("release@example.com", ""),
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateSignatureVerificationRules(&policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification{
+				Rules: test.input,
+			})
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ var SpecSchema = &schema.Schema{
 		Schema: map[string]*schema.Schema{
 			policy.InputKey:             inputSchema,
 			policy.NamespaceSelectorKey: policy.NamespaceSelector,
+			policy.ExclusionsKey:        policy.Exclusions,
 		},
 	},
 }
@@ -38,6 +39,11 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 
 	data, _ := value.([]interface{})
 
+	return ConstructSpecFromData(data)
+}
+
+// ConstructSpecFromData constructs the image policy spec from the data of a spec block.
+func ConstructSpecFromData(data []interface{}) (spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) {
 	if len(data) == 0 || data[0] == nil {
 		return spec
 	}
@@ -84,6 +90,10 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		if inputRecipeData.inputRequireDigest != nil {
 			spec.Input = *inputRecipeData.inputRequireDigest
 		}
+	case SignatureVerificationRecipe:
+		if inputRecipeData.inputSignatureVerification != nil {
+			spec.Input = *inputRecipeData.inputSignatureVerification
+		}
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -94,6 +104,12 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		}
 	}
 
+	if v, ok := specData[policy.ExclusionsKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			spec.NamespaceSelector = policy.ExcludeNamespaces(spec.NamespaceSelector, v1)
+		}
+	}
+
 	return spec
 }
 
@@ -169,6 +185,18 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 			recipe:             RequireDigestRecipe,
 			inputRequireDigest: &requireDigestRecipeInput,
 		}
+	case string(SignatureVerificationRecipe):
+		var signatureVerificationRecipeInput policyrecipeimagemodel.VmwareTanzuManageV1alpha1CommonPolicySpecImageV1SignatureVerification
+
+		err = signatureVerificationRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:                     SignatureVerificationRecipe,
+			inputSignatureVerification: &signatureVerificationRecipeInput,
+		}
 	case string(UnknownRecipe):
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
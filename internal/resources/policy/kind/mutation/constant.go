@@ -11,10 +11,14 @@ import (
 )
 
 const (
-	ResourceName             = "tanzu-mission-control_mutation_policy"
-	typePolicy               = "mutation-policy" // Type of Policy as defined in API
-	UnknownRecipe     Recipe = policy.UnknownRecipe
-	PodSecurityRecipe Recipe = recipe.PodSecurityKey
-	LabelRecipe       Recipe = recipe.LabelKey
-	AnnotationRecipe  Recipe = recipe.AnnotationKey
+	ResourceName                  = "tanzu-mission-control_mutation_policy"
+	typePolicy                    = "mutation-policy" // Type of Policy as defined in API
+	UnknownRecipe          Recipe = policy.UnknownRecipe
+	PodSecurityRecipe      Recipe = recipe.PodSecurityKey
+	LabelRecipe            Recipe = recipe.LabelKey
+	AnnotationRecipe       Recipe = recipe.AnnotationKey
+	TolerationsRecipe      Recipe = recipe.TolerationsKey
+	NodeSelectorRecipe     Recipe = recipe.NodeSelectorKey
+	DefaultResourcesRecipe Recipe = recipe.DefaultResourcesKey
+	ImageRegistryRecipe    Recipe = recipe.ImageRegistryKey
 )
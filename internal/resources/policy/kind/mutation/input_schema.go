@@ -27,22 +27,31 @@ var (
 		ForceNew:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				reciperesource.PodSecurityKey: reciperesource.PodSecuritySchema,
-				reciperesource.LabelKey:       reciperesource.LabelSchema,
-				reciperesource.AnnotationKey:  reciperesource.AnnotationSchema,
+				reciperesource.PodSecurityKey:      reciperesource.PodSecuritySchema,
+				reciperesource.LabelKey:            reciperesource.LabelSchema,
+				reciperesource.AnnotationKey:       reciperesource.AnnotationSchema,
+				reciperesource.TolerationsKey:      reciperesource.TolerationsSchema,
+				reciperesource.NodeSelectorKey:     reciperesource.NodeSelectorSchema,
+				reciperesource.DefaultResourcesKey: reciperesource.DefaultResourcesSchema,
+				reciperesource.ImageRegistryKey:    reciperesource.ImageRegistrySchema,
 			},
 		},
 	}
-	RecipesAllowed = [...]string{reciperesource.PodSecurityKey, reciperesource.LabelKey, reciperesource.AnnotationKey}
+	RecipesAllowed = [...]string{reciperesource.PodSecurityKey, reciperesource.LabelKey, reciperesource.AnnotationKey, reciperesource.TolerationsKey,
+		reciperesource.NodeSelectorKey, reciperesource.DefaultResourcesKey, reciperesource.ImageRegistryKey}
 )
 
 type (
 	Recipe      string
 	inputRecipe struct {
-		recipe      Recipe
-		podSecurity *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
-		label       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
-		annotation  *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation
+		recipe           Recipe
+		podSecurity      *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
+		label            *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
+		annotation       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation
+		tolerations      *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations
+		nodeSelector     *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector
+		defaultResources *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources
+		imageRegistry    *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry
 	}
 )
 
@@ -76,6 +85,34 @@ func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
 					annotation: reciperesource.ConstructAnnotation(ir),
 				}
 			}
+		case reciperesource.TolerationsKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe:      TolerationsRecipe,
+					tolerations: reciperesource.ConstructTolerations(ir),
+				}
+			}
+		case reciperesource.NodeSelectorKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe:       NodeSelectorRecipe,
+					nodeSelector: reciperesource.ConstructNodeSelector(ir),
+				}
+			}
+		case reciperesource.DefaultResourcesKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe:           DefaultResourcesRecipe,
+					defaultResources: reciperesource.ConstructDefaultResources(ir),
+				}
+			}
+		case reciperesource.ImageRegistryKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe:        ImageRegistryRecipe,
+					imageRegistry: reciperesource.ConstructImageRegistry(ir),
+				}
+			}
 		}
 	}
 
@@ -96,6 +133,14 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 		flattenInputData[reciperesource.LabelKey] = reciperesource.FlattenLabel(inputRecipeData.label)
 	case AnnotationRecipe:
 		flattenInputData[reciperesource.AnnotationKey] = reciperesource.FlattenAnnotation(inputRecipeData.annotation)
+	case TolerationsRecipe:
+		flattenInputData[reciperesource.TolerationsKey] = reciperesource.FlattenTolerations(inputRecipeData.tolerations)
+	case NodeSelectorRecipe:
+		flattenInputData[reciperesource.NodeSelectorKey] = reciperesource.FlattenNodeSelector(inputRecipeData.nodeSelector)
+	case DefaultResourcesRecipe:
+		flattenInputData[reciperesource.DefaultResourcesKey] = reciperesource.FlattenDefaultResources(inputRecipeData.defaultResources)
+	case ImageRegistryRecipe:
+		flattenInputData[reciperesource.ImageRegistryKey] = reciperesource.FlattenImageRegistry(inputRecipeData.imageRegistry)
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -104,7 +149,12 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 }
 
 func ValidateInput(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
-	value, ok := diff.GetOk(policy.SpecKey)
+	return ValidateInputAt(diff, policy.SpecKey)
+}
+
+// ValidateInputAt checks the spec block found at the given key of the diff has exactly one valid input recipe block.
+func ValidateInputAt(diff *schema.ResourceDiff, specKey string) error {
+	value, ok := diff.GetOk(specKey)
 	if !ok {
 		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
 	}
@@ -146,9 +196,7 @@ func ValidateInput(_ context.Context, diff *schema.ResourceDiff, _ interface{})
 }
 
 func appendRecipeFromInput(inputData map[string]interface{}) (recipesFound []string) {
-	recipeKeys := []string{reciperesource.PodSecurityKey, reciperesource.LabelKey, reciperesource.AnnotationKey}
-
-	for _, recipeKey := range recipeKeys {
+	for _, recipeKey := range RecipesAllowed {
 		if recipeData, ok := inputData[recipeKey]; ok {
 			if recipeType, ok := recipeData.([]interface{}); ok && len(recipeType) != 0 {
 				recipesFound = append(recipesFound, recipeKey)
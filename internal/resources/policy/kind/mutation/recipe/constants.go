@@ -9,6 +9,10 @@ const (
 	PodSecurityKey               = "pod_security"
 	LabelKey                     = "label"
 	AnnotationKey                = "annotation"
+	TolerationsKey               = "tolerations"
+	NodeSelectorKey              = "node_selector"
+	DefaultResourcesKey          = "default_resources"
+	ImageRegistryKey             = "image_registry"
 	targetKubernetesResourcesKey = "target_kubernetes_resources"
 	scopeKey                     = "scope"
 	apiGroupsKey                 = "api_groups"
@@ -35,4 +39,15 @@ const (
 	supplementalGroupsKey        = "supplemental_groups"
 	minKey                       = "min"
 	maxKey                       = "max"
+	tolerationKey                = "toleration"
+	operatorKey                  = "operator"
+	effectKey                    = "effect"
+	tolerationSecondsKey         = "toleration_seconds"
+	labelsKey                    = "labels"
+	requestsKey                  = "requests"
+	limitsKey                    = "limits"
+	cpuKey                       = "cpu"
+	memoryKey                    = "memory"
+	targetRegistryKey            = "target_registry"
+	sourceRegistriesKey          = "source_registries"
 )
@@ -0,0 +1,121 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
+)
+
+func TestFlattenDefaultResources(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation default resources",
+		},
+		{
+			description: "flatten default resources mutation policy struct with requests only",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources{
+				Requests: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues{
+					CPU:    "100m",
+					Memory: "128Mi",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					requestsKey: []interface{}{
+						map[string]interface{}{
+							cpuKey:    "100m",
+							memoryKey: "128Mi",
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "flatten normal default resources mutation policy struct",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources{
+				Requests: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues{
+					CPU:    "100m",
+					Memory: "128Mi",
+				},
+				Limits: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues{
+					Memory: "512Mi",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					requestsKey: []interface{}{
+						map[string]interface{}{
+							cpuKey:    "100m",
+							memoryKey: "128Mi",
+						},
+					},
+					limitsKey: []interface{}{
+						map[string]interface{}{
+							cpuKey:    "",
+							memoryKey: "512Mi",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenDefaultResources(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestValidateQuantity(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       interface{}
+		expectError bool
+	}{
+		{
+			description: "cpu quantity",
+			input:       "500m",
+		},
+		{
+			description: "memory quantity",
+			input:       "1Gi",
+		},
+		{
+			description: "invalid quantity",
+			input:       "one gigabyte",
+			expectError: true,
+		},
+		{
+			description: "non string value",
+			input:       1,
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, errs := common.ValidateQuantity(test.input, cpuKey)
+			require.Equal(t, test.expectError, len(errs) != 0)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
+)
+
+var DefaultResourcesSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for mutation policy default resources recipe version v1, setting the resource requests and limits of the containers not defining them",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			requestsKey: common.ResourceValuesSchema("Resource requests set on the containers not defining them"),
+			limitsKey:   common.ResourceValuesSchema("Resource limits set on the containers not defining them"),
+		},
+	},
+}
+
+func ConstructDefaultResources(data []interface{}) (defaultResourcesModel *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources) {
+	if len(data) == 0 || data[0] == nil {
+		return defaultResourcesModel
+	}
+
+	defaultResourcesData, _ := data[0].(map[string]interface{})
+
+	defaultResourcesModel = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources{}
+
+	if v, ok := defaultResourcesData[requestsKey].([]interface{}); ok {
+		defaultResourcesModel.Requests = expandResourceValues(v)
+	}
+
+	if v, ok := defaultResourcesData[limitsKey].([]interface{}); ok {
+		defaultResourcesModel.Limits = expandResourceValues(v)
+	}
+
+	return defaultResourcesModel
+}
+
+func expandResourceValues(data []interface{}) (resourceValues *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues) {
+	if len(data) == 0 || data[0] == nil {
+		return resourceValues
+	}
+
+	resourceValuesData, _ := data[0].(map[string]interface{})
+
+	resourceValues = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues{}
+
+	resourceValues.CPU, _ = resourceValuesData[cpuKey].(string)
+	resourceValues.Memory, _ = resourceValuesData[memoryKey].(string)
+
+	return resourceValues
+}
+
+func FlattenDefaultResources(defaultResources *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources) (data []interface{}) {
+	if defaultResources == nil {
+		return data
+	}
+
+	flattenDefaultResources := make(map[string]interface{})
+
+	if defaultResources.Requests != nil {
+		flattenDefaultResources[requestsKey] = flattenResourceValues(defaultResources.Requests)
+	}
+
+	if defaultResources.Limits != nil {
+		flattenDefaultResources[limitsKey] = flattenResourceValues(defaultResources.Limits)
+	}
+
+	return []interface{}{flattenDefaultResources}
+}
+
+func flattenResourceValues(resourceValues *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			cpuKey:    resourceValues.CPU,
+			memoryKey: resourceValues.Memory,
+		},
+	}
+}
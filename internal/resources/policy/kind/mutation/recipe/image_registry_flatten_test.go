@@ -0,0 +1,61 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+func TestFlattenImageRegistry(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation image registry",
+		},
+		{
+			description: "flatten image registry mutation policy struct rewriting all registries",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry{
+				TargetRegistry:   "harbor.example.com/mirror",
+				SourceRegistries: []string{},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					targetRegistryKey: "harbor.example.com/mirror",
+				},
+			},
+		},
+		{
+			description: "flatten normal image registry mutation policy struct",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry{
+				TargetRegistry:   "harbor.example.com/mirror",
+				SourceRegistries: []string{"docker.io", "quay.io"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					targetRegistryKey:   "harbor.example.com/mirror",
+					sourceRegistriesKey: []string{"docker.io", "quay.io"},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenImageRegistry(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
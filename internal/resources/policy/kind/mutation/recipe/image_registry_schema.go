@@ -0,0 +1,80 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+var ImageRegistrySchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for mutation policy image registry recipe version v1, rewriting the registry of the container images of the pods of the selected namespaces",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			targetRegistryKey: {
+				Type:         schema.TypeString,
+				Description:  "Registry the container images are rewritten to, optionally with a project path, e.g. harbor.example.com/mirror",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			sourceRegistriesKey: {
+				Type:        schema.TypeList,
+				Description: "Registries of the container images rewritten, e.g. docker.io; images of all registries are rewritten when empty",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	},
+}
+
+func ConstructImageRegistry(data []interface{}) (imageRegistryModel *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry) {
+	if len(data) == 0 || data[0] == nil {
+		return imageRegistryModel
+	}
+
+	imageRegistryData, _ := data[0].(map[string]interface{})
+
+	imageRegistryModel = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry{
+		SourceRegistries: make([]string, 0),
+	}
+
+	imageRegistryModel.TargetRegistry, _ = imageRegistryData[targetRegistryKey].(string)
+
+	if v, ok := imageRegistryData[sourceRegistriesKey].([]interface{}); ok {
+		for _, raw := range v {
+			if registry, ok := raw.(string); ok {
+				imageRegistryModel.SourceRegistries = append(imageRegistryModel.SourceRegistries, registry)
+			}
+		}
+	}
+
+	return imageRegistryModel
+}
+
+func FlattenImageRegistry(imageRegistry *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry) (data []interface{}) {
+	if imageRegistry == nil {
+		return data
+	}
+
+	flattenImageRegistry := map[string]interface{}{
+		targetRegistryKey: imageRegistry.TargetRegistry,
+	}
+
+	if len(imageRegistry.SourceRegistries) != 0 {
+		flattenImageRegistry[sourceRegistriesKey] = imageRegistry.SourceRegistries
+	}
+
+	return []interface{}{flattenImageRegistry}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+func TestFlattenNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation node selector",
+		},
+		{
+			description: "flatten normal node selector mutation policy struct",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector{
+				NodeSelector: map[string]string{
+					"kubernetes.io/os": "linux",
+					"node-pool":        "workloads",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					labelsKey: map[string]interface{}{
+						"kubernetes.io/os": "linux",
+						"node-pool":        "workloads",
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenNodeSelector(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
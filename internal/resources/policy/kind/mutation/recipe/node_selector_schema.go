@@ -0,0 +1,70 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+var NodeSelectorSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for mutation policy node selector recipe version v1, scheduling the pods of the selected namespaces on the matching nodes",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			labelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Node labels added to the node selector of the pods",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	},
+}
+
+func ConstructNodeSelector(data []interface{}) (nodeSelectorModel *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector) {
+	if len(data) == 0 || data[0] == nil {
+		return nodeSelectorModel
+	}
+
+	nodeSelectorData, _ := data[0].(map[string]interface{})
+
+	nodeSelectorModel = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector{
+		NodeSelector: make(map[string]string),
+	}
+
+	if v, ok := nodeSelectorData[labelsKey].(map[string]interface{}); ok {
+		for key, value := range v {
+			nodeSelectorModel.NodeSelector[key], _ = value.(string)
+		}
+	}
+
+	return nodeSelectorModel
+}
+
+func FlattenNodeSelector(nodeSelector *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector) (data []interface{}) {
+	if nodeSelector == nil {
+		return data
+	}
+
+	labels := make(map[string]interface{}, len(nodeSelector.NodeSelector))
+
+	for key, value := range nodeSelector.NodeSelector {
+		labels[key] = value
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			labelsKey: labels,
+		},
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+func TestFlattenTolerations(t *testing.T) {
+	t.Parallel()
+
+	tolerationSeconds := int64(300)
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation tolerations",
+		},
+		{
+			description: "flatten normal tolerations mutation policy struct",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations{
+				Tolerations: []*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration{
+					{
+						Key:      "dedicated",
+						Operator: "Equal",
+						Value:    "gpu",
+						Effect:   "NoSchedule",
+					},
+					nil,
+					{
+						Key:               "node.kubernetes.io/unreachable",
+						Operator:          "Exists",
+						Effect:            "NoExecute",
+						TolerationSeconds: &tolerationSeconds,
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					tolerationKey: []interface{}{
+						map[string]interface{}{
+							keyKey:      "dedicated",
+							operatorKey: "Equal",
+							valueKey:    "gpu",
+							effectKey:   "NoSchedule",
+						},
+						map[string]interface{}{
+							keyKey:               "node.kubernetes.io/unreachable",
+							operatorKey:          "Exists",
+							valueKey:             "",
+							effectKey:            "NoExecute",
+							tolerationSecondsKey: 300,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenTolerations(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
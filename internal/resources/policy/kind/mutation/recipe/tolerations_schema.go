@@ -0,0 +1,134 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+var TolerationsSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for mutation policy tolerations recipe version v1, adding tolerations to the pods of the selected namespaces",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			tolerationKey: {
+				Type:        schema.TypeList,
+				Description: "Toleration added to the pods",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyKey: {
+							Type:        schema.TypeString,
+							Description: "Taint key the toleration applies to, all taints when empty with operator Exists",
+							Optional:    true,
+						},
+						operatorKey: {
+							Type:         schema.TypeString,
+							Description:  "Relationship of the key to the value: Exists or Equal",
+							Optional:     true,
+							Default:      "Equal",
+							ValidateFunc: validation.StringInSlice([]string{"Exists", "Equal"}, false),
+						},
+						valueKey: {
+							Type:        schema.TypeString,
+							Description: "Taint value the toleration matches with operator Equal",
+							Optional:    true,
+						},
+						effectKey: {
+							Type:         schema.TypeString,
+							Description:  "Taint effect to match: NoSchedule, PreferNoSchedule or NoExecute, all effects when empty",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}, false),
+						},
+						tolerationSecondsKey: {
+							Type:         schema.TypeInt,
+							Description:  "Period of time in seconds the pods tolerate a NoExecute taint before being evicted, forever when not set",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func ConstructTolerations(data []interface{}) (tolerationsModel *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations) {
+	if len(data) == 0 || data[0] == nil {
+		return tolerationsModel
+	}
+
+	tolerationsData, _ := data[0].(map[string]interface{})
+
+	tolerationsModel = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations{
+		Tolerations: make([]*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration, 0),
+	}
+
+	if v, ok := tolerationsData[tolerationKey].([]interface{}); ok {
+		for _, raw := range v {
+			tolerationData, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			toleration := &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration{}
+
+			toleration.Key, _ = tolerationData[keyKey].(string)
+			toleration.Operator, _ = tolerationData[operatorKey].(string)
+			toleration.Value, _ = tolerationData[valueKey].(string)
+			toleration.Effect, _ = tolerationData[effectKey].(string)
+
+			if seconds, ok := tolerationData[tolerationSecondsKey].(int); ok && seconds > 0 {
+				tolerationSeconds := int64(seconds)
+				toleration.TolerationSeconds = &tolerationSeconds
+			}
+
+			tolerationsModel.Tolerations = append(tolerationsModel.Tolerations, toleration)
+		}
+	}
+
+	return tolerationsModel
+}
+
+func FlattenTolerations(tolerations *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations) (data []interface{}) {
+	if tolerations == nil {
+		return data
+	}
+
+	var flattenTolerationList []interface{}
+
+	for _, toleration := range tolerations.Tolerations {
+		if toleration == nil {
+			continue
+		}
+
+		flattenToleration := map[string]interface{}{
+			keyKey:      toleration.Key,
+			operatorKey: toleration.Operator,
+			valueKey:    toleration.Value,
+			effectKey:   toleration.Effect,
+		}
+
+		if toleration.TolerationSeconds != nil {
+			flattenToleration[tolerationSecondsKey] = int(*toleration.TolerationSeconds)
+		}
+
+		flattenTolerationList = append(flattenTolerationList, flattenToleration)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			tolerationKey: flattenTolerationList,
+		},
+	}
+}
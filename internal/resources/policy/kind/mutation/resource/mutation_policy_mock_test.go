@@ -42,7 +42,7 @@ func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
 
 	endpoint := os.Getenv("TMC_ENDPOINT")
 
-	for _, recipe := range []string{annotation, label, podSecurity} {
+	for _, recipe := range []string{annotation, label, podSecurity, tolerations, nodeSelector, defaultResources, imageRegistry} {
 		testConfig.setUpOrgPolicyEndPointMocks(t, recipe, endpoint)
 		testConfig.setUpClusterGroupEndPointMocks(t, endpoint)
 		testConfig.setUpClusterGroupPolicyEndpointMocks(t, recipe, endpoint)
@@ -271,6 +271,39 @@ func getMockPolicyCreateSpec(recipe string) *policymodel.VmwareTanzuManageV1alph
 				Values:    []*float64{helper.Float64Pointer(0), helper.Float64Pointer(1), helper.Float64Pointer(2), helper.Float64Pointer(3)},
 			},
 		}
+	case tolerations:
+		spec.Input = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations{
+			Tolerations: []*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Toleration{
+				{
+					Key:      "dedicated",
+					Operator: "Equal",
+					Value:    "gpu",
+					Effect:   "NoSchedule",
+				},
+			},
+		}
+	case nodeSelector:
+		spec.Input = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector{
+			NodeSelector: map[string]string{
+				"kubernetes.io/os": "linux",
+			},
+		}
+	case defaultResources:
+		spec.Input = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources{
+			Requests: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues{
+				CPU:    "100m",
+				Memory: "128Mi",
+			},
+			Limits: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ResourceValues{
+				CPU:    "500m",
+				Memory: "512Mi",
+			},
+		}
+	case imageRegistry:
+		spec.Input = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry{
+			TargetRegistry:   "harbor.example.com/mirror",
+			SourceRegistries: []string{"docker.io"},
+		}
 	}
 
 	return spec
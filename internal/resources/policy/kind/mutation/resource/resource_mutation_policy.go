@@ -27,6 +27,7 @@ func ResourceMutationPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindmutation.ResourceName])),
 			policykindmutation.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateSpecExclusions,
 		),
 	}
 }
@@ -86,6 +86,22 @@ func TestAcceptanceForMutationPolicyResource(t *testing.T) {
 				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(annotation, scope.ClusterGroupScope, getAnnotationResourceInput()),
 				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(annotation),
 			},
+			{
+				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(tolerations, scope.ClusterGroupScope, getTolerationsResourceInput()),
+				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(tolerations),
+			},
+			{
+				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(nodeSelector, scope.ClusterGroupScope, getNodeSelectorResourceInput()),
+				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(nodeSelector),
+			},
+			{
+				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(defaultResources, scope.ClusterGroupScope, getDefaultResourcesResourceInput()),
+				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(defaultResources),
+			},
+			{
+				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(imageRegistry, scope.ClusterGroupScope, getImageRegistryResourceInput()),
+				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(imageRegistry),
+			},
 			{
 				PreConfig: func() {
 					if testConfig.ScopeHelperResources.OrgID == "" {
@@ -418,3 +434,58 @@ func getPodSecurityResourceInput() string {
 	}
 `
 }
+
+func getTolerationsResourceInput() string {
+	return `
+    input {
+      tolerations {
+        toleration {
+          key      = "dedicated"
+          operator = "Equal"
+          value    = "gpu"
+          effect   = "NoSchedule"
+        }
+      }
+    }
+`
+}
+
+func getNodeSelectorResourceInput() string {
+	return `
+    input {
+      node_selector {
+        labels = {
+          "kubernetes.io/os" = "linux"
+        }
+      }
+    }
+`
+}
+
+func getDefaultResourcesResourceInput() string {
+	return `
+    input {
+      default_resources {
+        requests {
+          cpu    = "100m"
+          memory = "128Mi"
+        }
+        limits {
+          cpu    = "500m"
+          memory = "512Mi"
+        }
+      }
+    }
+`
+}
+
+func getImageRegistryResourceInput() string {
+	return `
+    input {
+      image_registry {
+        target_registry   = "harbor.example.com/mirror"
+        source_registries = ["docker.io"]
+      }
+    }
+`
+}
@@ -22,6 +22,10 @@ const (
 	annotation                = "annotation"
 	label                     = "label"
 	podSecurity               = "pod-security"
+	tolerations               = "tolerations"
+	nodeSelector              = "node-selector"
+	defaultResources          = "default-resources"
+	imageRegistry             = "image-registry"
 )
 
 type testAcceptanceConfig struct {
@@ -26,6 +26,7 @@ var SpecSchema = &schema.Schema{
 		Schema: map[string]*schema.Schema{
 			policy.InputKey:             inputSchema,
 			policy.NamespaceSelectorKey: policy.NamespaceSelector,
+			policy.ExclusionsKey:        policy.Exclusions,
 		},
 	},
 }
@@ -38,6 +39,11 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 
 	data, _ := value.([]interface{})
 
+	return ConstructSpecFromData(data)
+}
+
+// ConstructSpecFromData constructs the mutation policy spec from the data of a spec block.
+func ConstructSpecFromData(data []interface{}) (spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) {
 	if len(data) == 0 || data[0] == nil {
 		return spec
 	}
@@ -80,6 +86,22 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		if inputRecipeData.annotation != nil {
 			spec.Input = *inputRecipeData.annotation
 		}
+	case TolerationsRecipe:
+		if inputRecipeData.tolerations != nil {
+			spec.Input = *inputRecipeData.tolerations
+		}
+	case NodeSelectorRecipe:
+		if inputRecipeData.nodeSelector != nil {
+			spec.Input = *inputRecipeData.nodeSelector
+		}
+	case DefaultResourcesRecipe:
+		if inputRecipeData.defaultResources != nil {
+			spec.Input = *inputRecipeData.defaultResources
+		}
+	case ImageRegistryRecipe:
+		if inputRecipeData.imageRegistry != nil {
+			spec.Input = *inputRecipeData.imageRegistry
+		}
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -90,6 +112,12 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		}
 	}
 
+	if v, ok := specData[policy.ExclusionsKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			spec.NamespaceSelector = policy.ExcludeNamespaces(spec.NamespaceSelector, v1)
+		}
+	}
+
 	return spec
 }
 
@@ -153,6 +181,54 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 			recipe:     AnnotationRecipe,
 			annotation: &annotationRecipeInput,
 		}
+	case string(TolerationsRecipe):
+		var tolerationsRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Tolerations
+
+		err = tolerationsRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:      TolerationsRecipe,
+			tolerations: &tolerationsRecipeInput,
+		}
+	case string(NodeSelectorRecipe):
+		var nodeSelectorRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1NodeSelector
+
+		err = nodeSelectorRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:       NodeSelectorRecipe,
+			nodeSelector: &nodeSelectorRecipeInput,
+		}
+	case string(DefaultResourcesRecipe):
+		var defaultResourcesRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1DefaultResources
+
+		err = defaultResourcesRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:           DefaultResourcesRecipe,
+			defaultResources: &defaultResourcesRecipeInput,
+		}
+	case string(ImageRegistryRecipe):
+		var imageRegistryRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistry
+
+		err = imageRegistryRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:        ImageRegistryRecipe,
+			imageRegistry: &imageRegistryRecipeInput,
+		}
 	case string(UnknownRecipe):
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
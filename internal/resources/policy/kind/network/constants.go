@@ -15,13 +15,15 @@ const (
 	typePolicy   = "network-policy" // Type of Policy as defined in API
 
 	// Allowed input recipes.
-	UnknownRecipe        Recipe = policy.UnknownRecipe
-	AllowAllRecipe       Recipe = reciperesource.AllowAllKey
-	AllowAllToPodsRecipe Recipe = reciperesource.AllowAllToPodsKey
-	AllowAllEgressRecipe Recipe = reciperesource.AllowAllEgressKey
-	DenyAllRecipe        Recipe = reciperesource.DenyAllKey
-	DenyAllToPodsRecipe  Recipe = reciperesource.DenyAllToPodsKey
-	DenyAllEgressRecipe  Recipe = reciperesource.DenyAllEgressKey
-	CustomEgressRecipe   Recipe = reciperesource.CustomEgressKey
-	CustomIngressRecipe  Recipe = reciperesource.CustomIngressKey
+	UnknownRecipe             Recipe = policy.UnknownRecipe
+	AllowAllRecipe            Recipe = reciperesource.AllowAllKey
+	AllowAllToPodsRecipe      Recipe = reciperesource.AllowAllToPodsKey
+	AllowAllEgressRecipe      Recipe = reciperesource.AllowAllEgressKey
+	DenyAllRecipe             Recipe = reciperesource.DenyAllKey
+	DenyAllToPodsRecipe       Recipe = reciperesource.DenyAllToPodsKey
+	DenyAllEgressRecipe       Recipe = reciperesource.DenyAllEgressKey
+	CustomEgressRecipe        Recipe = reciperesource.CustomEgressKey
+	CustomIngressRecipe       Recipe = reciperesource.CustomIngressKey
+	AllowDNSEgressRecipe      Recipe = reciperesource.AllowDNSEgressKey
+	AllowFromNamespacesRecipe Recipe = reciperesource.AllowFromNamespacesKey
 )
@@ -20,37 +20,41 @@ import (
 var (
 	inputSchema = &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "Input for the network policy, having one of the valid recipes: allow-all, allow-all-to-pods, allow-all-egress, deny-all, deny-all-to-pods, deny-all-egress, custom-egress or custom-ingress.",
+		Description: "Input for the network policy, having one of the valid recipes: allow-all, allow-all-to-pods, allow-all-egress, deny-all, deny-all-to-pods, deny-all-egress, custom-egress, custom-ingress, allow-dns-egress or allow-from-namespaces.",
 		Required:    true,
 		MaxItems:    1,
 		MinItems:    1,
 		ForceNew:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				reciperesource.AllowAllKey:       reciperesource.AllowAll,
-				reciperesource.AllowAllToPodsKey: reciperesource.AllowAllToPods,
-				reciperesource.AllowAllEgressKey: reciperesource.AllowAllEgress,
-				reciperesource.DenyAllKey:        reciperesource.DenyAll,
-				reciperesource.DenyAllToPodsKey:  reciperesource.DenyAllToPods,
-				reciperesource.DenyAllEgressKey:  reciperesource.DenyAllEgress,
-				reciperesource.CustomEgressKey:   reciperesource.CustomEgress,
-				reciperesource.CustomIngressKey:  reciperesource.CustomIngress,
+				reciperesource.AllowAllKey:            reciperesource.AllowAll,
+				reciperesource.AllowAllToPodsKey:      reciperesource.AllowAllToPods,
+				reciperesource.AllowAllEgressKey:      reciperesource.AllowAllEgress,
+				reciperesource.DenyAllKey:             reciperesource.DenyAll,
+				reciperesource.DenyAllToPodsKey:       reciperesource.DenyAllToPods,
+				reciperesource.DenyAllEgressKey:       reciperesource.DenyAllEgress,
+				reciperesource.CustomEgressKey:        reciperesource.CustomEgress,
+				reciperesource.CustomIngressKey:       reciperesource.CustomIngress,
+				reciperesource.AllowDNSEgressKey:      reciperesource.AllowDNSEgress,
+				reciperesource.AllowFromNamespacesKey: reciperesource.AllowFromNamespaces,
 			},
 		},
 	}
-	RecipesAllowed = [...]string{reciperesource.AllowAllKey, reciperesource.AllowAllToPodsKey, reciperesource.AllowAllEgressKey, reciperesource.DenyAllKey, reciperesource.DenyAllToPodsKey, reciperesource.DenyAllEgressKey, reciperesource.CustomEgressKey, reciperesource.CustomIngressKey}
+	RecipesAllowed = [...]string{reciperesource.AllowAllKey, reciperesource.AllowAllToPodsKey, reciperesource.AllowAllEgressKey, reciperesource.DenyAllKey, reciperesource.DenyAllToPodsKey, reciperesource.DenyAllEgressKey, reciperesource.CustomEgressKey, reciperesource.CustomIngressKey, reciperesource.AllowDNSEgressKey, reciperesource.AllowFromNamespacesKey}
 )
 
 type (
 	Recipe string
 	// InputRecipe is a struct for all types of network policy inputs.
 	inputRecipe struct {
-		recipe              Recipe
-		inputAllowAll       *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowAll
-		inputAllowAllToPods *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowAllToPods
-		inputDenyAllToPods  *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1DenyAllToPods
-		inputCustomEgress   *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1CustomEgress
-		inputCustomIngress  *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1CustomIngress
+		recipe                   Recipe
+		inputAllowAll            *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowAll
+		inputAllowAllToPods      *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowAllToPods
+		inputDenyAllToPods       *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1DenyAllToPods
+		inputCustomEgress        *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1CustomEgress
+		inputCustomIngress       *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1CustomIngress
+		inputAllowDNSEgress      *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress
+		inputAllowFromNamespaces *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces
 	}
 )
 
@@ -116,6 +120,20 @@ func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
 					inputCustomIngress: reciperesource.ConstructCustomIngress(ir),
 				}
 			}
+		case reciperesource.AllowDNSEgressKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe:              AllowDNSEgressRecipe,
+					inputAllowDNSEgress: reciperesource.ConstructAllowDNSEgress(ir),
+				}
+			}
+		case reciperesource.AllowFromNamespacesKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe:                   AllowFromNamespacesRecipe,
+					inputAllowFromNamespaces: reciperesource.ConstructAllowFromNamespaces(ir),
+				}
+			}
 		}
 	}
 
@@ -146,6 +164,10 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 		flattenInputData[reciperesource.CustomEgressKey] = reciperesource.FlattenCustomEgress(inputRecipeData.inputCustomEgress)
 	case CustomIngressRecipe:
 		flattenInputData[reciperesource.CustomIngressKey] = reciperesource.FlattenCustomIngress(inputRecipeData.inputCustomIngress)
+	case AllowDNSEgressRecipe:
+		flattenInputData[reciperesource.AllowDNSEgressKey] = reciperesource.FlattenAllowDNSEgress(inputRecipeData.inputAllowDNSEgress)
+	case AllowFromNamespacesRecipe:
+		flattenInputData[reciperesource.AllowFromNamespacesKey] = reciperesource.FlattenAllowFromNamespaces(inputRecipeData.inputAllowFromNamespaces)
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -154,7 +176,12 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 }
 
 func ValidateInput(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
-	value, ok := diff.GetOk(policy.SpecKey)
+	return ValidateInputAt(diff, policy.SpecKey)
+}
+
+// ValidateInputAt checks the spec block found at the given key of the diff has exactly one valid input recipe block.
+func ValidateInputAt(diff *schema.ResourceDiff, specKey string) error {
+	value, ok := diff.GetOk(specKey)
 	if !ok {
 		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
 	}
@@ -192,7 +219,7 @@ func ValidateInput(_ context.Context, diff *schema.ResourceDiff, _ interface{})
 		return fmt.Errorf("found input recipes: %v are not valid: maximum one valid input recipe block is allowed", strings.Join(recipesFound, `, `))
 	}
 
-	return nil
+	return validateInputRecipe(constructInput(inputType))
 }
 
 func appendRecipeFromInput(inputData map[string]interface{}) (recipesFound []string) {
@@ -205,6 +232,8 @@ func appendRecipeFromInput(inputData map[string]interface{}) (recipesFound []str
 		reciperesource.DenyAllEgressKey,
 		reciperesource.CustomEgressKey,
 		reciperesource.CustomIngressKey,
+		reciperesource.AllowDNSEgressKey,
+		reciperesource.AllowFromNamespacesKey,
 	}
 
 	for _, recipeKey := range recipeKeys {
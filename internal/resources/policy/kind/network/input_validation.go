@@ -0,0 +1,74 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindnetwork
+
+import (
+	"fmt"
+	"strings"
+
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network/recipe"
+)
+
+// validateInputRecipe checks the port ranges and IP blocks of the input recipe, which cannot be validated per attribute.
+func validateInputRecipe(inputRecipeData *inputRecipe) error {
+	if inputRecipeData == nil {
+		return nil
+	}
+
+	errStrings := make([]string, 0)
+
+	switch inputRecipeData.recipe {
+	case CustomEgressRecipe:
+		if inputRecipeData.inputCustomEgress != nil {
+			errStrings = append(errStrings, validateCustomRules(inputRecipeData.inputCustomEgress.Rules)...)
+		}
+	case CustomIngressRecipe:
+		if inputRecipeData.inputCustomIngress != nil {
+			errStrings = append(errStrings, validateCustomRules(inputRecipeData.inputCustomIngress.Rules)...)
+		}
+	case AllowFromNamespacesRecipe:
+		if allowFromNamespaces := inputRecipeData.inputAllowFromNamespaces; allowFromNamespaces != nil {
+			if len(allowFromNamespaces.FromNamespaces) == 0 && len(allowFromNamespaces.FromNamespaceLabels) == 0 {
+				errStrings = append(errStrings, "- minimum one of from_namespaces or from_namespace_labels is required")
+			}
+
+			for _, port := range allowFromNamespaces.Ports {
+				if err := reciperesource.ValidatePortRange(port); err != nil {
+					errStrings = append(errStrings, fmt.Sprintf("- %v", err))
+				}
+			}
+		}
+	}
+
+	if len(errStrings) != 0 {
+		return fmt.Errorf("%s input recipe is not valid:\n%s", inputRecipeData.recipe, strings.Join(errStrings, "\n"))
+	}
+
+	return nil
+}
+
+func validateCustomRules(rules []policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRules) (errStrings []string) {
+	for _, rule := range rules {
+		if rule.Ports != nil {
+			for i := range *rule.Ports {
+				if err := reciperesource.ValidatePortRange(&(*rule.Ports)[i]); err != nil {
+					errStrings = append(errStrings, fmt.Sprintf("- %v", err))
+				}
+			}
+		}
+
+		for _, ruleSpec := range rule.RuleSpec {
+			if ipRuleSpec, ok := ruleSpec.(*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0); ok && ipRuleSpec != nil {
+				if err := reciperesource.ValidateIPBlock(ipRuleSpec.IpBlock); err != nil {
+					errStrings = append(errStrings, fmt.Sprintf("- %v", err))
+				}
+			}
+		}
+	}
+
+	return errStrings
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindnetwork
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipenetworkmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network"
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
+)
+
+func TestValidateInputRecipe(t *testing.T) {
+	t.Parallel()
+
+	endPort := int32(8090)
+
+	cases := []struct {
+		description string
+		input       *inputRecipe
+		expectError bool
+	}{
+		{
+			description: "check for nil input recipe",
+			input:       nil,
+		},
+		{
+			description: "custom egress rule with port range and IP block",
+			input: &inputRecipe{
+				recipe: CustomEgressRecipe,
+				inputCustomEgress: &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1CustomEgress{
+					Rules: []policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRules{
+						{
+							Ports: &[]policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{
+								{Port: helper.StringPointer("8080"), EndPort: &endPort},
+							},
+							RuleSpec: []interface{}{
+								&policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0{
+									IpBlock: &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock{
+										Cidr:   "10.0.0.0/8",
+										Except: &[]string{"10.1.0.0/16"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "custom ingress rule with IP block except outside of CIDR",
+			input: &inputRecipe{
+				recipe: CustomIngressRecipe,
+				inputCustomIngress: &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1CustomIngress{
+					Rules: []policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRules{
+						{
+							RuleSpec: []interface{}{
+								&policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0{
+									IpBlock: &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock{
+										Cidr:   "10.0.0.0/8",
+										Except: &[]string{"192.168.0.0/16"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			description: "allow from namespaces without namespaces",
+			input: &inputRecipe{
+				recipe:                   AllowFromNamespacesRecipe,
+				inputAllowFromNamespaces: &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces{},
+			},
+			expectError: true,
+		},
+		{
+			description: "allow from namespaces with named port range",
+			input: &inputRecipe{
+				recipe: AllowFromNamespacesRecipe,
+				inputAllowFromNamespaces: &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces{
+					FromNamespaces: []string{"monitoring"},
+					Ports: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{
+						{Port: helper.StringPointer("metrics"), EndPort: &endPort},
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateInputRecipe(test.input)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipenetworkmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network"
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
+)
+
+func TestFlattenAllowDNSEgress(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil allow-dns-egress recipe network policy",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "scenario with default cluster DNS",
+			input:       &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress{},
+			expected: []interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			description: "normal scenario with valid allow-dns-egress recipe network policy",
+			input: &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress{
+				DNSNamespaceLabels: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels{
+					{
+						Key:   "kubernetes.io/metadata.name",
+						Value: "dns",
+					},
+				},
+				DNSPodLabels: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels{
+					{
+						Key:   "app",
+						Value: "coredns",
+					},
+				},
+				ToPodLabels: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels{
+					{
+						Key:   "foo",
+						Value: "bar",
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					dnsNamespaceLabelsKey: map[string]interface{}{"kubernetes.io/metadata.name": "dns"},
+					dnsPodLabelsKey:       map[string]interface{}{"app": "coredns"},
+					ToPodLabelsKey:        map[string]interface{}{"foo": "bar"},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenAllowDNSEgress(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
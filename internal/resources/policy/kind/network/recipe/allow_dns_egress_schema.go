@@ -0,0 +1,65 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policyrecipenetworkmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network"
+)
+
+var AllowDNSEgress = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for network policy allow-dns-egress recipe version v1, only allowing egress traffic of the selected pods to the cluster DNS on port 53 (TCP and UDP)",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dnsNamespaceLabelsKey: labelsSchema("Labels of the namespace running the cluster DNS. Defaults to the kube-system namespace."),
+			dnsPodLabelsKey:       labelsSchema("Labels of the cluster DNS pods. Defaults to k8s-app: kube-dns."),
+			ToPodLabelsKey:        toPodLabel,
+		},
+	},
+}
+
+func ConstructAllowDNSEgress(data []interface{}) (allowDNSEgress *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress) {
+	if len(data) == 0 || data[0] == nil {
+		return allowDNSEgress
+	}
+
+	allowDNSEgressData, _ := data[0].(map[string]interface{})
+
+	allowDNSEgress = &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress{
+		DNSNamespaceLabels: constructLabels(allowDNSEgressData[dnsNamespaceLabelsKey]),
+		DNSPodLabels:       constructLabels(allowDNSEgressData[dnsPodLabelsKey]),
+		ToPodLabels:        constructLabels(allowDNSEgressData[ToPodLabelsKey]),
+	}
+
+	return allowDNSEgress
+}
+
+func FlattenAllowDNSEgress(allowDNSEgress *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress) (data []interface{}) {
+	if allowDNSEgress == nil {
+		return data
+	}
+
+	flattenAllowDNSEgress := make(map[string]interface{})
+
+	if allowDNSEgress.DNSNamespaceLabels != nil {
+		flattenAllowDNSEgress[dnsNamespaceLabelsKey] = flattenLabels(allowDNSEgress.DNSNamespaceLabels)
+	}
+
+	if allowDNSEgress.DNSPodLabels != nil {
+		flattenAllowDNSEgress[dnsPodLabelsKey] = flattenLabels(allowDNSEgress.DNSPodLabels)
+	}
+
+	if allowDNSEgress.ToPodLabels != nil {
+		flattenAllowDNSEgress[ToPodLabelsKey] = flattenLabels(allowDNSEgress.ToPodLabels)
+	}
+
+	return []interface{}{flattenAllowDNSEgress}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipenetworkmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network"
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
+)
+
+func TestFlattenAllowFromNamespaces(t *testing.T) {
+	t.Parallel()
+
+	endPort := int32(8090)
+
+	cases := []struct {
+		description string
+		input       *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil allow-from-namespaces recipe network policy",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with named port and port range",
+			input: &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces{
+				FromNamespaces: []string{"monitoring"},
+				FromNamespaceLabels: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels{
+					{
+						Key:   "team",
+						Value: "frontend",
+					},
+				},
+				Ports: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{
+					{
+						Port:     helper.StringPointer("metrics"),
+						Protocol: policyrecipenetworkcommonmodel.NewV1alpha1CommonPolicySpecNetworkV1CustomRulesPortsProtocol(policyrecipenetworkcommonmodel.TCP),
+					},
+					{
+						Port:     helper.StringPointer("8080"),
+						EndPort:  &endPort,
+						Protocol: policyrecipenetworkcommonmodel.NewV1alpha1CommonPolicySpecNetworkV1CustomRulesPortsProtocol(policyrecipenetworkcommonmodel.TCP),
+					},
+				},
+				ToPodLabels: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels{
+					{
+						Key:   "foo",
+						Value: "bar",
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					fromNamespacesKey:      []string{"monitoring"},
+					fromNamespaceLabelsKey: map[string]interface{}{"team": "frontend"},
+					portsKey: []interface{}{
+						map[string]interface{}{
+							portKey:     "metrics",
+							protocolKey: "TCP",
+						},
+						map[string]interface{}{
+							portKey:     "8080",
+							endPortKey:  8090,
+							protocolKey: "TCP",
+						},
+					},
+					ToPodLabelsKey: map[string]interface{}{"foo": "bar"},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenAllowFromNamespaces(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipenetworkmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network"
+)
+
+var AllowFromNamespaces = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for network policy allow-from-namespaces recipe version v1, only allowing ingress traffic to the selected pods from the given namespaces",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			fromNamespacesKey: {
+				Type:        schema.TypeList,
+				Description: "Names of the namespaces allowed as ingress sources. At least one of from_namespaces or from_namespace_labels is required.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 63),
+				},
+			},
+			fromNamespaceLabelsKey: labelsSchema("Labels of the namespaces allowed as ingress sources. At least one of from_namespaces or from_namespace_labels is required."),
+			portsKey: {
+				Type:        schema.TypeList,
+				Description: "List of ports which should be made accessible on the selected pods. Each item in this list is combined using a logical OR. Default is all ports.",
+				Optional:    true,
+				Elem:        port,
+			},
+			ToPodLabelsKey: toPodLabel,
+		},
+	},
+}
+
+func ConstructAllowFromNamespaces(data []interface{}) (allowFromNamespaces *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces) {
+	if len(data) == 0 || data[0] == nil {
+		return allowFromNamespaces
+	}
+
+	allowFromNamespacesData, _ := data[0].(map[string]interface{})
+
+	allowFromNamespaces = &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces{
+		FromNamespaceLabels: constructLabels(allowFromNamespacesData[fromNamespaceLabelsKey]),
+		ToPodLabels:         constructLabels(allowFromNamespacesData[ToPodLabelsKey]),
+	}
+
+	if namespacesData, ok := allowFromNamespacesData[fromNamespacesKey].([]interface{}); ok {
+		for _, namespace := range namespacesData {
+			if name, ok := namespace.(string); ok {
+				allowFromNamespaces.FromNamespaces = append(allowFromNamespaces.FromNamespaces, name)
+			}
+		}
+	}
+
+	if portsData, ok := allowFromNamespacesData[portsKey].([]interface{}); ok {
+		for _, raw := range portsData {
+			port := expandPort(raw)
+			allowFromNamespaces.Ports = append(allowFromNamespaces.Ports, &port)
+		}
+	}
+
+	return allowFromNamespaces
+}
+
+func FlattenAllowFromNamespaces(allowFromNamespaces *policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces) (data []interface{}) {
+	if allowFromNamespaces == nil {
+		return data
+	}
+
+	flattenAllowFromNamespaces := make(map[string]interface{})
+
+	if allowFromNamespaces.FromNamespaces != nil {
+		flattenAllowFromNamespaces[fromNamespacesKey] = allowFromNamespaces.FromNamespaces
+	}
+
+	if allowFromNamespaces.FromNamespaceLabels != nil {
+		flattenAllowFromNamespaces[fromNamespaceLabelsKey] = flattenLabels(allowFromNamespaces.FromNamespaceLabels)
+	}
+
+	if allowFromNamespaces.Ports != nil {
+		var ports []interface{}
+
+		for _, port := range allowFromNamespaces.Ports {
+			ports = append(ports, flattenPort(port))
+		}
+
+		flattenAllowFromNamespaces[portsKey] = ports
+	}
+
+	if allowFromNamespaces.ToPodLabels != nil {
+		flattenAllowFromNamespaces[ToPodLabelsKey] = flattenLabels(allowFromNamespaces.ToPodLabels)
+	}
+
+	return []interface{}{flattenAllowFromNamespaces}
+}
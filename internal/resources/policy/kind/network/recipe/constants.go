@@ -6,30 +6,37 @@ SPDX-License-Identifier: MPL-2.0
 package recipe
 
 const (
-	AllowAllKey          = "allow_all"
-	AllowAllToPodsKey    = "allow_all_to_pods"
-	AllowAllEgressKey    = "allow_all_egress"
-	DenyAllKey           = "deny_all"
-	DenyAllToPodsKey     = "deny_all_to_pods"
-	DenyAllEgressKey     = "deny_all_egress"
-	CustomEgressKey      = "custom_egress"
-	CustomIngressKey     = "custom_ingress"
-	FromOwnNamespaceKey  = "from_own_namespace"
-	ToPodLabelsKey       = "to_pod_labels"
-	LabelKey             = "key"
-	LabelValueKey        = "value"
-	rulesKey             = "rules"
-	portsKey             = "ports"
-	portKey              = "port"
-	protocolKey          = "protocol"
-	ruleSpecKey          = "rule_spec"
-	ruleSpecIPKey        = "custom_ip"
-	ruleSpecSelectorKey  = "custom_selector"
-	ipBlockKey           = "ip_block"
-	cidrKey              = "cidr"
-	exceptKey            = "except"
-	namespaceSelectorKey = "namespace_selector"
-	podSelectorKey       = "pod_selector"
+	AllowAllKey            = "allow_all"
+	AllowAllToPodsKey      = "allow_all_to_pods"
+	AllowAllEgressKey      = "allow_all_egress"
+	DenyAllKey             = "deny_all"
+	DenyAllToPodsKey       = "deny_all_to_pods"
+	DenyAllEgressKey       = "deny_all_egress"
+	CustomEgressKey        = "custom_egress"
+	CustomIngressKey       = "custom_ingress"
+	AllowDNSEgressKey      = "allow_dns_egress"
+	AllowFromNamespacesKey = "allow_from_namespaces"
+	FromOwnNamespaceKey    = "from_own_namespace"
+	ToPodLabelsKey         = "to_pod_labels"
+	LabelKey               = "key"
+	LabelValueKey          = "value"
+	rulesKey               = "rules"
+	portsKey               = "ports"
+	portKey                = "port"
+	protocolKey            = "protocol"
+	ruleSpecKey            = "rule_spec"
+	ruleSpecIPKey          = "custom_ip"
+	ruleSpecSelectorKey    = "custom_selector"
+	ipBlockKey             = "ip_block"
+	cidrKey                = "cidr"
+	exceptKey              = "except"
+	namespaceSelectorKey   = "namespace_selector"
+	podSelectorKey         = "pod_selector"
+	endPortKey             = "end_port"
+	dnsNamespaceLabelsKey  = "dns_namespace_labels"
+	dnsPodLabelsKey        = "dns_pod_labels"
+	fromNamespacesKey      = "from_namespaces"
+	fromNamespaceLabelsKey = "from_namespace_labels"
 
 	IPBlock           = "ipBlock"
 	PodSelector       = "podSelector"
@@ -8,8 +8,13 @@ package recipe
 import (
 	"encoding/json"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
@@ -19,9 +24,16 @@ var (
 	port = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			portKey: {
-				Type:        schema.TypeString,
-				Description: "The port on the given protocol. This can either be a numerical or named port on a pod.",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "The port on the given protocol. This can either be a numerical or named port on a pod.",
+				Optional:     true,
+				ValidateFunc: validatePort,
+			},
+			endPortKey: {
+				Type:         schema.TypeInt,
+				Description:  "If set, the range of ports from port to end_port, inclusive, is matched. Requires a numerical port lower than or equal to end_port.",
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
 			},
 			protocolKey: {
 				Type:        schema.TypeString,
@@ -40,16 +52,18 @@ var (
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						cidrKey: {
-							Type:        schema.TypeString,
-							Description: "CIDR is a string representing the IP Block Valid examples are \"192.168.1.1/24\" or \"2001:db9::/64\"",
-							Required:    true,
+							Type:         schema.TypeString,
+							Description:  "CIDR is a string representing the IP Block Valid examples are \"192.168.1.1/24\" or \"2001:db9::/64\"",
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
 						},
 						exceptKey: {
 							Type:        schema.TypeList,
 							Description: "Except is a slice of CIDRs that should not be included within an IP Block Valid examples are \"192.168.1.1/24\" or \"2001:db9::/64\" Except values will be rejected if they are outside the CIDR range",
 							Optional:    true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
 							},
 						},
 					},
@@ -158,9 +172,87 @@ func expandPort(data interface{}) (port policyrecipenetworkcommonmodel.V1alpha1C
 		port.Protocol = &protocol
 	}
 
+	if endPortData, ok := portData[endPortKey].(int); ok && endPortData != 0 {
+		endPort := int32(endPortData)
+		port.EndPort = &endPort
+	}
+
 	return port
 }
 
+// validatePort accepts a numerical port or an IANA service name, as a named port of a pod.
+func validatePort(v interface{}, k string) (warnings []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if number, err := strconv.Atoi(value); err == nil {
+		if msgs := k8svalidation.IsValidPortNum(number); len(msgs) != 0 {
+			errs = append(errs, fmt.Errorf("%s: %q is not a valid port: %s", k, value, strings.Join(msgs, "; ")))
+		}
+
+		return warnings, errs
+	}
+
+	if msgs := k8svalidation.IsValidPortName(value); len(msgs) != 0 {
+		errs = append(errs, fmt.Errorf("%s: %q is not a valid named port: %s", k, value, strings.Join(msgs, "; ")))
+	}
+
+	return warnings, errs
+}
+
+// ValidatePortRange checks end_port is only set on a numerical port lower than or equal to it.
+func ValidatePortRange(port *policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts) error {
+	if port == nil || port.EndPort == nil {
+		return nil
+	}
+
+	if port.Port == nil || *port.Port == "" {
+		return fmt.Errorf("%s: %d is not valid: a numerical port is required", endPortKey, *port.EndPort)
+	}
+
+	number, err := strconv.Atoi(*port.Port)
+	if err != nil {
+		return fmt.Errorf("%s: %d is not valid: named port %q cannot be used in a port range", endPortKey, *port.EndPort, *port.Port)
+	}
+
+	if int32(number) > *port.EndPort {
+		return fmt.Errorf("%s: %d is not valid: it must be greater than or equal to port %d", endPortKey, *port.EndPort, number)
+	}
+
+	return nil
+}
+
+// ValidateIPBlock checks the except CIDRs of the IP block are within its CIDR.
+func ValidateIPBlock(ipBlock *policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock) error {
+	if ipBlock == nil || ipBlock.Except == nil {
+		return nil
+	}
+
+	_, cidr, err := net.ParseCIDR(ipBlock.Cidr)
+	if err != nil {
+		return fmt.Errorf("%s: %q is not a valid CIDR: %v", cidrKey, ipBlock.Cidr, err)
+	}
+
+	cidrPrefix, _ := cidr.Mask.Size()
+
+	for _, except := range *ipBlock.Except {
+		exceptIP, exceptCIDR, err := net.ParseCIDR(except)
+		if err != nil {
+			return fmt.Errorf("%s: %q is not a valid CIDR: %v", exceptKey, except, err)
+		}
+
+		exceptPrefix, _ := exceptCIDR.Mask.Size()
+
+		if !cidr.Contains(exceptIP) || exceptPrefix < cidrPrefix {
+			return fmt.Errorf("%s: %q is not valid: it must be within %s %q", exceptKey, except, cidrKey, ipBlock.Cidr)
+		}
+	}
+
+	return nil
+}
+
 func constructRuleSpec(data interface{}) (customData *custom) {
 	if data == nil {
 		return customData
@@ -368,9 +460,17 @@ func flattenPort(port *policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNe
 
 	flattenPortData := make(map[string]interface{})
 
-	flattenPortData[portKey] = *port.Port
+	if port.Port != nil {
+		flattenPortData[portKey] = *port.Port
+	}
 
-	flattenPortData[protocolKey] = string(*port.Protocol)
+	if port.Protocol != nil {
+		flattenPortData[protocolKey] = string(*port.Protocol)
+	}
+
+	if port.EndPort != nil {
+		flattenPortData[endPortKey] = int(*port.EndPort)
+	}
 
 	return flattenPortData
 }
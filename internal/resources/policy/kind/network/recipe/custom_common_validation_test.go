@@ -0,0 +1,154 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
+)
+
+func TestValidatePort(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       interface{}
+		expectError bool
+	}{
+		{
+			description: "numerical port",
+			input:       "8443",
+		},
+		{
+			description: "named port",
+			input:       "https",
+		},
+		{
+			description: "out of range numerical port",
+			input:       "70000",
+			expectError: true,
+		},
+		{
+			description: "invalid named port",
+			input:       "not_a_port_name",
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, errs := validatePort(test.input, portKey)
+			if test.expectError {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestValidatePortRange(t *testing.T) {
+	t.Parallel()
+
+	endPort := int32(8090)
+
+	cases := []struct {
+		description string
+		input       *policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts
+		expectError bool
+	}{
+		{
+			description: "port without range",
+			input:       &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{Port: helper.StringPointer("https")},
+		},
+		{
+			description: "valid port range",
+			input:       &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{Port: helper.StringPointer("8080"), EndPort: &endPort},
+		},
+		{
+			description: "port range with named port",
+			input:       &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{Port: helper.StringPointer("https"), EndPort: &endPort},
+			expectError: true,
+		},
+		{
+			description: "port range without port",
+			input:       &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{EndPort: &endPort},
+			expectError: true,
+		},
+		{
+			description: "end port lower than port",
+			input:       &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesPorts{Port: helper.StringPointer("9090"), EndPort: &endPort},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := ValidatePortRange(test.input)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateIPBlock(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock
+		expectError bool
+	}{
+		{
+			description: "IP block without except",
+			input:       &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock{Cidr: "10.0.0.0/8"},
+		},
+		{
+			description: "except within CIDR",
+			input: &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock{
+				Cidr:   "10.0.0.0/8",
+				Except: &[]string{"10.1.0.0/16", "10.2.3.0/24"},
+			},
+		},
+		{
+			description: "except outside of CIDR",
+			input: &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock{
+				Cidr:   "10.0.0.0/8",
+				Except: &[]string{"192.168.0.0/16"},
+			},
+			expectError: true,
+		},
+		{
+			description: "except wider than CIDR",
+			input: &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1CustomRulesRuleSpec0IpBlock{
+				Cidr:   "10.1.0.0/16",
+				Except: &[]string{"10.0.0.0/8"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := ValidateIPBlock(test.input)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
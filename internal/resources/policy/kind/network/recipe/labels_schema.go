@@ -6,8 +6,12 @@ SPDX-License-Identifier: MPL-2.0
 package recipe
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipenetworkcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/network/common"
 )
 
 var toPodLabel = &schema.Schema{
@@ -22,3 +26,44 @@ var toPodLabel = &schema.Schema{
 		),
 	},
 }
+
+func labelsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: description,
+		Optional:    true,
+		Elem:        toPodLabel.Elem,
+	}
+}
+
+func constructLabels(data interface{}) (labels []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels) {
+	labelsData, ok := data.(map[string]interface{})
+	if !ok || len(labelsData) == 0 {
+		return labels
+	}
+
+	labels = make([]*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels, 0)
+
+	for key, value := range labelsData {
+		labels = append(labels, &policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels{
+			Key:   key,
+			Value: fmt.Sprintf("%v", value),
+		})
+	}
+
+	return labels
+}
+
+func flattenLabels(labels []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels) (data map[string]interface{}) {
+	if labels == nil {
+		return data
+	}
+
+	data = make(map[string]interface{})
+
+	for _, label := range labels {
+		data[label.Key] = label.Value
+	}
+
+	return data
+}
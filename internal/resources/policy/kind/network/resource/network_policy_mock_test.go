@@ -48,7 +48,7 @@ func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
 	referenceArray := make([]*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference, 0)
 	referenceArray = append(referenceArray, &reference)
 
-	for _, recipe := range []string{"allow-all", "allow-all-to-pods", "allow-all-egress", "deny-all", "deny-all-to-pods", "deny-all-egress", "custom-egress", "custom-ingress"} {
+	for _, recipe := range []string{"allow-all", "allow-all-to-pods", "allow-all-egress", "deny-all", "deny-all-to-pods", "deny-all-egress", "custom-egress", "custom-ingress", "allow-dns-egress"} {
 		postWorkspacePolicyModel := &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicy{
 			FullName: &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName{
 				Name:          testConfig.NetworkPolicyName + recipe,
@@ -327,6 +327,15 @@ func getMockPolicyCreateSpec(recipe string) *policymodel.VmwareTanzuManageV1alph
 	//			}
 	//		}(),
 	//	}
+	case "allow-dns-egress":
+		spec.Input = &policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress{
+			ToPodLabels: []*policyrecipenetworkcommonmodel.V1alpha1CommonPolicySpecNetworkV1Labels{
+				{
+					Key:   "key1",
+					Value: "value1",
+				},
+			},
+		}
 	case "allow-all-egress", "deny-all", "deny-all-egress":
 		spec.Input = struct{}{}
 	}
@@ -27,6 +27,7 @@ func ResourceNetworkPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindnetwork.ResourceName])),
 			policykindnetwork.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateSpecExclusions,
 		),
 	}
 }
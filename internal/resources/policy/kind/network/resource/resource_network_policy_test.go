@@ -326,6 +326,59 @@ func TestAcceptanceForNetworkPolicyResource(t *testing.T) {
 	})
 	t.Log("Network policy resource acceptance test complete for custom-ingress recipe!")
 
+	// Test case for network policy resource with allow-dns-egress recipe.
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig.getTestNetworkPolicyResourceBasicConfigValue(scope.WorkspaceScope, policykindNetwork.AllowDNSEgressRecipe),
+				Check:  testConfig.checkNetworkPolicyResourceAttributes(scope.WorkspaceScope, policykindNetwork.AllowDNSEgressRecipe),
+			},
+			{
+				PreConfig: func() {
+					if testConfig.ScopeHelperResources.OrgID == "" {
+						t.Skip("ORG_ID env var is not set for organization scoped network policy acceptance test")
+					}
+				},
+				Config: testConfig.getTestNetworkPolicyResourceBasicConfigValue(scope.OrganizationScope, policykindNetwork.AllowDNSEgressRecipe),
+				Check:  testConfig.checkNetworkPolicyResourceAttributes(scope.OrganizationScope, policykindNetwork.AllowDNSEgressRecipe),
+			},
+		},
+	})
+
+	t.Log("Network policy resource acceptance test complete for allow-dns-egress recipe!")
+
+	// Test case for network policy resource with allow-from-namespaces recipe.
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: func() []resource.TestStep {
+			if found {
+				steps := []resource.TestStep{
+					{
+						Config: testConfig.getTestNetworkPolicyResourceBasicConfigValue(scope.WorkspaceScope, policykindNetwork.AllowFromNamespacesRecipe),
+						Check:  testConfig.checkNetworkPolicyResourceAttributes(scope.WorkspaceScope, policykindNetwork.AllowFromNamespacesRecipe),
+					},
+					{
+						PreConfig: func() {
+							if testConfig.ScopeHelperResources.OrgID == "" {
+								t.Skip("ORG_ID env var is not set for organization scoped network policy acceptance test")
+							}
+						},
+						Config: testConfig.getTestNetworkPolicyResourceBasicConfigValue(scope.OrganizationScope, policykindNetwork.AllowFromNamespacesRecipe),
+						Check:  testConfig.checkNetworkPolicyResourceAttributes(scope.OrganizationScope, policykindNetwork.AllowFromNamespacesRecipe),
+					},
+				}
+				return steps
+			}
+			return []resource.TestStep{}
+		}(),
+	})
+	t.Log("Network policy resource acceptance test complete for allow-from-namespaces recipe!")
+
 	t.Log("all network policy resource acceptance tests complete!")
 }
 
@@ -476,7 +529,7 @@ func (testConfig *testAcceptanceConfig) getTestNetworkPolicyResourceInput(recipe
               ip_block {
                 cidr = "192.168.1.1/24"
                 except = [
-                  "2001:db9::/64",
+                  "192.168.1.1/25",
                 ]
               }
             }
@@ -516,7 +569,7 @@ func (testConfig *testAcceptanceConfig) getTestNetworkPolicyResourceInput(recipe
               ip_block {
                 cidr = "192.168.1.1/24"
                 except = [
-                  "2001:db9::/64",
+                  "192.168.1.1/25",
                 ]
               }
             }
@@ -540,6 +593,40 @@ func (testConfig *testAcceptanceConfig) getTestNetworkPolicyResourceInput(recipe
         }
       }
     }
+`
+		inputBlock = fmt.Sprintf(inputBlock, cfg.podLabelKey, cfg.podLabelValue)
+	case policykindNetwork.AllowDNSEgressRecipe:
+		inputBlock = `
+    input {
+      allow_dns_egress {
+        to_pod_labels = {
+          "%s" = "%s"
+        }
+      }
+    }
+`
+		inputBlock = fmt.Sprintf(inputBlock, cfg.podLabelKey, cfg.podLabelValue)
+	case policykindNetwork.AllowFromNamespacesRecipe:
+		inputBlock = `
+    input {
+      allow_from_namespaces {
+        from_namespaces = [
+          "monitoring",
+        ]
+        ports {
+          port = "metrics"
+          protocol = "TCP"
+        }
+        ports {
+          port = "8080"
+          end_port = 8090
+          protocol = "TCP"
+        }
+        to_pod_labels = {
+          "%s" = "%s"
+        }
+      }
+    }
 `
 		inputBlock = fmt.Sprintf(inputBlock, cfg.podLabelKey, cfg.podLabelValue)
 	case policykindNetwork.UnknownRecipe:
@@ -26,6 +26,7 @@ var SpecSchema = &schema.Schema{
 		Schema: map[string]*schema.Schema{
 			policy.InputKey:             inputSchema,
 			policy.NamespaceSelectorKey: policy.NamespaceSelector,
+			policy.ExclusionsKey:        policy.Exclusions,
 		},
 	},
 }
@@ -38,6 +39,11 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 
 	data, _ := value.([]interface{})
 
+	return ConstructSpecFromData(data)
+}
+
+// ConstructSpecFromData constructs the network policy spec from the data of a spec block.
+func ConstructSpecFromData(data []interface{}) (spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) {
 	if len(data) == 0 || data[0] == nil {
 		return spec
 	}
@@ -88,6 +94,14 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		if inputRecipeData.inputCustomIngress != nil {
 			spec.Input = *inputRecipeData.inputCustomIngress
 		}
+	case AllowDNSEgressRecipe:
+		if inputRecipeData.inputAllowDNSEgress != nil {
+			spec.Input = *inputRecipeData.inputAllowDNSEgress
+		}
+	case AllowFromNamespacesRecipe:
+		if inputRecipeData.inputAllowFromNamespaces != nil {
+			spec.Input = *inputRecipeData.inputAllowFromNamespaces
+		}
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -98,6 +112,12 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		}
 	}
 
+	if v, ok := specData[policy.ExclusionsKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			spec.NamespaceSelector = policy.ExcludeNamespaces(spec.NamespaceSelector, v1)
+		}
+	}
+
 	return spec
 }
 
@@ -197,6 +217,30 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 			recipe:             CustomIngressRecipe,
 			inputCustomIngress: &customIngressRecipeInput,
 		}
+	case string(AllowDNSEgressRecipe):
+		var allowDNSEgressRecipeInput policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowDNSEgress
+
+		err = allowDNSEgressRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:              AllowDNSEgressRecipe,
+			inputAllowDNSEgress: &allowDNSEgressRecipeInput,
+		}
+	case string(AllowFromNamespacesRecipe):
+		var allowFromNamespacesRecipeInput policyrecipenetworkmodel.V1alpha1CommonPolicySpecNetworkV1AllowFromNamespaces
+
+		err = allowFromNamespacesRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:                   AllowFromNamespacesRecipe,
+			inputAllowFromNamespaces: &allowFromNamespacesRecipeInput,
+		}
 	case string(UnknownRecipe):
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
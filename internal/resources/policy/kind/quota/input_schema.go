@@ -113,7 +113,12 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 }
 
 func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
-	value, ok := diff.GetOk(policy.SpecKey)
+	return ValidateInputAt(diff, policy.SpecKey)
+}
+
+// ValidateInputAt checks the spec block found at the given key of the diff has exactly one valid input recipe block.
+func ValidateInputAt(diff *schema.ResourceDiff, specKey string) error {
+	value, ok := diff.GetOk(specKey)
 	if !ok {
 		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
 	}
@@ -146,6 +151,12 @@ func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}
 	if v, ok := inputData[reciperesource.CustomKey]; ok {
 		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
 			recipesFound = append(recipesFound, reciperesource.CustomKey)
+
+			if custom := reciperesource.ConstructCustom(v1); custom != nil {
+				if err := validateLimitRange(custom.LimitRange); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
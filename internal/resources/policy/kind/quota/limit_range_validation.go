@@ -0,0 +1,81 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindquota
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota/recipe"
+)
+
+type limitRangeValue struct {
+	key    string
+	values *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues
+}
+
+// validateLimitRange checks the LimitRange values are ordered the way Kubernetes admits them: min <= default_request <= default <= max.
+func validateLimitRange(limitRange *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange) error {
+	if limitRange == nil {
+		return nil
+	}
+
+	ordered := []limitRangeValue{
+		{key: reciperesource.MinKey, values: limitRange.Min},
+		{key: reciperesource.DefaultRequestKey, values: limitRange.DefaultRequest},
+		{key: reciperesource.DefaultKey, values: limitRange.Default},
+		{key: reciperesource.MaxKey, values: limitRange.Max},
+	}
+
+	errStrings := make([]string, 0)
+
+	for _, resourceKey := range []string{reciperesource.CPUKey, reciperesource.MemoryKey} {
+		for i := range ordered {
+			lower, ok := limitRangeQuantity(ordered[i].values, resourceKey)
+			if !ok {
+				continue
+			}
+
+			for _, upperValue := range ordered[i+1:] {
+				upper, ok := limitRangeQuantity(upperValue.values, resourceKey)
+				if ok && lower.Cmp(upper) > 0 {
+					errStrings = append(errStrings, fmt.Sprintf("- %s %s: %s is greater than %s %s: %s", ordered[i].key, resourceKey, lower.String(), upperValue.key, resourceKey, upper.String()))
+				}
+			}
+		}
+	}
+
+	if len(errStrings) != 0 {
+		return fmt.Errorf("limit range values are not valid:\n%s", strings.Join(errStrings, "\n"))
+	}
+
+	return nil
+}
+
+func limitRangeQuantity(values *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues, resourceKey string) (resource.Quantity, bool) {
+	if values == nil {
+		return resource.Quantity{}, false
+	}
+
+	value := values.CPU
+	if resourceKey == reciperesource.MemoryKey {
+		value = values.Memory
+	}
+
+	if value == "" {
+		return resource.Quantity{}, false
+	}
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+
+	return quantity, true
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindquota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
+)
+
+func TestValidateLimitRange(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange
+		expectError bool
+	}{
+		{
+			description: "check for nil limit range",
+			input:       nil,
+		},
+		{
+			description: "ordered limit range values",
+			input: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange{
+				Min:            &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "100m", Memory: "64Mi"},
+				DefaultRequest: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "250m", Memory: "128Mi"},
+				Default:        &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "500m", Memory: "256Mi"},
+				Max:            &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "2", Memory: "1Gi"},
+			},
+		},
+		{
+			description: "partially set limit range values",
+			input: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange{
+				Default: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{Memory: "256Mi"},
+				Max:     &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "2"},
+			},
+		},
+		{
+			description: "default request greater than default",
+			input: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange{
+				DefaultRequest: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "1"},
+				Default:        &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "500m"},
+			},
+			expectError: true,
+		},
+		{
+			description: "min greater than max",
+			input: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange{
+				Min: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{Memory: "2Gi"},
+				Max: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{Memory: "1Gi"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			err := validateLimitRange(test.input)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
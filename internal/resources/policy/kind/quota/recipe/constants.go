@@ -19,4 +19,13 @@ const (
 	RequestsStorageKey                = "requests_storage"
 	RequestsStoragePerClassKey        = "requests_storage_per_class"
 	ResourceCountsKey                 = "resource_counts"
+	LimitRangeKey                     = "limit_range"
+	DefaultKey                        = "default"
+	DefaultRequestKey                 = "default_request"
+	MinKey                            = "min"
+	MaxKey                            = "max"
+	CPUKey                            = "cpu"
+	MemoryKey                         = "memory"
+	NamespaceOverrideKey              = "namespace_override"
+	NamespaceSelectorKey              = "namespace_selector"
 )
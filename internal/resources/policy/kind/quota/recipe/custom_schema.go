@@ -63,12 +63,9 @@ var Custom = &schema.Schema{
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			ResourceCountsKey: {
-				Type:        schema.TypeMap,
-				Description: "The total number of Services of the given type that can exist in a namespace",
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeInt},
-			},
+			ResourceCountsKey:    resourceCountsSchema,
+			LimitRangeKey:        LimitRange,
+			NamespaceOverrideKey: NamespaceOverride,
 		},
 	},
 }
@@ -118,6 +115,14 @@ func ConstructCustom(data []interface{}) (custom *policyrecipequotamodel.VmwareT
 		custom.ResourceCounts = common.GetTypeIntMapData(v.(map[string]interface{}))
 	}
 
+	if v, ok := customData[LimitRangeKey].([]interface{}); ok {
+		custom.LimitRange = ConstructLimitRange(v)
+	}
+
+	if v, ok := customData[NamespaceOverrideKey].([]interface{}); ok {
+		custom.NamespaceOverrides = ConstructNamespaceOverrides(v)
+	}
+
 	return custom
 }
 
@@ -138,5 +143,13 @@ func FlattenCustom(custom *policyrecipequotamodel.VmwareTanzuManageV1alpha1Commo
 	flattenCustom[RequestsStoragePerClassKey] = custom.RequestsStoragePerClass
 	flattenCustom[ResourceCountsKey] = custom.ResourceCounts
 
+	if custom.LimitRange != nil {
+		flattenCustom[LimitRangeKey] = FlattenLimitRange(custom.LimitRange)
+	}
+
+	if len(custom.NamespaceOverrides) != 0 {
+		flattenCustom[NamespaceOverrideKey] = FlattenNamespaceOverrides(custom.NamespaceOverrides)
+	}
+
 	return []interface{}{flattenCustom}
 }
@@ -0,0 +1,61 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
+)
+
+func TestFlattenLimitRange(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil limit range",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with partial limit range",
+			input: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange{
+				Default:        &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "500m", Memory: "256Mi"},
+				DefaultRequest: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{CPU: "250m"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					DefaultKey: []interface{}{
+						map[string]interface{}{
+							CPUKey:    "500m",
+							MemoryKey: "256Mi",
+						},
+					},
+					DefaultRequestKey: []interface{}{
+						map[string]interface{}{
+							CPUKey:    "250m",
+							MemoryKey: "",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenLimitRange(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
+)
+
+var LimitRange = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "LimitRange created in the namespaces, setting the default, minimum and maximum resources of their containers",
+	Optional:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			DefaultKey:        common.ResourceValuesSchema("Default limits set on the containers not defining them"),
+			DefaultRequestKey: common.ResourceValuesSchema("Default requests set on the containers not defining them"),
+			MinKey:            common.ResourceValuesSchema("Minimum requests a container can set"),
+			MaxKey:            common.ResourceValuesSchema("Maximum limits a container can set"),
+		},
+	},
+}
+
+func ConstructLimitRange(data []interface{}) (limitRange *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange) {
+	if len(data) == 0 || data[0] == nil {
+		return limitRange
+	}
+
+	limitRangeData, _ := data[0].(map[string]interface{})
+
+	limitRange = &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange{}
+
+	if v, ok := limitRangeData[DefaultKey].([]interface{}); ok {
+		limitRange.Default = constructResourceValues(v)
+	}
+
+	if v, ok := limitRangeData[DefaultRequestKey].([]interface{}); ok {
+		limitRange.DefaultRequest = constructResourceValues(v)
+	}
+
+	if v, ok := limitRangeData[MinKey].([]interface{}); ok {
+		limitRange.Min = constructResourceValues(v)
+	}
+
+	if v, ok := limitRangeData[MaxKey].([]interface{}); ok {
+		limitRange.Max = constructResourceValues(v)
+	}
+
+	return limitRange
+}
+
+func constructResourceValues(data []interface{}) (resourceValues *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues) {
+	if len(data) == 0 || data[0] == nil {
+		return resourceValues
+	}
+
+	resourceValuesData, _ := data[0].(map[string]interface{})
+
+	resourceValues = &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues{}
+
+	resourceValues.CPU, _ = resourceValuesData[CPUKey].(string)
+	resourceValues.Memory, _ = resourceValuesData[MemoryKey].(string)
+
+	return resourceValues
+}
+
+func FlattenLimitRange(limitRange *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1LimitRange) (data []interface{}) {
+	if limitRange == nil {
+		return data
+	}
+
+	flattenLimitRange := make(map[string]interface{})
+
+	if limitRange.Default != nil {
+		flattenLimitRange[DefaultKey] = flattenResourceValues(limitRange.Default)
+	}
+
+	if limitRange.DefaultRequest != nil {
+		flattenLimitRange[DefaultRequestKey] = flattenResourceValues(limitRange.DefaultRequest)
+	}
+
+	if limitRange.Min != nil {
+		flattenLimitRange[MinKey] = flattenResourceValues(limitRange.Min)
+	}
+
+	if limitRange.Max != nil {
+		flattenLimitRange[MaxKey] = flattenResourceValues(limitRange.Max)
+	}
+
+	return []interface{}{flattenLimitRange}
+}
+
+func flattenResourceValues(resourceValues *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1ResourceValues) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			CPUKey:    resourceValues.CPU,
+			MemoryKey: resourceValues.Memory,
+		},
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+)
+
+func TestFlattenNamespaceOverrides(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil namespace overrides",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete namespace override",
+			input: []*policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride{
+				{
+					NamespaceSelector: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+						MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+							{
+								Key:      "team",
+								Operator: "In",
+								Values:   []string{"ml"},
+							},
+						},
+					},
+					LimitsCPU:       "16",
+					LimitsMemory:    "64Gi",
+					RequestsCPU:     "8",
+					RequestsMemory:  "32Gi",
+					RequestsStorage: "100G",
+					ResourceCounts:  map[string]int{"requests.nvidia.com/gpu": 4},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NamespaceSelectorKey: []interface{}{
+						map[string]interface{}{
+							policy.MatchExpressionsKey: []interface{}{
+								map[string]interface{}{
+									policy.KeyKey:      "team",
+									policy.OperatorKey: "In",
+									policy.ValuesKey:   []string{"ml"},
+								},
+							},
+						},
+					},
+					LimitsCPUKey:       "16",
+					LimitsMemoryKey:    "64Gi",
+					RequestsCPUKey:     "8",
+					RequestsMemoryKey:  "32Gi",
+					RequestsStorageKey: "100G",
+					ResourceCountsKey:  map[string]int{"requests.nvidia.com/gpu": 4},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenNamespaceOverrides(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestValidateResourceCounts(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       interface{}
+		expectError bool
+	}{
+		{
+			description: "object counts and extended resource requests",
+			input: map[string]interface{}{
+				"pods":                    10,
+				"services.loadbalancers":  2,
+				"count/deployments.apps":  5,
+				"requests.nvidia.com/gpu": 4,
+			},
+		},
+		{
+			description: "extended resource limits",
+			input:       map[string]interface{}{"limits.nvidia.com/gpu": 4},
+			expectError: true,
+		},
+		{
+			description: "invalid resource name",
+			input:       map[string]interface{}{"requests.nvidia.com/gpu/a": 4},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, errs := validateResourceCounts(test.input, ResourceCountsKey)
+			if test.expectError {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+)
+
+const extendedResourceLimitsPrefix = "limits."
+
+var NamespaceOverride = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Quota values replacing the ones of the recipe for the namespaces matching the namespace selector",
+	Optional:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			NamespaceSelectorKey: {
+				Type:        schema.TypeList,
+				Description: "Label based selector of the namespaces the override applies to",
+				Required:    true,
+				MaxItems:    1,
+				Elem:        policy.NamespaceSelector.Elem,
+			},
+			LimitsCPUKey: {
+				Type:        schema.TypeString,
+				Description: "The sum of CPU limits across all pods in a non-terminal state cannot exceed this value",
+				Optional:    true,
+			},
+			LimitsMemoryKey: {
+				Type:        schema.TypeString,
+				Description: "The sum of memory limits across all pods in a non-terminal state cannot exceed this value",
+				Optional:    true,
+			},
+			RequestsCPUKey: {
+				Type:        schema.TypeString,
+				Description: "The sum of CPU requests across all pods in a non-terminal state cannot exceed this value",
+				Optional:    true,
+			},
+			RequestsMemoryKey: {
+				Type:        schema.TypeString,
+				Description: "The sum of memory requests across all pods in a non-terminal state cannot exceed this value",
+				Optional:    true,
+			},
+			RequestsStorageKey: {
+				Type:        schema.TypeString,
+				Description: "The sum of storage requests across all persistent volume claims cannot exceed this value",
+				Optional:    true,
+			},
+			ResourceCountsKey: resourceCountsSchema,
+		},
+	},
+}
+
+var resourceCountsSchema = &schema.Schema{
+	Type:         schema.TypeMap,
+	Description:  "The total number of objects or extended resources of the given name that can exist in a namespace, e.g. services.loadbalancers, count/deployments.apps or requests.nvidia.com/gpu",
+	Optional:     true,
+	Elem:         &schema.Schema{Type: schema.TypeInt},
+	ValidateFunc: validateResourceCounts,
+}
+
+// validateResourceCounts checks the resource names of the counts, Kubernetes only supports quota on the requests of extended resources.
+func validateResourceCounts(v interface{}, k string) (warnings []string, errs []error) {
+	counts, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be map", k)}
+	}
+
+	for name := range counts {
+		if msgs := validation.IsQualifiedName(name); len(msgs) != 0 {
+			errs = append(errs, fmt.Errorf("%s: %q is not a valid resource name: %s", k, name, strings.Join(msgs, "; ")))
+			continue
+		}
+
+		if strings.HasPrefix(name, extendedResourceLimitsPrefix) && strings.Contains(name, "/") {
+			errs = append(errs, fmt.Errorf("%s: %q is not valid: quota on extended resources is only supported on requests, e.g. requests.%s", k, name, strings.TrimPrefix(name, extendedResourceLimitsPrefix)))
+		}
+	}
+
+	return warnings, errs
+}
+
+func ConstructNamespaceOverrides(data []interface{}) (namespaceOverrides []*policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride) {
+	for _, raw := range data {
+		namespaceOverrideData, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		namespaceOverride := &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride{}
+
+		if v, ok := namespaceOverrideData[NamespaceSelectorKey].([]interface{}); ok {
+			namespaceOverride.NamespaceSelector = policy.ConstructNamespaceSelector(v)
+		}
+
+		if v, ok := namespaceOverrideData[LimitsCPUKey]; ok {
+			helper.SetPrimitiveValue(v, &namespaceOverride.LimitsCPU, LimitsCPUKey)
+		}
+
+		if v, ok := namespaceOverrideData[LimitsMemoryKey]; ok {
+			helper.SetPrimitiveValue(v, &namespaceOverride.LimitsMemory, LimitsMemoryKey)
+		}
+
+		if v, ok := namespaceOverrideData[RequestsCPUKey]; ok {
+			helper.SetPrimitiveValue(v, &namespaceOverride.RequestsCPU, RequestsCPUKey)
+		}
+
+		if v, ok := namespaceOverrideData[RequestsMemoryKey]; ok {
+			helper.SetPrimitiveValue(v, &namespaceOverride.RequestsMemory, RequestsMemoryKey)
+		}
+
+		if v, ok := namespaceOverrideData[RequestsStorageKey]; ok {
+			helper.SetPrimitiveValue(v, &namespaceOverride.RequestsStorage, RequestsStorageKey)
+		}
+
+		if v, ok := namespaceOverrideData[ResourceCountsKey]; ok {
+			namespaceOverride.ResourceCounts = common.GetTypeIntMapData(v.(map[string]interface{}))
+		}
+
+		namespaceOverrides = append(namespaceOverrides, namespaceOverride)
+	}
+
+	return namespaceOverrides
+}
+
+func FlattenNamespaceOverrides(namespaceOverrides []*policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1NamespaceOverride) (data []interface{}) {
+	for _, namespaceOverride := range namespaceOverrides {
+		if namespaceOverride == nil {
+			continue
+		}
+
+		flattenNamespaceOverride := make(map[string]interface{})
+
+		flattenNamespaceOverride[NamespaceSelectorKey] = policy.FlattenNamespaceSelector(namespaceOverride.NamespaceSelector)
+		flattenNamespaceOverride[LimitsCPUKey] = namespaceOverride.LimitsCPU
+		flattenNamespaceOverride[LimitsMemoryKey] = namespaceOverride.LimitsMemory
+		flattenNamespaceOverride[RequestsCPUKey] = namespaceOverride.RequestsCPU
+		flattenNamespaceOverride[RequestsMemoryKey] = namespaceOverride.RequestsMemory
+		flattenNamespaceOverride[RequestsStorageKey] = namespaceOverride.RequestsStorage
+		flattenNamespaceOverride[ResourceCountsKey] = namespaceOverride.ResourceCounts
+
+		data = append(data, flattenNamespaceOverride)
+	}
+
+	return data
+}
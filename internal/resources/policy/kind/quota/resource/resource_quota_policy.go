@@ -27,6 +27,7 @@ func ResourceQuotaPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindquota.ResourceName])),
 			policykindquota.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateSpecExclusions,
 		),
 	}
 }
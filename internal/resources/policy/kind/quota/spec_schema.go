@@ -26,6 +26,7 @@ var SpecSchema = &schema.Schema{
 		Schema: map[string]*schema.Schema{
 			policy.InputKey:             inputSchema,
 			policy.NamespaceSelectorKey: policy.NamespaceSelector,
+			policy.ExclusionsKey:        policy.Exclusions,
 		},
 	},
 }
@@ -38,6 +39,11 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 
 	data, _ := value.([]interface{})
 
+	return ConstructSpecFromData(data)
+}
+
+// ConstructSpecFromData constructs the quota policy spec from the data of a spec block.
+func ConstructSpecFromData(data []interface{}) (spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) {
 	if len(data) == 0 || data[0] == nil {
 		return spec
 	}
@@ -82,6 +88,12 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		}
 	}
 
+	if v, ok := specData[policy.ExclusionsKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			spec.NamespaceSelector = policy.ExcludeNamespaces(spec.NamespaceSelector, v1)
+		}
+	}
+
 	return spec
 }
 
@@ -17,8 +17,9 @@ const (
 
 // Allowed input recipes.
 const (
-	UnknownRecipe  Recipe = policy.UnknownRecipe
-	BaselineRecipe Recipe = reciperesource.BaselineKey
-	CustomRecipe   Recipe = reciperesource.CustomKey
-	StrictRecipe   Recipe = reciperesource.StrictKey
+	UnknownRecipe              Recipe = policy.UnknownRecipe
+	BaselineRecipe             Recipe = reciperesource.BaselineKey
+	CustomRecipe               Recipe = reciperesource.CustomKey
+	StrictRecipe               Recipe = reciperesource.StrictKey
+	PodSecurityAdmissionRecipe Recipe = reciperesource.PodSecurityAdmissionKey
 )
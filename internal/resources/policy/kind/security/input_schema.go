@@ -20,20 +20,21 @@ import (
 var (
 	inputSchema = &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "Input for the security policy, having one of the valid recipes: baseline, custom or strict.",
+		Description: "Input for the security policy, having one of the valid recipes: baseline, custom, strict or pod_security_admission.",
 		Required:    true,
 		MaxItems:    1,
 		MinItems:    1,
 		ForceNew:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				reciperesource.BaselineKey: reciperesource.Baseline,
-				reciperesource.CustomKey:   reciperesource.Custom,
-				reciperesource.StrictKey:   reciperesource.Strict,
+				reciperesource.BaselineKey:             reciperesource.Baseline,
+				reciperesource.CustomKey:               reciperesource.Custom,
+				reciperesource.StrictKey:               reciperesource.Strict,
+				reciperesource.PodSecurityAdmissionKey: reciperesource.PodSecurityAdmission,
 			},
 		},
 	}
-	RecipesAllowed = [...]string{reciperesource.BaselineKey, reciperesource.CustomKey, reciperesource.StrictKey}
+	RecipesAllowed = [...]string{reciperesource.BaselineKey, reciperesource.CustomKey, reciperesource.StrictKey, reciperesource.PodSecurityAdmissionKey}
 )
 
 type (
@@ -44,6 +45,7 @@ type (
 		inputBaseline *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1Baseline
 		inputCustom   *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1Custom
 		inputStrict   *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1Strict
+		inputPSA      *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission
 	}
 )
 
@@ -81,6 +83,15 @@ func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
 		}
 	}
 
+	if v, ok := inputData[reciperesource.PodSecurityAdmissionKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			inputRecipeData = &inputRecipe{
+				recipe:   PodSecurityAdmissionRecipe,
+				inputPSA: reciperesource.ConstructPodSecurityAdmission(v1),
+			}
+		}
+	}
+
 	return inputRecipeData
 }
 
@@ -98,6 +109,8 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 		flattenInputData[reciperesource.CustomKey] = reciperesource.FlattenCustom(inputRecipeData.inputCustom)
 	case StrictRecipe:
 		flattenInputData[reciperesource.StrictKey] = reciperesource.FlattenStrict(inputRecipeData.inputStrict)
+	case PodSecurityAdmissionRecipe:
+		flattenInputData[reciperesource.PodSecurityAdmissionKey] = reciperesource.FlattenPodSecurityAdmission(inputRecipeData.inputPSA)
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -106,7 +119,12 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 }
 
 func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
-	value, ok := diff.GetOk(policy.SpecKey)
+	return ValidateInputAt(diff, policy.SpecKey)
+}
+
+// ValidateInputAt checks the spec block found at the given key of the diff has exactly one valid input recipe block.
+func ValidateInputAt(diff *schema.ResourceDiff, specKey string) error {
+	value, ok := diff.GetOk(specKey)
 	if !ok {
 		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
 	}
@@ -154,6 +172,12 @@ func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}
 		}
 	}
 
+	if v, ok := inputData[reciperesource.PodSecurityAdmissionKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			recipesFound = append(recipesFound, reciperesource.PodSecurityAdmissionKey)
+		}
+	}
+
 	if len(recipesFound) == 0 {
 		return fmt.Errorf("no valid input recipe block found: minimum one valid input recipe block is required among: %v", strings.Join(RecipesAllowed[:], `, `))
 	} else if len(recipesFound) > 1 {
@@ -0,0 +1,139 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindsecurity
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security/recipe"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+// Pod Security Admission is enabled by default from Kubernetes v1.23.
+const podSecurityAdmissionMinMinorVersion = 23
+
+var kubernetesMinorVersionRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)`)
+
+// ValidatePodSecurityAdmission checks that a pod_security_admission recipe sets at least one mode and,
+// for cluster and cluster group scopes, that the clusters it applies to support Pod Security Admission.
+// Clusters which are not known yet or do not report a Kubernetes version are not checked.
+func ValidatePodSecurityAdmission(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	psaKey := fmt.Sprintf("%s.0.%s.0.%s", policy.SpecKey, policy.InputKey, reciperesource.PodSecurityAdmissionKey)
+
+	psaData, _ := diff.Get(psaKey).([]interface{})
+	if len(psaData) == 0 || psaData[0] == nil {
+		return nil
+	}
+
+	psa := reciperesource.ConstructPodSecurityAdmission(psaData)
+	if psa.Enforce == nil && psa.Audit == nil && psa.Warn == nil {
+		return fmt.Errorf("%s: minimum one of enforce, audit or warn is required", reciperesource.PodSecurityAdmissionKey)
+	}
+
+	config, ok := m.(authctx.TanzuContext)
+	if !ok || config.TMCConnection == nil || config.TMCConnection.ClusterResourceService == nil || !diff.NewValueKnown(scope.ScopeKey) {
+		return nil
+	}
+
+	clusters, err := scopedClusters(config, diff)
+	if err != nil {
+		return err
+	}
+
+	if unsupported := clustersWithoutPodSecurityAdmission(clusters); len(unsupported) != 0 {
+		return fmt.Errorf("%s requires Kubernetes v1.%d or later, clusters not supporting Pod Security Admission: %s",
+			reciperesource.PodSecurityAdmissionKey, podSecurityAdmissionMinMinorVersion, strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+func scopedClusters(config authctx.TanzuContext, diff *schema.ResourceDiff) ([]*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster, error) {
+	clusterKey := fmt.Sprintf("%s.0.%s", scope.ScopeKey, scope.ClusterKey)
+	clusterGroupKey := fmt.Sprintf("%s.0.%s", scope.ScopeKey, scope.ClusterGroupKey)
+
+	if clusterData, ok := diff.Get(clusterKey).([]interface{}); ok && len(clusterData) != 0 && clusterData[0] != nil {
+		cluster, _ := clusterData[0].(map[string]interface{})
+		fn := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{}
+
+		fn.ManagementClusterName, _ = cluster[scope.ManagementClusterNameKey].(string)
+		fn.ProvisionerName, _ = cluster[scope.ProvisionerNameKey].(string)
+		fn.Name, _ = cluster[scope.ClusterNameKey].(string)
+
+		resp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(fn)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return nil, nil
+			}
+
+			return nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", fn.Name)
+		}
+
+		return []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{resp.Cluster}, nil
+	}
+
+	if clusterGroupData, ok := diff.Get(clusterGroupKey).([]interface{}); ok && len(clusterGroupData) != 0 && clusterGroupData[0] != nil {
+		clusterGroup, _ := clusterGroupData[0].(map[string]interface{})
+		clusterGroupName, _ := clusterGroup[scope.ClusterGroupNameKey].(string)
+
+		request := &clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequest{
+			SearchScope: &clustermodel.VmwareTanzuManageV1alpha1ClusterSearchScope{
+				ClusterGroupName: clusterGroupName,
+			},
+		}
+
+		resp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceList(request)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return nil, nil
+			}
+
+			return nil, errors.Wrapf(err, "Unable to list Tanzu Mission Control clusters, cluster group : %s", clusterGroupName)
+		}
+
+		return resp.Clusters, nil
+	}
+
+	return nil, nil
+}
+
+func clustersWithoutPodSecurityAdmission(clusters []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) (unsupported []string) {
+	for _, cluster := range clusters {
+		if cluster == nil || cluster.FullName == nil || cluster.Status == nil {
+			continue
+		}
+
+		if !supportsPodSecurityAdmission(cluster.Status.KubeServerVersion) {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", cluster.FullName.Name, cluster.Status.KubeServerVersion))
+		}
+	}
+
+	return unsupported
+}
+
+// supportsPodSecurityAdmission reports versions which cannot be parsed as supported, they are checked by the cluster itself.
+func supportsPodSecurityAdmission(kubernetesVersion string) bool {
+	matches := kubernetesMinorVersionRegex.FindStringSubmatch(kubernetesVersion)
+	if matches == nil {
+		return true
+	}
+
+	major, _ := strconv.Atoi(matches[1])
+	minor, _ := strconv.Atoi(matches[2])
+
+	return major > 1 || minor >= podSecurityAdmissionMinMinorVersion
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindsecurity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+)
+
+func TestClustersWithoutPodSecurityAdmission(t *testing.T) {
+	t.Parallel()
+
+	cluster := func(name, version string) *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster {
+		return &clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+			FullName: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{Name: name},
+			Status:   &clustermodel.VmwareTanzuManageV1alpha1ClusterStatus{KubeServerVersion: version},
+		}
+	}
+
+	cases := []struct {
+		description string
+		input       []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster
+		expected    []string
+	}{
+		{
+			description: "check for nil clusters",
+		},
+		{
+			description: "clusters supporting pod security admission",
+			input: []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+				cluster("tkg", "v1.25.7+vmware.2"),
+				cluster("eks", "v1.23.17-eks-0a21954"),
+				nil,
+				{FullName: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{Name: "pending"}},
+			},
+		},
+		{
+			description: "clusters not supporting pod security admission",
+			input: []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+				cluster("old", "v1.22.9"),
+				cluster("new", "v1.26.5"),
+				cluster("unknown", ""),
+			},
+			expected: []string{"old (v1.22.9)"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := clustersWithoutPodSecurityAdmission(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
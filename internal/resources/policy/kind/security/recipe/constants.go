@@ -9,6 +9,7 @@ const (
 	BaselineKey                  = "baseline"
 	CustomKey                    = "custom"
 	StrictKey                    = "strict"
+	PodSecurityAdmissionKey      = "pod_security_admission"
 	AuditKey                     = "audit"
 	DisableNativePspKey          = "disable_native_psp"
 	allowPrivilegedContainersKey = "allow_privileged_containers"
@@ -42,4 +43,12 @@ const (
 	forbiddenSysctlsKey          = "forbidden_sysctls"
 	allowedProfilesKey           = "allowed_profiles"
 	allowedLocalhostFilesKey     = "allowed_localhost_files"
+	enforceKey                   = "enforce"
+	warnKey                      = "warn"
+	versionKey                   = "version"
+	exemptionsKey                = "exemptions"
+	usernamesKey                 = "usernames"
+	runtimeClassesKey            = "runtime_classes"
+	namespacesKey                = "namespaces"
+	latestVersion                = "latest"
 )
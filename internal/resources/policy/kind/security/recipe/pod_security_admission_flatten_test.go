@@ -0,0 +1,107 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipesecuritymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/security"
+)
+
+func TestFlattenPodSecurityAdmission(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil security policy pod security admission recipe",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete security policy pod security admission recipe",
+			input: &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission{
+				Enforce: &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode{
+					Level:   "baseline",
+					Version: "v1.25",
+				},
+				Audit: &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode{
+					Level: "restricted",
+				},
+				Warn: &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode{
+					Level:   "restricted",
+					Version: "latest",
+				},
+				Exemptions: &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions{
+					Usernames:      []string{"system:serviceaccount:kube-system:replicaset-controller"},
+					RuntimeClasses: []string{"kata"},
+					Namespaces:     []string{"kube-system"},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					enforceKey: []interface{}{
+						map[string]interface{}{
+							levelKey:   "baseline",
+							versionKey: "v1.25",
+						},
+					},
+					AuditKey: []interface{}{
+						map[string]interface{}{
+							levelKey:   "restricted",
+							versionKey: "latest",
+						},
+					},
+					warnKey: []interface{}{
+						map[string]interface{}{
+							levelKey:   "restricted",
+							versionKey: "latest",
+						},
+					},
+					exemptionsKey: []interface{}{
+						map[string]interface{}{
+							usernamesKey:      []interface{}{"system:serviceaccount:kube-system:replicaset-controller"},
+							runtimeClassesKey: []interface{}{"kata"},
+							namespacesKey:     []interface{}{"kube-system"},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "scenario with only enforce mode",
+			input: &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission{
+				Enforce: &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode{
+					Level:   "privileged",
+					Version: "latest",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					enforceKey: []interface{}{
+						map[string]interface{}{
+							levelKey:   "privileged",
+							versionKey: "latest",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenPodSecurityAdmission(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipesecuritymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/security"
+)
+
+// PodSecurityStandardLevels are the levels defined by the Kubernetes pod security standards.
+var PodSecurityStandardLevels = []string{"privileged", "baseline", "restricted"}
+
+var PodSecurityAdmission = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for security policy pod security admission recipe version v1, labelling the selected namespaces for the Kubernetes Pod Security Admission controller",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			enforceKey: podSecurityAdmissionMode("Pod security standard enforced on the selected namespaces, violating pods are rejected"),
+			AuditKey:   podSecurityAdmissionMode("Pod security standard audited on the selected namespaces, violations are recorded in the audit log"),
+			warnKey:    podSecurityAdmissionMode("Pod security standard warned about on the selected namespaces, violations are returned as warnings to the user"),
+			exemptionsKey: {
+				Type:        schema.TypeList,
+				Description: "Requests exempted from pod security admission",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						usernamesKey: {
+							Type:        schema.TypeList,
+							Description: "Authenticated usernames exempted",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						runtimeClassesKey: {
+							Type:        schema.TypeList,
+							Description: "Runtime class names exempted",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						namespacesKey: {
+							Type:        schema.TypeList,
+							Description: "Namespaces exempted",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func podSecurityAdmissionMode(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				levelKey: {
+					Type:         schema.TypeString,
+					Description:  "Pod security standard level: privileged, baseline or restricted",
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(PodSecurityStandardLevels, false),
+				},
+				versionKey: {
+					Type:         schema.TypeString,
+					Description:  "Kubernetes minor version of the pod security standard, e.g. v1.25, or latest",
+					Optional:     true,
+					ForceNew:     true,
+					Default:      latestVersion,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(latest|v1\.\d+)$`), "version must be latest or a Kubernetes minor version like v1.25"),
+				},
+			},
+		},
+	}
+}
+
+func ConstructPodSecurityAdmission(data []interface{}) (psa *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission) {
+	if len(data) == 0 || data[0] == nil {
+		return psa
+	}
+
+	psaData, _ := data[0].(map[string]interface{})
+
+	psa = &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission{}
+
+	if v, ok := psaData[enforceKey].([]interface{}); ok {
+		psa.Enforce = expandPodSecurityAdmissionMode(v)
+	}
+
+	if v, ok := psaData[AuditKey].([]interface{}); ok {
+		psa.Audit = expandPodSecurityAdmissionMode(v)
+	}
+
+	if v, ok := psaData[warnKey].([]interface{}); ok {
+		psa.Warn = expandPodSecurityAdmissionMode(v)
+	}
+
+	if v, ok := psaData[exemptionsKey].([]interface{}); ok {
+		psa.Exemptions = expandPodSecurityAdmissionExemptions(v)
+	}
+
+	return psa
+}
+
+func expandPodSecurityAdmissionMode(data []interface{}) (mode *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode) {
+	if len(data) == 0 || data[0] == nil {
+		return mode
+	}
+
+	modeData, _ := data[0].(map[string]interface{})
+
+	mode = &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode{}
+	mode.Level, _ = modeData[levelKey].(string)
+	mode.Version, _ = modeData[versionKey].(string)
+
+	return mode
+}
+
+func expandPodSecurityAdmissionExemptions(data []interface{}) (exemptions *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions) {
+	if len(data) == 0 || data[0] == nil {
+		return exemptions
+	}
+
+	exemptionsData, _ := data[0].(map[string]interface{})
+
+	exemptions = &policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionExemptions{
+		Usernames:      expandStrings(exemptionsData[usernamesKey]),
+		RuntimeClasses: expandStrings(exemptionsData[runtimeClassesKey]),
+		Namespaces:     expandStrings(exemptionsData[namespacesKey]),
+	}
+
+	return exemptions
+}
+
+func expandStrings(data interface{}) (values []string) {
+	vs, _ := data.([]interface{})
+
+	for _, raw := range vs {
+		if value, ok := raw.(string); ok {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func FlattenPodSecurityAdmission(psa *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmission) (data []interface{}) {
+	if psa == nil {
+		return data
+	}
+
+	flattenPSA := make(map[string]interface{})
+
+	if psa.Enforce != nil {
+		flattenPSA[enforceKey] = flattenPodSecurityAdmissionMode(psa.Enforce)
+	}
+
+	if psa.Audit != nil {
+		flattenPSA[AuditKey] = flattenPodSecurityAdmissionMode(psa.Audit)
+	}
+
+	if psa.Warn != nil {
+		flattenPSA[warnKey] = flattenPodSecurityAdmissionMode(psa.Warn)
+	}
+
+	if psa.Exemptions != nil {
+		flattenPSA[exemptionsKey] = []interface{}{
+			map[string]interface{}{
+				usernamesKey:      flattenStrings(psa.Exemptions.Usernames),
+				runtimeClassesKey: flattenStrings(psa.Exemptions.RuntimeClasses),
+				namespacesKey:     flattenStrings(psa.Exemptions.Namespaces),
+			},
+		}
+	}
+
+	return []interface{}{flattenPSA}
+}
+
+func flattenPodSecurityAdmissionMode(mode *policyrecipesecuritymodel.VmwareTanzuManageV1alpha1CommonPolicySpecSecurityV1PodSecurityAdmissionMode) []interface{} {
+	version := mode.Version
+	if version == "" {
+		version = latestVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			levelKey:   mode.Level,
+			versionKey: version,
+		},
+	}
+}
+
+func flattenStrings(values []string) []interface{} {
+	flattenValues := make([]interface{}, 0, len(values))
+
+	for _, value := range values {
+		flattenValues = append(flattenValues, value)
+	}
+
+	return flattenValues
+}
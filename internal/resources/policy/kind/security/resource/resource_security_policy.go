@@ -26,7 +26,9 @@ func ResourceSecurityPolicy() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindsecurity.ResourceName])),
 			policykindsecurity.ValidateInput,
+			policykindsecurity.ValidatePodSecurityAdmission,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateSpecExclusions,
 		),
 	}
 }
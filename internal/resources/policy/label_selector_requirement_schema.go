@@ -86,7 +86,12 @@ func flattenLabelSelectorRequirement(labelSelectorRequirement *policymodel.K8sIo
 }
 
 func ValidateSpecLabelSelectorRequirement(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
-	value, ok := diff.GetOk(SpecKey)
+	return ValidateLabelSelectorRequirementAt(diff, SpecKey)
+}
+
+// ValidateLabelSelectorRequirementAt checks the namespace selectors of the spec block found at the given key of the diff.
+func ValidateLabelSelectorRequirementAt(diff *schema.ResourceDiff, specKey string) error {
+	value, ok := diff.GetOk(specKey)
 	if !ok {
 		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
 	}
@@ -98,8 +103,21 @@ func ValidateSpecLabelSelectorRequirement(ctx context.Context, diff *schema.Reso
 	}
 
 	specData := data[0].(map[string]interface{})
-	namespaceSelector := specData[NamespaceSelectorKey]
 
+	if err := validateLabelSelectorRequirements(specData[NamespaceSelectorKey]); err != nil {
+		return err
+	}
+
+	if exclusionsData, ok := specData[ExclusionsKey].([]interface{}); ok && len(exclusionsData) != 0 && exclusionsData[0] != nil {
+		exclusions, _ := exclusionsData[0].(map[string]interface{})
+
+		return validateLabelSelectorRequirements(exclusions[NamespaceSelectorKey])
+	}
+
+	return nil
+}
+
+func validateLabelSelectorRequirements(namespaceSelector interface{}) error {
 	// nolint: nestif
 	if namespaceData, ok := namespaceSelector.([]interface{}); ok && len(namespaceData) != 0 && namespaceData[0] != nil {
 		namespaceSelectorData, _ := namespaceData[0].(map[string]interface{})
@@ -66,6 +66,9 @@ func ResourcePolicyRead(_ context.Context, d *schema.ResourceData, m interface{}
 		flattenedSpec = policykindmutation.FlattenSpec(spec)
 	}
 
+	priorSpec, _ := d.Get(policy.SpecKey).([]interface{})
+	flattenedSpec = policy.FlattenSpecExclusions(flattenedSpec, priorSpec)
+
 	if err := d.Set(policy.SpecKey, flattenedSpec); err != nil {
 		return diag.FromErr(err)
 	}
@@ -52,6 +52,10 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control %s policy entry, name : %s", rn, policyName))
+	}
+
 	var updateAvailable bool
 
 	if updateCheckForMeta(d, meta) {
@@ -145,9 +149,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
@@ -196,6 +198,8 @@ func hasSpecChanged(d *schema.ResourceData) bool {
 	case d.HasChange(helper.GetFirstElementOf(policy.SpecKey, policy.InputKey)):
 		fallthrough
 	case d.HasChange(helper.GetFirstElementOf(policy.SpecKey, policy.NamespaceSelectorKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(policy.SpecKey, policy.ExclusionsKey)):
 		updateRequired = true
 	}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 	sourcesecretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/clustergroup"
+	sourcesecretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/workspace"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/sourcesecret/scope"
@@ -101,6 +102,11 @@ func resourceSourcesecretRead(ctx context.Context, d *schema.ResourceData, m int
 			AtomicSpec: atomicSpec,
 		}
 		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec, specTypeData)
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSpec{
+			AtomicSpec: atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForWorkspaceScope(workspaceScopeSpec, specTypeData)
 	}
 
 	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
@@ -21,6 +21,7 @@ import (
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 	sourcesecretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/clustergroup"
+	sourcesecretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/workspace"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/sourcesecret/scope"
@@ -153,6 +154,37 @@ func retrieveSourcesecretUIDMetaAndSpecFromServer(config authctx.TanzuContext, s
 				return "", nil, nil, err
 			}
 
+			UID = resp.SourceSecret.Meta.UID
+			meta = resp.SourceSecret.Meta
+			spec = resp.SourceSecret.Spec.AtomicSpec
+		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			resp, err := config.TMCConnection.WorkspaceSourcesecretResourceService.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceGet(scopedFullnameData.FullnameWorkspace)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return "", nil, nil, err
+				}
+
+				return "", nil, nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace source secret entry, name : %s", scopedFullnameData.FullnameWorkspace.Name)
+			}
+
+			scopedFullnameData = &scope.ScopedFullname{
+				Scope:             commonscope.WorkspaceScope,
+				FullnameWorkspace: resp.SourceSecret.FullName,
+			}
+
+			fullName, name := scope.FlattenScope(scopedFullnameData)
+
+			if err := d.Set(nameKey, name); err != nil {
+				return "", nil, nil, err
+			}
+
+			if err := d.Set(commonscope.ScopeKey, fullName); err != nil {
+				return "", nil, nil, err
+			}
+
 			UID = resp.SourceSecret.Meta.UID
 			meta = resp.SourceSecret.Meta
 			spec = resp.SourceSecret.Spec.AtomicSpec
@@ -221,6 +253,23 @@ func resourceSourcesecretCreate(ctx context.Context, d *schema.ResourceData, m i
 				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group sourcesecret entry, name : %s", sourcesecretName))
 			}
 
+			UID = sourcesecretResponse.SourceSecret.Meta.UID
+		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			sourcesecretReq := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest{
+				SourceSecret: &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSourceSecret{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForWorkspaceScope(d),
+				},
+			}
+
+			sourcesecretResponse, err := config.TMCConnection.WorkspaceSourcesecretResourceService.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceCreate(sourcesecretReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace sourcesecret entry, name : %s", sourcesecretName))
+			}
+
 			UID = sourcesecretResponse.SourceSecret.Meta.UID
 		}
 	case commonscope.UnknownScope:
@@ -252,6 +301,10 @@ func resourceSourcesecretInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var updateAvailable bool
 
 	if updateCheckForMeta(d, meta) {
@@ -299,6 +352,23 @@ func resourceSourcesecretInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group source secret entry, name : %s", sourcesecretName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			sourcesecretReq := &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourceSecretRequest{
+				SourceSecret: &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSourceSecret{
+					FullName: scopedFullnameData.FullnameWorkspace,
+					Meta:     meta,
+					Spec: &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSpec{
+						AtomicSpec: atomicSpec,
+					},
+				},
+			}
+
+			_, err := config.TMCConnection.WorkspaceSourcesecretResourceService.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceUpdate(sourcesecretReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace source secret entry, name : %s", sourcesecretName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.CredentialTypesAllowed[:], `, `))
 	}
@@ -337,6 +407,13 @@ func resourceSourcesecretDelete(ctx context.Context, d *schema.ResourceData, m i
 				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group source secret entry, name : %s", sourcesecretName))
 			}
 		}
+	case commonscope.WorkspaceScope:
+		if scopedFullnameData.FullnameWorkspace != nil {
+			err := config.TMCConnection.WorkspaceSourcesecretResourceService.ManageV1alpha1WorkspaceFluxcdSourcesecretResourceServiceDelete(scopedFullnameData.FullnameWorkspace)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace source secret entry, name : %s", sourcesecretName))
+			}
+		}
 	case commonscope.UnknownScope:
 		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.CredentialTypesAllowed[:], `, `))
 	}
@@ -355,9 +432,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
@@ -390,6 +465,9 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *sourcesecretclusterm
 	case commonscope.ClusterGroupScope:
 		clusterGroupScopeSpec := spec.ConstructSpecForClusterGroupScope(d)
 		sourcesecretSpec = clusterGroupScopeSpec.AtomicSpec
+	case commonscope.WorkspaceScope:
+		workspaceScopeSpec := spec.ConstructSpecForWorkspaceScope(d)
+		sourcesecretSpec = workspaceScopeSpec.AtomicSpec
 	}
 
 	atomicSpec.Data.Data = sourcesecretSpec.Data.Data
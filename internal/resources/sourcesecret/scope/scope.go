@@ -14,6 +14,7 @@ import (
 
 	sourcesecretlustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 	sourcesecretlustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/clustergroup"
+	sourcesecretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/workspace"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
@@ -22,10 +23,11 @@ type ScopedFullname struct {
 	Scope                commonscope.Scope
 	FullnameCluster      *sourcesecretlustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretFullName
 	FullnameClusterGroup *sourcesecretlustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdSourcesecretFullName
+	FullnameWorkspace    *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretFullName
 }
 
 var (
-	CredentialTypesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
+	CredentialTypesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey, commonscope.WorkspaceKey}
 	ScopeSchema            = commonscope.GetScopeSchema(
 		commonscope.WithDescription(fmt.Sprintf("Scope for the source secret, having one of the valid scopes: %v.", strings.Join(CredentialTypesAllowed[:], `, `))),
 		commonscope.WithScopes(CredentialTypesAllowed[:]))
@@ -68,6 +70,17 @@ func ConstructScope(d *schema.ResourceData, name string) (scopedFullnameData *Sc
 		}
 	}
 
+	if workspaceData, ok := scopeData[commonscope.WorkspaceKey]; ok && slices.Contains(CredentialTypesAllowed[:], commonscope.WorkspaceKey) {
+		if workspaceValue, ok := workspaceData.([]interface{}); ok && len(workspaceValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:             commonscope.WorkspaceScope,
+				FullnameWorkspace: ConstructWorkspaceSourcesecretFullname(workspaceValue, name),
+			}
+
+			scopesFound = append(scopesFound, commonscope.WorkspaceKey)
+		}
+	}
+
 	return scopedFullnameData, scopesFound
 }
 
@@ -89,6 +102,11 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name stri
 			name = scopedFullname.FullnameClusterGroup.Name
 			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupSourcesecretFullname(scopedFullname.FullnameClusterGroup)
 		}
+	case commonscope.WorkspaceScope:
+		if slices.Contains(CredentialTypesAllowed[:], commonscope.WorkspaceKey) {
+			name = scopedFullname.FullnameWorkspace.Name
+			flattenScopeData[commonscope.WorkspaceKey] = FlattenWorkspaceSourcesecretFullname(scopedFullname.FullnameWorkspace)
+		}
 	case commonscope.UnknownScope:
 		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(CredentialTypesAllowed[:], `, `))
 	}
@@ -13,6 +13,7 @@ import (
 
 	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 	sourcesecretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/clustergroup"
+	sourcesecretworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/workspace"
 )
 
 func TestFlattenSpecForClusterScope(t *testing.T) {
@@ -170,3 +171,83 @@ func TestFlattenStatusForClusterGroupScope(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenStatusForWorkspaceScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster source secret spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete workspace source secret username/password type spec",
+			input: &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSpec{
+				AtomicSpec: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec{
+					SourceSecretType: sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeUSERNAMEPASSWORD),
+					Data: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+						Data: map[string]strfmt.Base64{
+							"username": []byte("someusername"),
+							"password": []byte(""),
+						},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					DataKey: []interface{}{
+						map[string]interface{}{
+							UsernamePasswordKey: []interface{}{
+								map[string]interface{}{
+									usernameKey: "someusername",
+									PasswordKey: "somevalue",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "normal scenario with complete workspace source secret ssh type spec",
+			input: &sourcesecretworkspacemodel.VmwareTanzuManageV1alpha1WorkspaceFluxcdSourcesecretSpec{
+				AtomicSpec: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec{
+					SourceSecretType: sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeSSH),
+					Data: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+						Data: map[string]strfmt.Base64{
+							IdentityKey:   []byte(""),
+							KnownhostsKey: []byte("someknownhostvalue"),
+						},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					DataKey: []interface{}{
+						map[string]interface{}{
+							SSHKey: []interface{}{
+								map[string]interface{}{
+									IdentityKey:   "somevalue",
+									KnownhostsKey: "someknownhostvalue",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecForWorkspaceScope(test.input, "somevalue")
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -20,9 +20,11 @@ import (
 	openapiv3 "github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper/openapi_v3_schema_validator"
 	legacyclustermodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
 	kubeconfigmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/kubeconfig"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	tanzukubernetesclustermodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
 	tkccommonmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster/common"
 	tkcnodepoolmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster/nodepool"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
 type ClusterClassModifierFunc func(tfVariable interface{}, modelVariable interface{}) interface{}
@@ -380,6 +382,19 @@ func getTimeoutPolicy(data *schema.ResourceData) map[string]interface{} {
 	return timeoutPolicy
 }
 
+// setManagedBy marks the cluster as created by this resource, which other resources check before taking over the cluster group.
+func setManagedBy(kubernetesClusterModel *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterTanzuKubernetesCluster) {
+	if kubernetesClusterModel.Meta == nil {
+		kubernetesClusterModel.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if kubernetesClusterModel.Meta.Labels == nil {
+		kubernetesClusterModel.Meta.Labels = make(map[string]string)
+	}
+
+	kubernetesClusterModel.Meta.Labels[common.ManagedByLabelKey] = ResourceName
+}
+
 // removeUnspecifiedClusterVariables removed cluster variables returning in the API which do not exist in the Cluster Class schema.
 func removeUnspecifiedClusterVariables(tfClusterVariables string, kubernetesClusterModel *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterTanzuKubernetesCluster) {
 	tfClusterVariablesJSON := make(map[string]interface{})
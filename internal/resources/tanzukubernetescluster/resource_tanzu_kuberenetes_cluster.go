@@ -67,6 +67,8 @@ func resourceTanzuKubernetesClusterCreate(ctx context.Context, data *schema.Reso
 		return diag.FromErr(errors.Wrapf(err, "Couldn't create TKG Cluster."))
 	}
 
+	setManagedBy(model)
+
 	modelNodePools := model.Spec.Topology.NodePools
 	model.Spec.Topology.NodePools = nil
 
@@ -201,7 +203,22 @@ func resourceTanzuKubernetesClusterUpdate(ctx context.Context, data *schema.Reso
 		modelNodePools := model.Spec.Topology.NodePools
 
 		if data.HasChanges(clusterResourceUpdateKeys...) {
+			getResp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGet(model.FullName)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Couldn't read TKG Cluster.\nManagement Cluster Name: %s, Provisioner: %s, Cluster Name: %s",
+					model.FullName.ManagementClusterName, model.FullName.ProvisionerName, model.FullName.Name))
+			}
+
+			if err := common.CheckResourceVersion(data, getResp.TanzuKubernetesCluster.Meta); err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Couldn't update TKG Cluster, name : %s", model.FullName.Name))
+			}
+
 			model.Spec.Topology.NodePools = nil
+			setManagedBy(model)
+
+			if getResp.TanzuKubernetesCluster.Meta != nil {
+				model.Meta.Labels = common.WithSystemManagedKeys(model.Meta.Labels, getResp.TanzuKubernetesCluster.Meta.Labels)
+			}
 
 			clusterRequest := &tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterData{
 				TanzuKubernetesCluster: model,
@@ -181,6 +181,10 @@ func resourcePackageInstallInPlaceUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var updateAvailable bool
 
 	if updateCheckForMeta(d, meta) {
@@ -273,9 +277,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
@@ -212,6 +212,10 @@ func resourcePackageRepositoryInPlaceUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	if err := common.CheckResourceVersion(d, pkgRepoDataFromServer.meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if updateCheckForMeta(d, pkgRepoDataFromServer.meta) || updateCheckForSpec(d, pkgRepoDataFromServer.spec) {
 		pkgRepoReq := &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryRequest{
 			Repository: &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepository{
@@ -295,9 +299,7 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 	objectMeta := common.ConstructMeta(d)
 
-	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
-		objectMeta.Labels[common.CreatorLabelKey] = value
-	}
+	objectMeta.Labels = common.WithSystemManagedKeys(objectMeta.Labels, meta.Labels)
 
 	meta.Labels = objectMeta.Labels
 	meta.Description = objectMeta.Description
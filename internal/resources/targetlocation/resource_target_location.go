@@ -18,6 +18,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
 	targetlocationmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/targetlocation"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
 type CredentialsTypeCtxKey string
@@ -163,6 +164,21 @@ func resourceTargetLocationUpdate(ctx context.Context, data *schema.ResourceData
 	}
 
 	model.FullName.ProviderName = TMCProviderName
+
+	getResp, err := config.TMCConnection.TargetLocationService.TargetLocationResourceServiceGet(model.FullName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't read Tanzu Mission Control backup target location.\nName: %s, Provider: %s",
+			model.FullName.Name, model.FullName.ProviderName))
+	}
+
+	if err := common.CheckResourceVersion(data, getResp.BackupLocation.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't update Tanzu Mission Control backup target location, name : %s", model.FullName.Name))
+	}
+
+	if model.Meta != nil && getResp.BackupLocation.Meta != nil {
+		model.Meta.Labels = common.WithSystemManagedKeys(model.Meta.Labels, getResp.BackupLocation.Meta.Labels)
+	}
+
 	credentialsType, err := getCredentialsType(config, model.Spec.Credential.Name)
 
 	if err != nil {
@@ -6,6 +6,14 @@ SPDX-License-Identifier: MPL-2.0
 package workspace
 
 const (
-	ResourceName = "tanzu-mission-control_workspace"
-	NameKey      = "name"
+	ResourceName       = "tanzu-mission-control_workspace"
+	ListDataSourceName = "tanzu-mission-control_workspaces"
+	NameKey            = "name"
+	queryKey           = "query"
+	labelsKey          = "labels"
+	workspacesKey      = "workspaces"
+	namesKey           = "names"
+	totalCountKey      = "total_count"
+	uidKey             = "uid"
+	descriptionKey     = "description"
 )
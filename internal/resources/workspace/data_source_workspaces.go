@@ -0,0 +1,190 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// nolint: dupl
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func DataSourceWorkspaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkspacesRead,
+		Schema:      workspacesSchema,
+		Description: "Tanzu Mission Control Workspaces Data Source",
+	}
+}
+
+var workspacesSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the workspaces to search for; supports globbing.",
+		Optional:    true,
+		Default:     "*",
+	},
+	queryKey: {
+		Type:        schema.TypeString,
+		Description: "TQL query to filter the workspaces.",
+		Optional:    true,
+	},
+	labelsKey: {
+		Type:        schema.TypeMap,
+		Description: "Only list the workspaces carrying all of these labels.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	workspacesKey: {
+		Type:        schema.TypeList,
+		Description: "List of workspaces matching the search criteria, sorted by name.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the workspace",
+					Computed:    true,
+				},
+				uidKey: {
+					Type:        schema.TypeString,
+					Description: "UID of the workspace",
+					Computed:    true,
+				},
+				descriptionKey: {
+					Type:        schema.TypeString,
+					Description: "Description of the workspace",
+					Computed:    true,
+				},
+				labelsKey: {
+					Type:        schema.TypeMap,
+					Description: "Labels of the workspace",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
+	namesKey: {
+		Type:        schema.TypeList,
+		Description: "Names of the workspaces matching the search criteria, sorted by name.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	totalCountKey: {
+		Type:        schema.TypeInt,
+		Description: "Total count of workspaces matching the search criteria.",
+		Computed:    true,
+	},
+}
+
+func dataSourceWorkspacesRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	request := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest{
+		SearchScope: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceSearchScope{},
+	}
+
+	request.SearchScope.Name, _ = d.Get(NameKey).(string)
+	request.Query, _ = d.Get(queryKey).(string)
+	labels, _ := d.Get(labelsKey).(map[string]interface{})
+	selector := common.GetTypeStringMapData(labels)
+
+	workspaces, err := ListWorkspaces(config, request)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to list Tanzu Mission Control workspaces, name : %s", request.SearchScope.Name))
+	}
+
+	workspaces = filterWorkspaces(workspaces, selector)
+
+	if err := d.Set(workspacesKey, flattenWorkspaces(workspaces)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(namesKey, workspaceNames(workspaces)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(totalCountKey, len(workspaces)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idKeys := []string{request.SearchScope.Name, request.Query, helper.LabelSelectorString(selector)}
+	d.SetId(fmt.Sprintf("workspaces/%s", strings.Join(idKeys, "/")))
+
+	return diags
+}
+
+// filterWorkspaces returns the workspaces carrying all the labels of the selector, sorted by name.
+func filterWorkspaces(workspaces []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace, selector map[string]string) []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace {
+	filtered := make([]*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace, 0, len(workspaces))
+
+	for _, workspace := range workspaces {
+		if workspace == nil || workspace.FullName == nil {
+			continue
+		}
+
+		var labels map[string]string
+
+		if workspace.Meta != nil {
+			labels = workspace.Meta.Labels
+		}
+
+		if helper.MatchLabels(labels, selector) {
+			filtered = append(filtered, workspace)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].FullName.Name < filtered[j].FullName.Name
+	})
+
+	return filtered
+}
+
+func workspaceNames(workspaces []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace) []string {
+	names := make([]string, 0, len(workspaces))
+
+	for _, workspace := range workspaces {
+		names = append(names, workspace.FullName.Name)
+	}
+
+	return names
+}
+
+func flattenWorkspaces(workspaces []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace) (data []interface{}) {
+	for _, workspace := range workspaces {
+		if workspace == nil {
+			continue
+		}
+
+		flattenData := make(map[string]interface{})
+
+		if workspace.FullName != nil {
+			flattenData[NameKey] = workspace.FullName.Name
+		}
+
+		if workspace.Meta != nil {
+			flattenData[uidKey] = workspace.Meta.UID
+			flattenData[descriptionKey] = workspace.Meta.Description
+			flattenData[labelsKey] = workspace.Meta.Labels
+		}
+
+		data = append(data, flattenData)
+	}
+
+	return data
+}
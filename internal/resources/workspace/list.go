@@ -0,0 +1,49 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// nolint: dupl
+package workspace
+
+import (
+	"strconv"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+// ListWorkspaces returns all the workspaces matching the search scope and query of the request, across all pages.
+func ListWorkspaces(config authctx.TanzuContext, request *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequest) ([]*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace, error) {
+	workspaces := make([]*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace, 0)
+
+	pageRequest := *request
+	pageRequest.SortBy = "fullName.name"
+	pageRequest.IncludeTotalCount = true
+
+	err := helper.ListAllPages(helper.DefaultPageSize, func(offset, size int) (int, int, error) {
+		pageRequest.Pagination = &optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{
+			Offset: strconv.Itoa(offset),
+			Size:   strconv.Itoa(size),
+		}
+
+		resp, err := config.TMCConnection.WorkspaceResourceService.ManageV1alpha1WorkspaceResourceServiceList(&pageRequest)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return 0, 0, nil
+			}
+
+			return 0, 0, err
+		}
+
+		workspaces = append(workspaces, resp.Workspaces...)
+		totalCount, _ := strconv.Atoi(resp.TotalCount)
+
+		return len(resp.Workspaces), totalCount, nil
+	})
+
+	return workspaces, err
+}
@@ -106,12 +106,14 @@ func resourceWorkspaceInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control wrokspace entry, name : %s", workspaceName))
 	}
 
+	if err := common.CheckResourceVersion(d, getResp.Workspace.Meta); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace entry, name : %s", workspaceName))
+	}
+
 	if updateRequired {
 		meta := common.ConstructMeta(d)
 
-		if value, ok := getResp.Workspace.Meta.Labels[common.CreatorLabelKey]; ok {
-			meta.Labels[common.CreatorLabelKey] = value
-		}
+		meta.Labels = common.WithSystemManagedKeys(meta.Labels, getResp.Workspace.Meta.Labels)
 
 		getResp.Workspace.Meta.Labels = meta.Labels
 		getResp.Workspace.Meta.Description = meta.Description
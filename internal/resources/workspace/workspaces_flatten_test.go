@@ -0,0 +1,117 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+func TestFilterWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	prodB := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{
+		FullName: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "prod-b"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "prod"}},
+	}
+	prodA := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{
+		FullName: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "prod-a"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "prod", "team": "payments"}},
+	}
+	dev := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{
+		FullName: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "dev"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: map[string]string{"env": "dev"}},
+	}
+	noMeta := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{
+		FullName: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "default"},
+	}
+	workspaces := []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{prodB, nil, dev, prodA, noMeta}
+
+	cases := []struct {
+		description string
+		selector    map[string]string
+		expected    []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace
+	}{
+		{
+			description: "no selector lists all the workspaces sorted by name",
+			expected:    []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{noMeta, dev, prodA, prodB},
+		},
+		{
+			description: "filter by label",
+			selector:    map[string]string{"env": "prod"},
+			expected:    []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{prodA, prodB},
+		},
+		{
+			description: "filter by labels without match",
+			selector:    map[string]string{"env": "dev", "team": "payments"},
+			expected:    []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := filterWorkspaces(workspaces, test.selector)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil workspace list",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with workspace list",
+			input: []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{
+				{
+					FullName: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "prod-a"},
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+						UID:         "ws:01",
+						Description: "Production namespaces",
+						Labels:      map[string]string{"env": "prod"},
+					},
+				},
+				nil,
+				{
+					FullName: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: "default"},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NameKey:        "prod-a",
+					uidKey:         "ws:01",
+					descriptionKey: "Production namespaces",
+					labelsKey:      map[string]string{"env": "prod"},
+				},
+				map[string]interface{}{
+					NameKey: "default",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenWorkspaces(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}